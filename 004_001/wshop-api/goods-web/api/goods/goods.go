@@ -117,6 +117,9 @@ func List(ctx *gin.Context) {
 	brandIdInt, _ := strconv.Atoi(brandId)
 	request.Brand = int32(brandIdInt)
 
+	// 排序: rating(评分), review_num(评价数)
+	request.Sort = ctx.DefaultQuery("sort", "")
+
 	// 请求商品的service服务、负载均衡
 	// parent, _ := ctx.Get("parentSpan")
 	// opentracing.ContextWithSpan(context.Background(), parent.(opentracing.Span))
@@ -160,9 +163,12 @@ func List(ctx *gin.Context) {
 				"name": value.Brand.Name,
 				"logo": value.Brand.Logo,
 			},
-			"is_hot":  value.IsHot,
-			"is_new":  value.IsNew,
-			"on_sale": value.OnSale,
+			"is_hot":     value.IsHot,
+			"is_new":     value.IsNew,
+			"on_sale":    value.OnSale,
+			"weight":     value.Weight,
+			"rating":     value.Rating,
+			"review_num": value.ReviewNum,
 		})
 	}
 	reMap["data"] = goodsList
@@ -190,6 +196,7 @@ func New(ctx *gin.Context) {
 		GoodsFrontImage: goodsForm.FrontImage,
 		CategoryId:      goodsForm.CategoryId,
 		BrandId:         goodsForm.Brand,
+		Weight:          goodsForm.Weight,
 	})
 	if err != nil {
 		HandleGrpcErrorToHttp(err, ctx)
@@ -236,9 +243,12 @@ func Detail(ctx *gin.Context) {
 			"name": r.Brand.Name,
 			"logo": r.Brand.Logo,
 		},
-		"is_hot":  r.IsHot,
-		"is_new":  r.IsNew,
-		"on_sale": r.OnSale,
+		"is_hot":     r.IsHot,
+		"is_new":     r.IsNew,
+		"on_sale":    r.OnSale,
+		"weight":     r.Weight,
+		"rating":     r.Rating,
+		"review_num": r.ReviewNum,
 	}
 	ctx.JSON(http.StatusOK, rsp)
 }
@@ -318,6 +328,7 @@ func Update(ctx *gin.Context) {
 		GoodsFrontImage: goodsForm.FrontImage,
 		CategoryId:      goodsForm.CategoryId,
 		BrandId:         goodsForm.Brand,
+		Weight:          goodsForm.Weight,
 	}); err != nil {
 		HandleGrpcErrorToHttp(err, ctx)
 		return
@@ -0,0 +1,68 @@
+package goods
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/goods-web/global"
+	"wshop-api/goods-web/proto"
+)
+
+// 和userop_srv中的REVIEW_APPROVED保持一致， 商品详情页只展示审核通过的评价
+const reviewApproved = 2
+
+// Reviews 商品的评价列表， has_image=1的时候只看有图的评价
+func Reviews(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	request := &proto.ReviewFilterRequest{
+		GoodsId: int32(i),
+		Status:  reviewApproved,
+	}
+	if ctx.DefaultQuery("has_image", "0") == "1" {
+		request.HasImage = true
+	}
+
+	pages := ctx.DefaultQuery("p", "0")
+	pagesInt, _ := strconv.Atoi(pages)
+	request.Pages = int32(pagesInt)
+
+	perNums := ctx.DefaultQuery("pnum", "0")
+	perNumsInt, _ := strconv.Atoi(perNums)
+	request.PagePerNums = int32(perNumsInt)
+
+	rsp, err := global.ReviewClient.ReviewList(context.WithValue(context.Background(), "ginContext", ctx), request)
+	if err != nil {
+		zap.S().Errorw("[Reviews] 查询 【商品评价】失败")
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	reviews := make([]interface{}, 0)
+	for _, value := range rsp.Data {
+		reviews = append(reviews, map[string]interface{}{
+			"id":         value.Id,
+			"user_id":    value.UserId,
+			"rating":     value.Rating,
+			"content":    value.Content,
+			"images":     value.Images,
+			"reply":      value.Reply,
+			"reply_time": value.ReplyTime,
+			"add_time":   value.AddTime,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  reviews,
+	})
+}
@@ -0,0 +1,87 @@
+package goods
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop-api/goods-web/global"
+	"wshop-api/goods-web/proto"
+)
+
+// fakeReview 评价服务， 记录收到的查询条件
+type fakeReview struct {
+	proto.ReviewClient
+	err     error
+	request *proto.ReviewFilterRequest
+}
+
+func (f *fakeReview) ReviewList(ctx context.Context, in *proto.ReviewFilterRequest, opts ...grpc.CallOption) (*proto.ReviewListResponse, error) {
+	f.request = in
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &proto.ReviewListResponse{Total: 1, Data: []*proto.ReviewResponse{
+		{Id: 7, UserId: 3, Rating: 5, Content: "好", Images: []string{"a.jpg"}, Reply: "谢谢", AddTime: "2021-03-01 10:00:00"},
+	}}, nil
+}
+
+func getReviews(t *testing.T, client *fakeReview, url string) *httptest.ResponseRecorder {
+	t.Helper()
+	old := global.ReviewClient
+	t.Cleanup(func() { global.ReviewClient = old })
+	global.ReviewClient = client
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/g/v1/goods/:id/reviews", Reviews)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+	return w
+}
+
+func TestReviews(t *testing.T) {
+	client := &fakeReview{}
+	w := getReviews(t, client, "/g/v1/goods/5/reviews?has_image=1&p=2&pnum=20")
+	if w.Code != http.StatusOK {
+		t.Fatalf("code = %d", w.Code)
+	}
+	// 商品详情页只展示审核通过的评价
+	req := client.request
+	if req.GoodsId != 5 || req.Status != reviewApproved || !req.HasImage || req.Pages != 2 || req.PagePerNums != 20 || req.UserId != 0 {
+		t.Errorf("request = %v", req)
+	}
+
+	var body struct {
+		Total int32
+		Data  []map[string]interface{}
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Total != 1 || len(body.Data) != 1 || body.Data[0]["content"] != "好" || body.Data[0]["reply"] != "谢谢" {
+		t.Errorf("body = %s", w.Body.String())
+	}
+	// 审核相关的字段不返回给前端
+	if _, ok := body.Data[0]["admin_remark"]; ok {
+		t.Errorf("body = %s", w.Body.String())
+	}
+
+	if getReviews(t, client, "/g/v1/goods/5/reviews"); client.request.HasImage {
+		t.Error("没有传has_image的时候不过滤")
+	}
+	if w := getReviews(t, client, "/g/v1/goods/abc/reviews"); w.Code != http.StatusNotFound {
+		t.Errorf("商品id格式错误 code = %d", w.Code)
+	}
+	client.err = status.Errorf(codes.Internal, "查询评价失败")
+	if w := getReviews(t, client, "/g/v1/goods/5/reviews"); w.Code != http.StatusInternalServerError {
+		t.Errorf("评价服务出错 code = %d", w.Code)
+	}
+}
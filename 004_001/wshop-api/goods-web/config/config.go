@@ -4,6 +4,10 @@ type GoodsSrvConfig struct {
 	Name string `mapstructure:"name" json:"name"`
 }
 
+type UserOpSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
 type JWTConfig struct {
 	SigningKey string `mapstructure:"key" json:"key"`
 }
@@ -20,14 +24,15 @@ type ConsulConfig struct {
 }
 
 type ServerConfig struct {
-	Name         string         `mapstructure:"name" json:"name"`
-	Host         string         `mapstructure:"host" json:"host"`
-	Port         int            `mapstructure:"port" json:"port"`
-	Tags         []string       `mapstructure:"tags" json:"tags"`
-	GoodsSrvInfo GoodsSrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
-	JWTInfo      JWTConfig      `mapstructure:"jwt" json:"jwt"`
-	ConsulInfo   ConsulConfig   `mapstructure:"consul" json:"consul"`
-	JaegerInfo   JaegerConfig   `mapstructure:"consul" json:"jaeger"`
+	Name          string          `mapstructure:"name" json:"name"`
+	Host          string          `mapstructure:"host" json:"host"`
+	Port          int             `mapstructure:"port" json:"port"`
+	Tags          []string        `mapstructure:"tags" json:"tags"`
+	GoodsSrvInfo  GoodsSrvConfig  `mapstructure:"goods_srv" json:"goods_srv"`
+	UserOpSrvInfo UserOpSrvConfig `mapstructure:"userop_srv" json:"userop_srv"` // 商品的评价
+	JWTInfo       JWTConfig       `mapstructure:"jwt" json:"jwt"`
+	ConsulInfo    ConsulConfig    `mapstructure:"consul" json:"consul"`
+	JaegerInfo    JaegerConfig    `mapstructure:"consul" json:"jaeger"`
 }
 
 type NacosConfig struct {
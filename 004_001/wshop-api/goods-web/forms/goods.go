@@ -13,6 +13,7 @@ type GoodsForm struct {
 	ShipFree    *bool    `form:"ship_free" json:"ship_free" binding:"required"`
 	FrontImage  string   `form:"front_image" json:"front_image" binding:"required,url"`
 	Brand       int32    `form:"brand" json:"brand" binding:"required"`
+	Weight      int32    `form:"weight" json:"weight" binding:"min=0"` // 单位为克， 按重量计算运费的时候使用
 }
 
 type GoodsStatusForm struct {
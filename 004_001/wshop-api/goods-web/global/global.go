@@ -14,4 +14,6 @@ var (
 	NacosConfig *config.NacosConfig = &config.NacosConfig{}
 
 	GoodsSrvClient proto.GoodsClient
+
+	ReviewClient proto.ReviewClient
 )
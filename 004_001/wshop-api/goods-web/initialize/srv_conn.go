@@ -22,4 +22,15 @@ func InitSrvConn() {
 	}
 
 	global.GoodsSrvClient = proto.NewGoodsClient(goodsConn)
+
+	userOpConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.UserOpSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【用户操作服务失败】")
+	}
+
+	global.ReviewClient = proto.NewReviewClient(userOpConn)
 }
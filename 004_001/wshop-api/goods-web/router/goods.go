@@ -15,6 +15,7 @@ func InitGoodsRouter(Router *gin.RouterGroup) {
 		GoodsRouter.GET("/:id", goods.Detail)                                                      // 获取商品的详情
 		GoodsRouter.DELETE("/:id", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Delete) // 删除商品
 		GoodsRouter.GET("/:id/stocks", goods.Stocks)                                               // 获取商品的库存
+		GoodsRouter.GET("/:id/reviews", goods.Reviews)                                             // 商品的评价
 
 		GoodsRouter.PUT("/:id", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Update)
 		GoodsRouter.PATCH("/:id", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.UpdateStatus)
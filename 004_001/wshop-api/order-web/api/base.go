@@ -37,6 +37,14 @@ func HandleGrpcErrorToHttp(err error, c *gin.Context) {
 				c.JSON(http.StatusBadRequest, gin.H{
 					"msg": "参数错误",
 				})
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": e.Message(),
+				})
+			case codes.Aborted:
+				c.JSON(http.StatusConflict, gin.H{
+					"msg": e.Message(),
+				})
 			case codes.Unavailable:
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "服务不可用",
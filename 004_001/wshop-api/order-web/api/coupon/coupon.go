@@ -0,0 +1,236 @@
+package coupon
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/forms"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+	"wshop-api/order-web/utils/money"
+)
+
+func TemplateList(ctx *gin.Context) {
+	// 普通用户只能看到当前可以领取的优惠券， 管理员可以看到所有的模板
+	claims, _ := ctx.Get("claims")
+	request := proto.CouponTemplateFilterRequest{
+		Available: claims.(*models.CustomClaims).AuthorityId == 1,
+	}
+
+	pagesInt, _ := strconv.Atoi(ctx.DefaultQuery("p", "0"))
+	request.Pages = int32(pagesInt)
+
+	perNumsInt, _ := strconv.Atoi(ctx.DefaultQuery("pnum", "0"))
+	request.PagePerNums = int32(perNumsInt)
+
+	rsp, err := global.PromotionSrvClient.CouponTemplateList(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("获取优惠券列表失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	templateList := make([]interface{}, 0)
+	for _, item := range rsp.Data {
+		templateList = append(templateList, templateToMap(item))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  templateList,
+	})
+}
+
+func NewTemplate(ctx *gin.Context) {
+	templateForm := forms.CreateCouponTemplateForm{}
+	if err := ctx.ShouldBindJSON(&templateForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	rsp, err := global.PromotionSrvClient.CreateCouponTemplate(context.Background(), &proto.CouponTemplateRequest{
+		Name:             templateForm.Name,
+		CouponType:       templateForm.CouponType,
+		ThresholdCents:   money.FromYuan(templateForm.Threshold).Cents(),
+		DiscountCents:    money.FromYuan(templateForm.Discount).Cents(),
+		Percent:          templateForm.Percent,
+		MaxDiscountCents: money.FromYuan(templateForm.MaxDiscount).Cents(),
+		ScopeType:        templateForm.ScopeType,
+		ScopeIds:         templateForm.ScopeIds,
+		Total:            templateForm.Total,
+		PerUserLimit:     templateForm.PerUserLimit,
+		ValidDays:        templateForm.ValidDays,
+		StartTime:        templateForm.StartTime,
+		EndTime:          templateForm.EndTime,
+	})
+	if err != nil {
+		zap.S().Errorw("创建优惠券模板失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, templateToMap(rsp))
+}
+
+func Claim(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	userId, _ := ctx.Get("userId")
+	rsp, err := global.PromotionSrvClient.ClaimCoupon(context.Background(), &proto.ClaimCouponRequest{
+		UserId:     int32(userId.(uint)),
+		TemplateId: int32(i),
+	})
+	if err != nil {
+		zap.S().Errorw("领取优惠券失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, userCouponToMap(rsp))
+}
+
+func List(ctx *gin.Context) {
+	// 我的优惠券， 可以按照状态过滤
+	userId, _ := ctx.Get("userId")
+	request := proto.UserCouponFilterRequest{
+		UserId: int32(userId.(uint)),
+	}
+
+	statusInt, _ := strconv.Atoi(ctx.DefaultQuery("status", "0"))
+	request.Status = int32(statusInt)
+
+	pagesInt, _ := strconv.Atoi(ctx.DefaultQuery("p", "0"))
+	request.Pages = int32(pagesInt)
+
+	perNumsInt, _ := strconv.Atoi(ctx.DefaultQuery("pnum", "0"))
+	request.PagePerNums = int32(perNumsInt)
+
+	rsp, err := global.PromotionSrvClient.UserCouponList(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("获取用户优惠券失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	couponList := make([]interface{}, 0)
+	for _, item := range rsp.Data {
+		couponList = append(couponList, userCouponToMap(item))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  couponList,
+	})
+}
+
+func Best(ctx *gin.Context) {
+	// 计算购物车中选中的商品可以使用的最优优惠券组合， 结算页面展示
+	userId, _ := ctx.Get("userId")
+	cartRsp, err := global.OrderSrvClient.CartItemList(context.Background(), &proto.UserInfo{
+		Id: int32(userId.(uint)),
+	})
+	if err != nil {
+		zap.S().Errorw("[Best] 查询 【购物车列表】失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	ids := make([]int32, 0)
+	numsMap := make(map[int32]int32)
+	for _, item := range cartRsp.Data {
+		if item.Checked {
+			ids = append(ids, item.GoodsId)
+			numsMap[item.GoodsId] = item.Nums
+		}
+	}
+	if len(ids) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "没有选中结算的商品",
+		})
+		return
+	}
+
+	goodsRsp, err := global.GoodsSrvClient.BatchGetGoods(context.Background(), &proto.BatchGoodsIdInfo{
+		Id: ids,
+	})
+	if err != nil {
+		zap.S().Errorw("[Best] 批量查询【商品列表】失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	request := proto.CalculateDiscountRequest{
+		UserId: int32(userId.(uint)),
+	}
+	for _, good := range goodsRsp.Data {
+		request.Goods = append(request.Goods, &proto.DiscountGoodsItem{
+			GoodsId:    good.Id,
+			CategoryId: good.CategoryId,
+			BrandId:    good.Brand.GetId(),
+			PriceCents: money.FromProto(good.ShopPriceCents, good.ShopPrice).Cents(),
+			Nums:       numsMap[good.Id],
+		})
+	}
+
+	rsp, err := global.PromotionSrvClient.CalculateDiscount(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("计算优惠失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	couponList := make([]interface{}, 0)
+	for _, item := range rsp.Coupons {
+		couponList = append(couponList, gin.H{
+			"id":       item.CouponId,
+			"name":     item.Name,
+			"discount": money.FromCents(item.DiscountCents).Yuan(),
+		})
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total":    money.FromCents(rsp.TotalCents).Yuan(),
+		"discount": money.FromCents(rsp.DiscountCents).Yuan(),
+		"pay":      money.FromCents(rsp.TotalCents - rsp.DiscountCents).Yuan(),
+		"coupons":  couponList,
+	})
+}
+
+func templateToMap(item *proto.CouponTemplateResponse) gin.H {
+	return gin.H{
+		"id":             item.Id,
+		"name":           item.Name,
+		"type":           item.CouponType,
+		"threshold":      money.FromCents(item.ThresholdCents).Yuan(),
+		"discount":       money.FromCents(item.DiscountCents).Yuan(),
+		"percent":        item.Percent,
+		"max_discount":   money.FromCents(item.MaxDiscountCents).Yuan(),
+		"scope":          item.ScopeType,
+		"scope_ids":      item.ScopeIds,
+		"total":          item.Total,
+		"claimed":        item.Claimed,
+		"per_user_limit": item.PerUserLimit,
+		"valid_days":     item.ValidDays,
+		"start_time":     item.StartTime,
+		"end_time":       item.EndTime,
+	}
+}
+
+func userCouponToMap(item *proto.UserCouponResponse) gin.H {
+	return gin.H{
+		"id":         item.Id,
+		"status":     item.Status,
+		"order_sn":   item.OrderSn,
+		"valid_from": item.ValidFrom,
+		"valid_to":   item.ValidTo,
+		"template":   templateToMap(item.Template),
+	}
+}
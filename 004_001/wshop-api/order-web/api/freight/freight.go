@@ -0,0 +1,136 @@
+package freight
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/forms"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/proto"
+	"wshop-api/order-web/utils/money"
+)
+
+func templateToMap(item *proto.FreightTemplateInfoResponse) gin.H {
+	rules := make([]interface{}, 0)
+	for _, rule := range item.Rules {
+		rules = append(rules, gin.H{
+			"id":              rule.Id,
+			"province":        rule.Province,
+			"city":            rule.City,
+			"first_unit":      rule.FirstUnit,
+			"first_fee":       money.FromCents(rule.FirstFeeCents).Yuan(),
+			"additional_unit": rule.AdditionalUnit,
+			"additional_fee":  money.FromCents(rule.AdditionalFeeCents).Yuan(),
+		})
+	}
+	return gin.H{
+		"id":             item.Id,
+		"name":           item.Name,
+		"charge_type":    item.ChargeType,
+		"free_threshold": money.FromCents(item.FreeThresholdCents).Yuan(),
+		"is_default":     item.IsDefault,
+		"rules":          rules,
+	}
+}
+
+func formToRequest(templateForm forms.FreightTemplateForm) *proto.FreightTemplateRequest {
+	request := &proto.FreightTemplateRequest{
+		Name:               templateForm.Name,
+		ChargeType:         templateForm.ChargeType,
+		FreeThresholdCents: money.FromYuan(templateForm.FreeThreshold).Cents(),
+		IsDefault:          templateForm.IsDefault,
+	}
+	for _, rule := range templateForm.Rules {
+		request.Rules = append(request.Rules, &proto.FreightRuleInfo{
+			Province:           rule.Province,
+			City:               rule.City,
+			FirstUnit:          rule.FirstUnit,
+			FirstFeeCents:      money.FromYuan(rule.FirstFee).Cents(),
+			AdditionalUnit:     rule.AdditionalUnit,
+			AdditionalFeeCents: money.FromYuan(rule.AdditionalFee).Cents(),
+		})
+	}
+	return request
+}
+
+func List(ctx *gin.Context) {
+	rsp, err := global.OrderSrvClient.FreightTemplateList(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		zap.S().Errorw("获取运费模板列表失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	templateList := make([]interface{}, 0)
+	for _, item := range rsp.Data {
+		templateList = append(templateList, templateToMap(item))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  templateList,
+	})
+}
+
+func New(ctx *gin.Context) {
+	templateForm := forms.FreightTemplateForm{}
+	if err := ctx.ShouldBindJSON(&templateForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.CreateFreightTemplate(context.Background(), formToRequest(templateForm))
+	if err != nil {
+		zap.S().Errorw("新建运费模板失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, templateToMap(rsp))
+}
+
+func Update(ctx *gin.Context) {
+	i, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	templateForm := forms.FreightTemplateForm{}
+	if err := ctx.ShouldBindJSON(&templateForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	request := formToRequest(templateForm)
+	request.Id = int32(i)
+	if _, err := global.OrderSrvClient.UpdateFreightTemplate(context.Background(), request); err != nil {
+		zap.S().Errorw("修改运费模板失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "更新成功",
+	})
+}
+
+func Delete(ctx *gin.Context) {
+	i, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if _, err := global.OrderSrvClient.DeleteFreightTemplate(context.Background(), &proto.FreightTemplateRequest{Id: int32(i)}); err != nil {
+		zap.S().Errorw("删除运费模板失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "删除成功",
+	})
+}
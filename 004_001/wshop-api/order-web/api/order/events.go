@@ -0,0 +1,111 @@
+package order
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/proto"
+)
+
+// 没有状态变化的时候定时发送注释行， 避免连接被代理服务器当作空闲连接断开
+const eventsHeartbeat = 15 * time.Second
+
+// Events 使用Server-Sent Events推送订单状态的变化， 结算页面等待支付结果的时候使用
+// 连接之后先推送一次订单当前的状态， 之后订单状态每变化一次推送一次， 断开之后客户端重新连接
+func Events(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+	userId, _ := ctx.Get("userId")
+
+	// 客户端断开的时候取消订阅
+	streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+	defer cancel()
+	stream, err := global.OrderSrvClient.WatchOrders(streamCtx, &proto.WatchOrdersRequest{
+		UserId:   int32(userId.(uint)),
+		OrderIds: []int32{int32(i)},
+	})
+	if err != nil {
+		zap.S().Errorw("订阅订单状态失败", "msg", err.Error())
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	// 订阅之后再查询订单， 不会漏掉订阅之前的变化， 同时校验只能订阅自己的订单
+	rsp, err := global.OrderSrvClient.OrderDetail(context.Background(), &proto.OrderRequest{
+		Id:     int32(i),
+		UserId: int32(userId.(uint)),
+	})
+	if err != nil {
+		zap.S().Errorw("获取订单详情失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	events := make(chan *proto.OrderEventResponse)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case events <- e:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+
+	// 禁止nginx缓存推送的内容
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.SSEvent("status", gin.H{
+		"id":       rsp.OrderInfo.Id,
+		"order_sn": rsp.OrderInfo.OrderSn,
+		"status":   rsp.OrderInfo.Status,
+	})
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(eventsHeartbeat)
+	defer heartbeat.Stop()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case e := <-events:
+			ctx.SSEvent("status", gin.H{
+				"id":          e.OrderId,
+				"order_sn":    e.OrderSn,
+				"status":      e.Status,
+				"from_status": e.FromStatus,
+				"time":        e.Time,
+			})
+			return true
+		case <-heartbeat.C:
+			_, _ = w.Write([]byte(": ping\n\n"))
+			return true
+		case err := <-errs:
+			if streamCtx.Err() == nil {
+				zap.S().Warnf("订单状态推送断开: %s", err.Error())
+				ctx.SSEvent("error", gin.H{"msg": "推送已断开， 请重新连接"})
+			}
+			return false
+		case <-streamCtx.Done():
+			return false
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package order
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+	"wshop-api/order-web/utils/money"
+)
+
+// parseFilter 从query参数中解析订单列表的过滤条件
+//
+//	status=TRADE_SUCCESS,TRADE_SHIPPED        订单状态， 多个状态用逗号分隔
+//	created_start=2021-03-01&created_end=...   下单时间， 只传日期的时候结束时间包含当天
+//	paid_start=...&paid_end=...                支付时间
+//	order_sn=...                               订单号
+//	min_amount=10.5&max_amount=100             订单金额， 单位为元
+//	goods=...                                  商品名称
+//	user=1&mobile=...                          用户id和收货人手机号， 只有管理员可以使用
+func parseFilter(ctx *gin.Context, claims *models.CustomClaims, userId uint) (*proto.OrderFilterRequest, error) {
+	request := proto.OrderFilterRequest{}
+
+	// 如果是管理员用户则返回所有的订单
+	if claims.AuthorityId == 1 {
+		request.UserId = int32(userId)
+	} else {
+		if user := ctx.Query("user"); user != "" {
+			userInt, err := strconv.Atoi(user)
+			if err != nil {
+				return nil, errors.New("user格式出错")
+			}
+			request.UserId = int32(userInt)
+		}
+		request.Mobile = ctx.Query("mobile")
+	}
+
+	pagesInt, _ := strconv.Atoi(ctx.DefaultQuery("p", "0"))
+	request.Pages = int32(pagesInt)
+
+	perNumsInt, _ := strconv.Atoi(ctx.DefaultQuery("pnum", "0"))
+	request.PagePerNums = int32(perNumsInt)
+
+	for _, statuses := range ctx.QueryArray("status") {
+		for _, s := range strings.Split(statuses, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				request.Status = append(request.Status, s)
+			}
+		}
+	}
+
+	var err error
+	if request.CreatedStart, err = parseTime(ctx.Query("created_start"), false); err != nil {
+		return nil, errors.New("created_start格式出错")
+	}
+	if request.CreatedEnd, err = parseTime(ctx.Query("created_end"), true); err != nil {
+		return nil, errors.New("created_end格式出错")
+	}
+	if request.PaidStart, err = parseTime(ctx.Query("paid_start"), false); err != nil {
+		return nil, errors.New("paid_start格式出错")
+	}
+	if request.PaidEnd, err = parseTime(ctx.Query("paid_end"), true); err != nil {
+		return nil, errors.New("paid_end格式出错")
+	}
+
+	if minAmount := ctx.Query("min_amount"); minAmount != "" {
+		m, err := money.Parse(minAmount)
+		if err != nil {
+			return nil, errors.New("min_amount格式出错")
+		}
+		request.MinAmountCents = m.Cents()
+	}
+	if maxAmount := ctx.Query("max_amount"); maxAmount != "" {
+		m, err := money.Parse(maxAmount)
+		if err != nil {
+			return nil, errors.New("max_amount格式出错")
+		}
+		request.MaxAmountCents = m.Cents()
+	}
+
+	request.OrderSn = strings.TrimSpace(ctx.Query("order_sn"))
+	request.GoodsName = strings.TrimSpace(ctx.Query("goods"))
+	return &request, nil
+}
+
+// parseTime 支持日期和日期时间两种格式， 返回unix时间戳
+// 作为结束时间并且只传了日期的时候， 返回第二天的零点， 这样结束日期当天的订单也能查到
+func parseTime(s string, end bool) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return 0, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t.Unix(), nil
+}
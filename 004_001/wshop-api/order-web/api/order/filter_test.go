@@ -0,0 +1,87 @@
+package order
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+)
+
+func filterRequest(t *testing.T, query string, authority uint) (*proto.OrderFilterRequest, error) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/v1/orders?"+query, nil)
+	return parseFilter(ctx, &models.CustomClaims{ID: 5, AuthorityId: authority}, 5)
+}
+
+func TestParseFilterUser(t *testing.T) {
+	// 普通用户只能查询自己的订单， user和mobile参数被忽略
+	request, err := filterRequest(t, "user=2&mobile=13800000000", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.UserId != 5 || request.Mobile != "" {
+		t.Errorf("普通用户: user = %d, mobile = %q", request.UserId, request.Mobile)
+	}
+
+	// 管理员可以按照用户和手机号过滤， 不传的时候查询所有的订单
+	request, err = filterRequest(t, "user=2&mobile=13800000000", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.UserId != 2 || request.Mobile != "13800000000" {
+		t.Errorf("管理员: user = %d, mobile = %q", request.UserId, request.Mobile)
+	}
+	if request, _ = filterRequest(t, "", 2); request.UserId != 0 {
+		t.Errorf("管理员不传user的时候应该查询所有的订单, user = %d", request.UserId)
+	}
+	if _, err = filterRequest(t, "user=abc", 2); err == nil {
+		t.Error("user格式错误的时候应该返回错误")
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	day := time.Date(2021, 3, 1, 0, 0, 0, 0, time.Local)
+	request, err := filterRequest(t, "p=2&pnum=20&status=TRADE_SUCCESS,+TRADE_SHIPPED,&status=TRADE_CLOSED"+
+		"&created_start=2021-03-01&created_end=2021-03-01&paid_start=2021-03-01+08:30:00&paid_end=2021-03-02+00:00:00"+
+		"&min_amount=10.5&max_amount=100&order_sn=+sn1+&goods=+50%25+", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &proto.OrderFilterRequest{
+		UserId:         5,
+		Pages:          2,
+		PagePerNums:    20,
+		Status:         []string{"TRADE_SUCCESS", "TRADE_SHIPPED", "TRADE_CLOSED"},
+		CreatedStart:   day.Unix(),
+		CreatedEnd:     day.AddDate(0, 0, 1).Unix(), // 只传日期的时候包含结束日期当天
+		PaidStart:      day.Add(8*time.Hour + 30*time.Minute).Unix(),
+		PaidEnd:        day.AddDate(0, 0, 1).Unix(),
+		MinAmountCents: 1050,
+		MaxAmountCents: 10000,
+		OrderSn:        "sn1",
+		GoodsName:      "50%", // 通配符由订单服务转义
+	}
+	if !protobuf.Equal(request, want) {
+		t.Errorf("request = %v\nwant %v", request, want)
+	}
+
+	for _, query := range []string{
+		"created_start=2021-13-01",
+		"created_end=yesterday",
+		"paid_start=2021/03/01",
+		"paid_end=1614556800",
+		"min_amount=1.234",
+		"max_amount=abc",
+	} {
+		if _, err := filterRequest(t, query, 1); err == nil {
+			t.Errorf("%s: 应该返回错误", query)
+		}
+	}
+}
@@ -3,13 +3,13 @@ package order
 import (
 	"context"
 	"github.com/gin-gonic/gin"
-	"github.com/smartwalle/alipay/v3"
 	"go.uber.org/zap"
 	"net/http"
 	"strconv"
 	"wshop-api/order-web/forms"
 	"wshop-api/order-web/global"
 	"wshop-api/order-web/proto"
+	"wshop-api/order-web/utils/money"
 
 	"wshop-api/order-web/api"
 	"wshop-api/order-web/models"
@@ -20,26 +20,15 @@ func List(ctx *gin.Context) {
 	userId, _ := ctx.Get("userId")
 	claims, _ := ctx.Get("claims")
 
-	request := proto.OrderFilterRequest{}
-
-	// 如果是管理员用户则返回所有的订单
-	model := claims.(*models.CustomClaims)
-	if model.AuthorityId == 1 {
-		request.UserId = int32(userId.(uint))
+	request, err := parseFilter(ctx, claims.(*models.CustomClaims), userId.(uint))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
 	}
 
-	pages := ctx.DefaultQuery("p", "0")
-	pagesInt, _ := strconv.Atoi(pages)
-	request.Pages = int32(pagesInt)
-
-	perNums := ctx.DefaultQuery("pnum", "0")
-	perNumsInt, _ := strconv.Atoi(perNums)
-	request.PagePerNums = int32(perNumsInt)
-
-	request.Pages = int32(pagesInt)
-	request.PagePerNums = int32(perNumsInt)
-
-	rsp, err := global.OrderSrvClient.OrderList(context.Background(), &request)
+	rsp, err := global.OrderSrvClient.OrderList(context.Background(), request)
 	if err != nil {
 		zap.S().Errorw("获取订单列表失败")
 		api.HandleGrpcErrorToHttp(err, ctx)
@@ -70,12 +59,18 @@ func List(ctx *gin.Context) {
 		tmpMap["user"] = item.UserId
 		tmpMap["post"] = item.Post
 		tmpMap["total"] = item.Total
+		tmpMap["discount"] = money.FromCents(item.DiscountCents).Yuan()
+		tmpMap["freight"] = money.FromCents(item.FreightCents).Yuan()
+		tmpMap["province"] = item.Province
+		tmpMap["city"] = item.City
 		tmpMap["address"] = item.Address
 		tmpMap["name"] = item.Name
 		tmpMap["mobile"] = item.Mobile
 		tmpMap["order_sn"] = item.OrderSn
 		tmpMap["id"] = item.Id
 		tmpMap["add_time"] = item.AddTime
+		tmpMap["pay_time"] = item.PayTime
+		tmpMap["expire_at"] = item.ExpireAt
 
 		orderList = append(orderList, tmpMap)
 	}
@@ -91,11 +86,18 @@ func New(ctx *gin.Context) {
 	}
 	userId, _ := ctx.Get("userId")
 	rsp, err := global.OrderSrvClient.CreateOrder(context.WithValue(context.Background(), "ginContext", ctx), &proto.OrderRequest{
-		UserId:  int32(userId.(uint)),
-		Name:    orderForm.Name,
-		Mobile:  orderForm.Mobile,
-		Address: orderForm.Address,
-		Post:    orderForm.Post,
+		UserId:   int32(userId.(uint)),
+		Name:     orderForm.Name,
+		Mobile:   orderForm.Mobile,
+		Province: orderForm.Province,
+		City:     orderForm.City,
+		Address:  orderForm.Address,
+		Post:     orderForm.Post,
+
+		CouponIds: orderForm.CouponIds,
+		PayType:   orderForm.PayType,
+		// 客户端超时重试的时候带上相同的Idempotency-Key， 不会重复下单
+		IdempotencyKey: ctx.GetHeader("Idempotency-Key"),
 	})
 	if err != nil {
 		zap.S().Errorw("新建订单失败")
@@ -103,44 +105,65 @@ func New(ctx *gin.Context) {
 		return
 	}
 
-	// 生成支付宝的支付url
-	client, err := alipay.New(global.ServerConfig.AliPayInfo.AppID, global.ServerConfig.AliPayInfo.PrivateKey, false)
+	// 订单已经创建成功了， 生成支付链接失败的时候返回订单id， 客户端可以在订单详情中重新发起支付
+	payInfo, err := payOrder(ctx, rsp.Id, int32(userId.(uint)), orderForm.PayType)
 	if err != nil {
-		zap.S().Errorw("实例化支付宝失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
+		zap.S().Errorw("发起支付失败", "order_sn", rsp.OrderSn, "msg", err.Error())
+		ctx.JSON(http.StatusOK, gin.H{
+			"id":        rsp.Id,
+			"expire_at": rsp.ExpireAt,
+			"msg":       "生成支付链接失败， 请在订单详情中重新支付",
 		})
 		return
 	}
-	err = client.LoadAliPayPublicKey((global.ServerConfig.AliPayInfo.AliPublicKey))
-	if err != nil {
-		zap.S().Errorw("加载支付宝的公钥失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
-		})
+	payInfo["id"] = rsp.Id
+	ctx.JSON(http.StatusOK, payInfo)
+}
+
+// Preview 结算预览， 返回商品当前的价格、 是否在售、 可用库存和应付金额
+func Preview(ctx *gin.Context) {
+	previewForm := forms.PreviewOrderForm{}
+	if err := ctx.ShouldBindJSON(&previewForm); err != nil {
+		api.HandleValidatorError(ctx, err)
 		return
 	}
 
-	var p = alipay.TradePagePay{}
-	p.NotifyURL = global.ServerConfig.AliPayInfo.NotifyURL
-	p.ReturnURL = global.ServerConfig.AliPayInfo.ReturnURL
-	p.Subject = "慕学生鲜订单-" + rsp.OrderSn
-	p.OutTradeNo = rsp.OrderSn
-	p.TotalAmount = strconv.FormatFloat(float64(rsp.Total), 'f', 2, 64)
-	p.ProductCode = "FAST_INSTANT_TRADE_PAY"
-
-	url, err := client.TradePagePay(p)
+	userId, _ := ctx.Get("userId")
+	rsp, err := global.OrderSrvClient.PreviewOrder(context.Background(), &proto.OrderRequest{
+		UserId:    int32(userId.(uint)),
+		Province:  previewForm.Province,
+		City:      previewForm.City,
+		CouponIds: previewForm.CouponIds,
+	})
 	if err != nil {
-		zap.S().Errorw("生成支付url失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
-		})
+		zap.S().Errorw("结算预览失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
 		return
 	}
 
+	goodsList := make([]interface{}, 0)
+	for _, item := range rsp.Goods {
+		goodsList = append(goodsList, gin.H{
+			"goods_id":      item.GoodsId,
+			"name":          item.GoodsName,
+			"image":         item.GoodsImage,
+			"nums":          item.Nums,
+			"price":         money.FromCents(item.PriceCents).Yuan(),
+			"cart_price":    money.FromCents(item.CartPriceCents).Yuan(),
+			"price_changed": item.PriceChanged,
+			"on_sale":       item.OnSale,
+			"stock":         item.Stock,
+			"amount":        money.FromCents(item.AmountCents).Yuan(),
+		})
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{
-		"id":         rsp.Id,
-		"alipay_url": url.String(),
+		"goods":    goodsList,
+		"amount":   money.FromCents(rsp.GoodsCents).Yuan(),
+		"shipping": money.FromCents(rsp.ShippingCents).Yuan(),
+		"discount": money.FromCents(rsp.DiscountCents).Yuan(),
+		"total":    money.FromCents(rsp.TotalCents).Yuan(),
+		"payable":  rsp.Payable,
 	})
 }
 
@@ -177,61 +200,119 @@ func Detail(ctx *gin.Context) {
 	reMap["user"] = rsp.OrderInfo.UserId
 	reMap["post"] = rsp.OrderInfo.Post
 	reMap["total"] = rsp.OrderInfo.Total
+	reMap["discount"] = money.FromCents(rsp.OrderInfo.DiscountCents).Yuan()
+	reMap["freight"] = money.FromCents(rsp.OrderInfo.FreightCents).Yuan()
+	reMap["province"] = rsp.OrderInfo.Province
+	reMap["city"] = rsp.OrderInfo.City
 	reMap["address"] = rsp.OrderInfo.Address
 	reMap["name"] = rsp.OrderInfo.Name
 	reMap["mobile"] = rsp.OrderInfo.Mobile
 	reMap["pay_type"] = rsp.OrderInfo.PayType
 	reMap["order_sn"] = rsp.OrderInfo.OrderSn
+	reMap["add_time"] = rsp.OrderInfo.AddTime
+	reMap["pay_time"] = rsp.OrderInfo.PayTime
+	reMap["expire_at"] = rsp.OrderInfo.ExpireAt
 
 	goodsList := make([]interface{}, 0)
 	for _, item := range rsp.Goods {
 		tmpMap := gin.H{
-			"id":    item.GoodsId,
-			"name":  item.GoodsName,
-			"image": item.GoodsImage,
-			"price": item.GoodsPrice,
-			"nums":  item.Nums,
+			"id":             item.GoodsId,
+			"order_goods_id": item.Id,
+			"name":           item.GoodsName,
+			"image":          item.GoodsImage,
+			"price":          item.GoodsPrice,
+			"nums":           item.Nums,
 		}
 
 		goodsList = append(goodsList, tmpMap)
 	}
 	reMap["goods"] = goodsList
 
-	// 生成支付宝的支付url
-	client, err := alipay.New(global.ServerConfig.AliPayInfo.AppID, global.ServerConfig.AliPayInfo.PrivateKey, false)
-	if err != nil {
-		zap.S().Errorw("实例化支付宝失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
+	historyList := make([]interface{}, 0)
+	for _, item := range rsp.History {
+		historyList = append(historyList, gin.H{
+			"from_status": item.FromStatus,
+			"to_status":   item.ToStatus,
+			"remark":      item.Remark,
+			"add_time":    item.AddTime,
 		})
-		return
 	}
-	err = client.LoadAliPayPublicKey((global.ServerConfig.AliPayInfo.AliPublicKey))
+	reMap["status_history"] = historyList
+
+	// 物流信息， 查询失败不影响订单详情
+	shipments, err := global.OrderSrvClient.ShipmentList(context.Background(), &proto.OrderRequest{Id: rsp.OrderInfo.Id})
+	if err != nil {
+		zap.S().Errorw("获取订单物流失败", "msg", err.Error())
+		reMap["shipments"] = make([]interface{}, 0)
+	} else {
+		reMap["shipments"] = shipmentsToList(shipments)
+	}
+
+	// 用户查看待支付的订单时返回支付链接， 生成失败不影响订单详情
+	switch rsp.OrderInfo.Status {
+	case "WAIT_BUYER_PAY", "PAYING":
+		if request.UserId == 0 {
+			break
+		}
+		payInfo, err := payOrder(ctx, rsp.OrderInfo.Id, request.UserId, "")
+		if err != nil {
+			zap.S().Errorw("发起支付失败", "order_sn", rsp.OrderInfo.OrderSn, "msg", err.Error())
+			break
+		}
+		for k, v := range payInfo {
+			if k != "expire_at" {
+				reMap[k] = v
+			}
+		}
+	}
+
+	ctx.JSON(http.StatusOK, reMap)
+}
+
+func Cancel(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
 	if err != nil {
-		zap.S().Errorw("加载支付宝的公钥失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
 		})
 		return
 	}
 
-	var p = alipay.TradePagePay{}
-	p.NotifyURL = global.ServerConfig.AliPayInfo.NotifyURL
-	p.ReturnURL = global.ServerConfig.AliPayInfo.ReturnURL
-	p.Subject = "慕学生鲜订单-" + rsp.OrderInfo.OrderSn
-	p.OutTradeNo = rsp.OrderInfo.OrderSn
-	p.TotalAmount = strconv.FormatFloat(float64(rsp.OrderInfo.Total), 'f', 2, 64)
-	p.ProductCode = "FAST_INSTANT_TRADE_PAY"
+	// 只能取消自己的订单， 管理员也一样
+	userId, _ := ctx.Get("userId")
+	_, err = global.OrderSrvClient.CancelOrder(context.Background(), &proto.OrderRequest{
+		Id:     int32(i),
+		UserId: int32(userId.(uint)),
+	})
+	if err != nil {
+		zap.S().Errorw("取消订单失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
 
-	url, err := client.TradePagePay(p)
+func Confirm(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
 	if err != nil {
-		zap.S().Errorw("生成支付url失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
 		})
 		return
 	}
-	reMap["alipay_url"] = url.String()
 
-	ctx.JSON(http.StatusOK, reMap)
+	// 只能确认自己的订单， 超时没有确认的订单由订单服务自动确认收货
+	userId, _ := ctx.Get("userId")
+	_, err = global.OrderSrvClient.ConfirmReceipt(context.Background(), &proto.OrderRequest{
+		Id:     int32(i),
+		UserId: int32(userId.(uint)),
+	})
+	if err != nil {
+		zap.S().Errorw("确认收货失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
 }
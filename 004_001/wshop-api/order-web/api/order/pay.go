@@ -0,0 +1,99 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/forms"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/payment"
+	"wshop-api/order-web/proto"
+	"wshop-api/order-web/utils/money"
+)
+
+// payOrder 发起支付， 返回跳转的支付页面或者二维码的内容
+// payType为空的时候使用下单时选择的支付方式， 切换了支付方式的时候先关闭之前支付方式中的交易
+func payOrder(ctx *gin.Context, orderId, userId int32, payType string) (gin.H, error) {
+	rsp, err := global.OrderSrvClient.CreatePayment(context.Background(), &proto.PaymentRequest{
+		OrderId: orderId,
+		UserId:  userId,
+		PayType: payType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	provider, err := payment.Get(rsp.PayType)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if rsp.PreviousPayType != "" {
+		if previous, err := payment.Get(rsp.PreviousPayType); err == nil {
+			if err := previous.CloseTrade(context.Background(), rsp.OrderSn); err != nil {
+				zap.S().Warnf("关闭订单 %s 之前的%s交易失败: %s", rsp.OrderSn, rsp.PreviousPayType, err.Error())
+			}
+		}
+	}
+
+	request := &payment.PayRequest{
+		OrderSn:  rsp.OrderSn,
+		Subject:  "慕学生鲜订单-" + rsp.OrderSn,
+		Amount:   money.FromCents(rsp.AmountCents),
+		ClientIP: ctx.ClientIP(),
+	}
+	if rsp.ExpireAt != "" {
+		request.ExpireAt, _ = time.ParseInLocation("2006-01-02 15:04:05", rsp.ExpireAt, time.Local)
+	}
+	result, err := provider.Pay(context.Background(), request)
+	if err != nil {
+		zap.S().Errorw("生成支付链接失败", "pay_type", rsp.PayType, "msg", err.Error())
+		return nil, status.Errorf(codes.Internal, "生成支付链接失败")
+	}
+
+	payInfo := gin.H{
+		"pay_type":  rsp.PayType,
+		"pay_url":   result.PayURL,
+		"qr_code":   result.QRCode,
+		"expire_at": rsp.ExpireAt, // 超过之后没有支付的订单自动关闭
+	}
+	// 之前的版本只支持支付宝， 客户端使用alipay_url跳转
+	if rsp.PayType == "alipay" {
+		payInfo["alipay_url"] = result.PayURL
+	}
+	return payInfo, nil
+}
+
+// Pay 待支付的订单发起支付， 可以切换支付方式
+func Pay(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	payForm := forms.PayOrderForm{}
+	if err := ctx.ShouldBindJSON(&payForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	userId, _ := ctx.Get("userId")
+	payInfo, err := payOrder(ctx, int32(i), int32(userId.(uint)), payForm.PayType)
+	if err != nil {
+		zap.S().Errorw("发起支付失败", "id", i, "msg", err.Error())
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, payInfo)
+}
@@ -0,0 +1,127 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/forms"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+)
+
+func Ship(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	shipForm := forms.ShipOrderForm{}
+	if err := ctx.ShouldBindJSON(&shipForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	request := proto.ShipOrderRequest{
+		OrderId:    int32(i),
+		Carrier:    shipForm.Carrier,
+		TrackingNo: shipForm.TrackingNo,
+	}
+	for _, item := range shipForm.Goods {
+		request.Goods = append(request.Goods, &proto.ShipGoodsItem{
+			OrderGoodsId: item.OrderGoodsId,
+			Nums:         item.Nums,
+		})
+	}
+
+	rsp, err := global.OrderSrvClient.ShipOrder(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("订单发货失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, shipmentToMap(rsp))
+}
+
+func Shipments(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	// 如果是管理员用户则可以查看所有订单的物流
+	request := proto.OrderRequest{
+		Id: int32(i),
+	}
+	userId, _ := ctx.Get("userId")
+	claims, _ := ctx.Get("claims")
+	model := claims.(*models.CustomClaims)
+	if model.AuthorityId == 1 {
+		request.UserId = int32(userId.(uint))
+	}
+
+	rsp, err := global.OrderSrvClient.ShipmentList(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("获取订单物流失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  shipmentsToList(rsp),
+	})
+}
+
+func shipmentsToList(rsp *proto.ShipmentListResponse) []interface{} {
+	shipmentList := make([]interface{}, 0)
+	for _, item := range rsp.Data {
+		shipmentList = append(shipmentList, shipmentToMap(item))
+	}
+	return shipmentList
+}
+
+func shipmentToMap(item *proto.ShipmentInfoResponse) gin.H {
+	goodsList := make([]interface{}, 0)
+	for _, goods := range item.Goods {
+		goodsList = append(goodsList, gin.H{
+			"order_goods_id": goods.OrderGoodsId,
+			"goods_id":       goods.GoodsId,
+			"name":           goods.GoodsName,
+			"nums":           goods.Nums,
+		})
+	}
+
+	// 物流轨迹按照时间先后排列
+	eventList := make([]interface{}, 0)
+	for _, event := range item.Events {
+		eventList = append(eventList, gin.H{
+			"status":      event.Status,
+			"description": event.Description,
+			"location":    event.Location,
+			"time":        event.EventTime,
+		})
+	}
+
+	return gin.H{
+		"id":          item.Id,
+		"carrier":     item.Carrier,
+		"tracking_no": item.TrackingNo,
+		"status":      item.Status,
+		"ship_time":   item.ShipTime,
+		"goods":       goodsList,
+		"events":      eventList,
+	}
+}
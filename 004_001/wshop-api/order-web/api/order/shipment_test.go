@@ -0,0 +1,92 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/middlewares"
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+)
+
+// fakeShipment 订单服务， 记录收到的发货和物流查询请求
+type fakeShipment struct {
+	proto.OrderClient
+	ship *proto.ShipOrderRequest
+	list *proto.OrderRequest
+}
+
+func (f *fakeShipment) ShipOrder(ctx context.Context, in *proto.ShipOrderRequest, opts ...grpc.CallOption) (*proto.ShipmentInfoResponse, error) {
+	f.ship = in
+	return &proto.ShipmentInfoResponse{Id: 1, OrderId: in.OrderId, Carrier: in.Carrier, TrackingNo: in.TrackingNo}, nil
+}
+
+func (f *fakeShipment) ShipmentList(ctx context.Context, in *proto.OrderRequest, opts ...grpc.CallOption) (*proto.ShipmentListResponse, error) {
+	f.list = in
+	return &proto.ShipmentListResponse{}, nil
+}
+
+// shipmentRouter 和线上的路由一样， 发货需要管理员权限， JWT认证用直接设置claims代替
+func shipmentRouter(client *fakeShipment, authority uint) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(ctx *gin.Context) {
+		ctx.Set("claims", &models.CustomClaims{ID: 5, AuthorityId: authority})
+		ctx.Set("userId", uint(5))
+	})
+	r.POST("/o/v1/orders/:id/ship", middlewares.IsAdminAuth(), Ship)
+	r.GET("/o/v1/orders/:id/shipments", Shipments)
+	return r
+}
+
+func TestShipAdminOnly(t *testing.T) {
+	old := global.OrderSrvClient
+	t.Cleanup(func() { global.OrderSrvClient = old })
+	client := &fakeShipment{}
+	global.OrderSrvClient = client
+
+	body := `{"carrier":"SF","tracking_no":"SF001","goods":[{"order_goods_id":3,"nums":1}]}`
+	ship := func(authority uint) int {
+		w := httptest.NewRecorder()
+		shipmentRouter(client, authority).ServeHTTP(w, httptest.NewRequest("POST", "/o/v1/orders/7/ship", strings.NewReader(body)))
+		return w.Code
+	}
+
+	// 普通用户不能发货， 请求不会发送到订单服务
+	if code := ship(1); code != http.StatusForbidden || client.ship != nil {
+		t.Fatalf("普通用户发货 code = %d, request = %v", code, client.ship)
+	}
+	if code := ship(2); code != http.StatusOK {
+		t.Fatalf("管理员发货 code = %d", code)
+	}
+	if req := client.ship; req.OrderId != 7 || req.Carrier != "SF" || req.TrackingNo != "SF001" ||
+		len(req.Goods) != 1 || req.Goods[0].OrderGoodsId != 3 || req.Goods[0].Nums != 1 {
+		t.Errorf("request = %v", req)
+	}
+}
+
+func TestShipmentsUser(t *testing.T) {
+	old := global.OrderSrvClient
+	t.Cleanup(func() { global.OrderSrvClient = old })
+	client := &fakeShipment{}
+	global.OrderSrvClient = client
+
+	// 普通用户只能查看自己订单的物流， 管理员可以查看所有订单的物流
+	for authority, userId := range map[uint]int32{1: 5, 2: 0} {
+		w := httptest.NewRecorder()
+		shipmentRouter(client, authority).ServeHTTP(w, httptest.NewRequest("GET", "/o/v1/orders/7/shipments", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("authority %d: code = %d", authority, w.Code)
+		}
+		if client.list.Id != 7 || client.list.UserId != userId {
+			t.Errorf("authority %d: request = %v", authority, client.list)
+		}
+	}
+}
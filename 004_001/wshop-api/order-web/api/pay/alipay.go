@@ -1,48 +0,0 @@
-package pay
-
-import (
-	"context"
-	"github.com/gin-gonic/gin"
-	"github.com/smartwalle/alipay/v3"
-	"go.uber.org/zap"
-	"net/http"
-	"wshop-api/order-web/proto"
-
-	"wshop-api/order-web/global"
-)
-
-func Notify(ctx *gin.Context) {
-	// 支付宝回调通知
-	client, err := alipay.New(global.ServerConfig.AliPayInfo.AppID, global.ServerConfig.AliPayInfo.PrivateKey, false)
-	if err != nil {
-		zap.S().Errorw("实例化支付宝失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
-		})
-		return
-	}
-	err = client.LoadAliPayPublicKey((global.ServerConfig.AliPayInfo.AliPublicKey))
-	if err != nil {
-		zap.S().Errorw("加载支付宝的公钥失败")
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"msg": err.Error(),
-		})
-		return
-	}
-
-	noti, err := client.GetTradeNotification(ctx.Request)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{})
-		return
-	}
-
-	_, err = global.OrderSrvClient.UpdateOrderStatus(context.Background(), &proto.OrderStatus{
-		OrderSn: noti.OutTradeNo,
-		Status:  string(noti.TradeStatus),
-	})
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{})
-		return
-	}
-	ctx.String(http.StatusOK, "success")
-}
@@ -0,0 +1,103 @@
+package pay
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/payment"
+)
+
+var cashierTemplate = template.Must(template.New("cashier").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>模拟收银台</title></head>
+<body>
+<h3>模拟收银台</h3>
+<p>订单号: {{.OrderSn}}</p>
+<p>商品: {{.Subject}}</p>
+<p>金额: {{.Amount}} 元</p>
+<form method="post">
+<input type="hidden" name="out_trade_no" value="{{.OrderSn}}">
+<input type="hidden" name="subject" value="{{.Subject}}">
+<input type="hidden" name="total_amount" value="{{.Amount}}">
+<input type="hidden" name="sign" value="{{.Sign}}">
+<button type="submit" name="action" value="pay">确认支付</button>
+<button type="submit" name="action" value="cancel">取消支付</button>
+</form>
+</body>
+</html>`))
+
+func mockProvider(ctx *gin.Context) *payment.Mock {
+	provider, err := payment.Get("mock")
+	if err != nil {
+		ctx.String(http.StatusNotFound, "模拟支付没有开启")
+		return nil
+	}
+	mock, ok := provider.(*payment.Mock)
+	if !ok {
+		ctx.String(http.StatusNotFound, "模拟支付没有开启")
+		return nil
+	}
+	return mock
+}
+
+// MockCashier 模拟收银台页面， 链接由模拟支付渠道签名， 不能修改订单号和金额
+func MockCashier(ctx *gin.Context) {
+	mock := mockProvider(ctx)
+	if mock == nil {
+		return
+	}
+	values := ctx.Request.URL.Query()
+	trade, err := mock.Cashier(values)
+	if err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if trade.Status != "WAIT_BUYER_PAY" {
+		ctx.String(http.StatusOK, "交易已经结束: "+trade.Status)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	ctx.Status(http.StatusOK)
+	_ = cashierTemplate.Execute(ctx.Writer, gin.H{
+		"OrderSn": trade.OrderSn,
+		"Subject": values.Get("subject"),
+		"Amount":  trade.Amount.String(),
+		"Sign":    values.Get("sign"),
+	})
+}
+
+// MockPay 在模拟收银台确认支付或者取消支付， 回调通知异步发送
+func MockPay(ctx *gin.Context) {
+	mock := mockProvider(ctx)
+	if mock == nil {
+		return
+	}
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	values := ctx.Request.PostForm
+	paid := values.Get("action") == "pay"
+	values.Del("action")
+	trade, err := mock.Cashier(values)
+	if err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	returnURL, err := mock.Complete(trade.OrderSn, paid)
+	if err != nil {
+		zap.S().Warnw("模拟支付失败", "order_sn", trade.OrderSn, "msg", err.Error())
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if returnURL == "" {
+		ctx.String(http.StatusOK, "支付完成")
+		return
+	}
+	ctx.Redirect(http.StatusFound, returnURL)
+}
@@ -0,0 +1,68 @@
+package pay
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/payment"
+	"wshop-api/order-web/proto"
+)
+
+// Notify 支付平台的回调通知， 每个支付方式使用单独的回调地址
+// 只有应答成功之后支付平台才会停止通知， 需要支付平台重新通知的情况不能应答成功
+func Notify(payType string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		provider, err := payment.Get(payType)
+		if err != nil {
+			zap.S().Errorw("支付方式没有配置", "pay_type", payType)
+			ctx.JSON(http.StatusNotFound, gin.H{})
+			return
+		}
+
+		// 验签失败的通知可能是伪造的， 不处理
+		noti, err := provider.VerifyNotify(ctx.Request)
+		if err != nil {
+			zap.S().Warnw("支付通知验证失败", "pay_type", payType, "msg", err.Error())
+			ctx.JSON(http.StatusBadRequest, gin.H{})
+			return
+		}
+
+		request := &proto.PayOrderRequest{
+			OrderSn:     noti.OrderSn,
+			PayType:     payType,
+			AppId:       noti.AppId,
+			TradeNo:     noti.TradeNo,
+			TradeStatus: noti.Status,
+			AmountCents: noti.Amount.Cents(),
+		}
+		if !noti.PayTime.IsZero() {
+			request.PayTime = noti.PayTime.Local().Format("2006-01-02 15:04:05")
+		}
+		_, err = global.OrderSrvClient.PayOrder(context.Background(), request)
+		if err != nil {
+			e, _ := status.FromError(err)
+			switch e.Code() {
+			case codes.FailedPrecondition:
+				// 已经关闭或者重复支付的订单重试也没有意义， 告诉支付平台不要再重复通知， 由人工退款
+				zap.S().Errorf("订单 %s 支付通知被拒绝: %s", noti.OrderSn, e.Message())
+				provider.AckNotify(ctx.Writer)
+			case codes.InvalidArgument, codes.NotFound:
+				// 金额、收款应用不一致或者订单不存在， 通知是异常的， 不能应答成功
+				zap.S().Errorf("订单 %s 支付通知异常: %s", noti.OrderSn, e.Message())
+				ctx.JSON(http.StatusBadRequest, gin.H{})
+			default:
+				// 其他错误让支付平台稍后重新通知
+				zap.S().Errorf("订单 %s 支付通知处理失败: %s", noti.OrderSn, e.Message())
+				ctx.JSON(http.StatusInternalServerError, gin.H{})
+			}
+			return
+		}
+		provider.AckNotify(ctx.Writer)
+	}
+}
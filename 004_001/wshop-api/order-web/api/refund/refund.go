@@ -0,0 +1,174 @@
+package refund
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/api"
+	"wshop-api/order-web/forms"
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/models"
+	"wshop-api/order-web/proto"
+)
+
+func List(ctx *gin.Context) {
+	userId, _ := ctx.Get("userId")
+	claims, _ := ctx.Get("claims")
+
+	request := proto.RefundFilterRequest{}
+
+	// 如果是管理员用户则返回所有的退款单
+	model := claims.(*models.CustomClaims)
+	if model.AuthorityId == 1 {
+		request.UserId = int32(userId.(uint))
+	}
+
+	statusInt, _ := strconv.Atoi(ctx.DefaultQuery("status", "0"))
+	request.Status = int32(statusInt)
+
+	pagesInt, _ := strconv.Atoi(ctx.DefaultQuery("p", "0"))
+	request.Pages = int32(pagesInt)
+
+	perNumsInt, _ := strconv.Atoi(ctx.DefaultQuery("pnum", "0"))
+	request.PagePerNums = int32(perNumsInt)
+
+	rsp, err := global.OrderSrvClient.RefundList(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("获取退款列表失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	reMap := gin.H{
+		"total": rsp.Total,
+	}
+	refundList := make([]interface{}, 0)
+	for _, item := range rsp.Data {
+		refundList = append(refundList, refundToMap(item))
+	}
+	reMap["data"] = refundList
+	ctx.JSON(http.StatusOK, reMap)
+}
+
+func New(ctx *gin.Context) {
+	refundForm := forms.CreateRefundForm{}
+	if err := ctx.ShouldBindJSON(&refundForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	userId, _ := ctx.Get("userId")
+	request := proto.RefundRequest{
+		UserId:     int32(userId.(uint)),
+		OrderId:    refundForm.OrderId,
+		RefundType: refundForm.RefundType,
+		Reason:     refundForm.Reason,
+		Images:     refundForm.Images,
+	}
+	for _, item := range refundForm.Goods {
+		request.Goods = append(request.Goods, &proto.RefundGoodsItem{
+			OrderGoodsId: item.OrderGoodsId,
+			Nums:         item.Nums,
+		})
+	}
+
+	rsp, err := global.OrderSrvClient.CreateRefund(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("申请退款失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, refundToMap(rsp))
+}
+
+func Detail(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	request := proto.RefundRequest{
+		Id: int32(i),
+	}
+	userId, _ := ctx.Get("userId")
+	claims, _ := ctx.Get("claims")
+	model := claims.(*models.CustomClaims)
+	if model.AuthorityId == 1 {
+		request.UserId = int32(userId.(uint))
+	}
+
+	rsp, err := global.OrderSrvClient.RefundDetail(context.Background(), &request)
+	if err != nil {
+		zap.S().Errorw("获取退款详情失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, refundToMap(rsp))
+}
+
+func Audit(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "url格式出错",
+		})
+		return
+	}
+
+	auditForm := forms.AuditRefundForm{}
+	if err := ctx.ShouldBindJSON(&auditForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	rsp, err := global.OrderSrvClient.AuditRefund(context.Background(), &proto.RefundAuditRequest{
+		Id:          int32(i),
+		Approved:    *auditForm.Approved,
+		AdminRemark: auditForm.Remark,
+	})
+	if err != nil {
+		zap.S().Errorw("审核退款失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, refundToMap(rsp))
+}
+
+func refundToMap(item *proto.RefundInfoResponse) gin.H {
+	goodsList := make([]interface{}, 0)
+	for _, goods := range item.Goods {
+		goodsList = append(goodsList, gin.H{
+			"order_goods_id": goods.OrderGoodsId,
+			"goods_id":       goods.GoodsId,
+			"name":           goods.GoodsName,
+			"price":          goods.GoodsPrice,
+			"nums":           goods.Nums,
+		})
+	}
+
+	return gin.H{
+		"id":           item.Id,
+		"user":         item.UserId,
+		"order":        item.OrderId,
+		"order_sn":     item.OrderSn,
+		"refund_sn":    item.RefundSn,
+		"type":         item.RefundType,
+		"status":       item.Status,
+		"reason":       item.Reason,
+		"images":       item.Images,
+		"amount":       item.Amount,
+		"admin_remark": item.AdminRemark,
+		"fail_reason":  item.FailReason,
+		"add_time":     item.AddTime,
+		"goods":        goodsList,
+	}
+}
@@ -2,6 +2,7 @@ package shop_cart
 
 import (
 	"context"
+	"github.com/satori/go.uuid"
 	"go.uber.org/zap"
 	"net/http"
 	"strconv"
@@ -13,11 +14,27 @@ import (
 	"wshop-api/order-web/global"
 )
 
+// Device 给游客下发设备token， 没有登录的时候使用x-device-token访问购物车
+func Device(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{
+		"device_token": uuid.NewV4().String(),
+	})
+}
+
+// owner 登录用户返回用户id， 游客返回设备token
+func owner(ctx *gin.Context) (int32, string) {
+	if userId, ok := ctx.Get("userId"); ok {
+		return int32(userId.(uint)), ""
+	}
+	return 0, ctx.GetString("deviceToken")
+}
+
 func List(ctx *gin.Context) {
 	// 获取购物车商品
-	userId, _ := ctx.Get("userId")
+	userId, deviceToken := owner(ctx)
 	rsp, err := global.OrderSrvClient.CartItemList(context.Background(), &proto.UserInfo{
-		Id: int32(userId.(uint)),
+		Id:          userId,
+		DeviceToken: deviceToken,
 	})
 	if err != nil {
 		zap.S().Errorw("[List] 查询 【购物车列表】失败")
@@ -97,7 +114,7 @@ func New(ctx *gin.Context) {
 	}
 
 	// 为了严谨性，添加商品到购物车之前，记得检查一下商品是否存在
-	_, err := global.GoodsSrvClient.GetGoodsDetail(context.Background(), &proto.GoodInfoRequest{
+	goods, err := global.GoodsSrvClient.GetGoodsDetail(context.Background(), &proto.GoodInfoRequest{
 		Id: itemForm.GoodsId,
 	})
 	if err != nil {
@@ -122,11 +139,15 @@ func New(ctx *gin.Context) {
 		return
 	}
 
-	userId, _ := ctx.Get("userId")
+	userId, deviceToken := owner(ctx)
 	rsp, err := global.OrderSrvClient.CreateCartItem(context.Background(), &proto.CartItemRequest{
-		GoodsId: itemForm.GoodsId,
-		UserId:  int32(userId.(uint)),
-		Nums:    itemForm.Nums,
+		GoodsId:     itemForm.GoodsId,
+		UserId:      userId,
+		DeviceToken: deviceToken,
+		Nums:        itemForm.Nums,
+		// 记录加入购物车时的价格， 结算的时候提示价格变化
+		GoodsPrice:      goods.ShopPrice,
+		GoodsPriceCents: goods.ShopPriceCents,
 	})
 
 	if err != nil {
@@ -157,12 +178,13 @@ func Update(ctx *gin.Context) {
 		return
 	}
 
-	userId, _ := ctx.Get("userId")
+	userId, deviceToken := owner(ctx)
 	request := proto.CartItemRequest{
-		UserId:  int32(userId.(uint)),
-		GoodsId: int32(i),
-		Nums:    itemForm.Nums,
-		Checked: false,
+		UserId:      userId,
+		DeviceToken: deviceToken,
+		GoodsId:     int32(i),
+		Nums:        itemForm.Nums,
+		Checked:     false,
 	}
 	if itemForm.Checked != nil {
 		request.Checked = *itemForm.Checked
@@ -187,10 +209,11 @@ func Delete(ctx *gin.Context) {
 		return
 	}
 
-	userId, _ := ctx.Get("userId")
+	userId, deviceToken := owner(ctx)
 	_, err = global.OrderSrvClient.DeleteCartItem(context.Background(), &proto.CartItemRequest{
-		UserId:  int32(userId.(uint)),
-		GoodsId: int32(i),
+		UserId:      userId,
+		DeviceToken: deviceToken,
+		GoodsId:     int32(i),
 	})
 	if err != nil {
 		zap.S().Errorw("删除购物车记录失败")
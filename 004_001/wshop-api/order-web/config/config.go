@@ -8,6 +8,10 @@ type OrderSrvConfig struct {
 	Name string `mapstructure:"name" json:"name"`
 }
 
+type PromotionSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
 type JWTConfig struct {
 	SigningKey string `mapstructure:"key" json:"key"`
 }
@@ -29,20 +33,41 @@ type AlipayConfig struct {
 	AliPublicKey string `mapstructure:"ali_public_key" json:"ali_public_key"`
 	NotifyURL    string `mapstructure:"notify_url" json:"notify_url"`
 	ReturnURL    string `mapstructure:"return_url" json:"return_url"`
+	IsProduction bool   `mapstructure:"is_production" json:"is_production"`
+}
+
+type WechatPayConfig struct {
+	AppID        string `mapstructure:"app_id" json:"app_id"`
+	MchID        string `mapstructure:"mch_id" json:"mch_id"`
+	SerialNo     string `mapstructure:"serial_no" json:"serial_no"`         // 商户API证书的序列号
+	PrivateKey   string `mapstructure:"private_key" json:"private_key"`     // 商户API证书的私钥
+	APIv3Key     string `mapstructure:"api_v3_key" json:"api_v3_key"`       // 解密回调通知
+	PlatformCert string `mapstructure:"platform_cert" json:"platform_cert"` // 微信支付平台证书， 验证应答和回调通知的签名
+	NotifyURL    string `mapstructure:"notify_url" json:"notify_url"`
+}
+
+type MockPayConfig struct {
+	Secret     string `mapstructure:"secret" json:"secret"`           // 回调通知的签名密钥， 没有配置的时候不启用模拟支付
+	CashierURL string `mapstructure:"cashier_url" json:"cashier_url"` // 模拟收银台的地址， 也就是order-web的/o/v1/pay/mock/cashier
+	NotifyURL  string `mapstructure:"notify_url" json:"notify_url"`
+	ReturnURL  string `mapstructure:"return_url" json:"return_url"`
 }
 
 type ServerConfig struct {
-	Name             string         `mapstructure:"name" json:"name"`
-	Host             string         `mapstructure:"host" json:"host"`
-	Port             int            `mapstructure:"port" json:"port"`
-	Tags             []string       `mapstructure:"tags" json:"tags"`
-	GoodsSrvInfo     GoodsSrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
-	OrderSrvInfo     OrderSrvConfig `mapstructure:"order_srv" json:"order_srv"`
-	InventorySrvInfo GoodsSrvConfig `mapstructure:"order_srv" json:"inventory_srv"`
-	JWTInfo          JWTConfig      `mapstructure:"jwt" json:"jwt"`
-	ConsulInfo       ConsulConfig   `mapstructure:"consul" json:"consul"`
-	AliPayInfo       AlipayConfig   `mapstructure:"alipay" json:"alipay"`
-	JaegerInfo       JaegerConfig   `mapstructure:"consul" json:"jaeger"`
+	Name             string             `mapstructure:"name" json:"name"`
+	Host             string             `mapstructure:"host" json:"host"`
+	Port             int                `mapstructure:"port" json:"port"`
+	Tags             []string           `mapstructure:"tags" json:"tags"`
+	GoodsSrvInfo     GoodsSrvConfig     `mapstructure:"goods_srv" json:"goods_srv"`
+	OrderSrvInfo     OrderSrvConfig     `mapstructure:"order_srv" json:"order_srv"`
+	InventorySrvInfo GoodsSrvConfig     `mapstructure:"order_srv" json:"inventory_srv"`
+	PromotionSrvInfo PromotionSrvConfig `mapstructure:"promotion_srv" json:"promotion_srv"`
+	JWTInfo          JWTConfig          `mapstructure:"jwt" json:"jwt"`
+	ConsulInfo       ConsulConfig       `mapstructure:"consul" json:"consul"`
+	AliPayInfo       AlipayConfig       `mapstructure:"alipay" json:"alipay"`
+	WechatPayInfo    WechatPayConfig    `mapstructure:"wechat_pay" json:"wechat_pay"`
+	MockPayInfo      MockPayConfig      `mapstructure:"mock_pay" json:"mock_pay"`
+	JaegerInfo       JaegerConfig       `mapstructure:"consul" json:"jaeger"`
 }
 
 type NacosConfig struct {
@@ -0,0 +1,17 @@
+package forms
+
+type CreateCouponTemplateForm struct {
+	Name         string  `json:"name" binding:"required,max=50"`
+	CouponType   int32   `json:"type" binding:"required,oneof=1 2 3"`  // 1立减 2折扣 3满减
+	Threshold    float32 `json:"threshold" binding:"min=0"`            // 满多少可以使用
+	Discount     float32 `json:"discount" binding:"min=0"`             // 立减和满减的金额
+	Percent      int32   `json:"percent" binding:"min=0,max=99"`       // 折扣券支付的百分比， 85表示85折
+	MaxDiscount  float32 `json:"max_discount" binding:"min=0"`         // 折扣券最多优惠的金额
+	ScopeType    int32   `json:"scope" binding:"required,oneof=1 2 3"` // 1全场 2指定分类 3指定品牌
+	ScopeIds     []int32 `json:"scope_ids"`
+	Total        int32   `json:"total" binding:"min=0"`
+	PerUserLimit int32   `json:"per_user_limit" binding:"min=0"`
+	ValidDays    int32   `json:"valid_days" binding:"min=0"`
+	StartTime    int64   `json:"start_time"`
+	EndTime      int64   `json:"end_time"`
+}
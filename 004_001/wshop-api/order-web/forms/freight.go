@@ -0,0 +1,18 @@
+package forms
+
+type FreightRuleForm struct {
+	Province       string  `json:"province"`                        // 为空表示默认地区
+	City           string  `json:"city"`                            // 为空表示整个省份
+	FirstUnit      int32   `json:"first_unit" binding:"min=0"`      // 首件的件数或者首重的克数
+	FirstFee       float32 `json:"first_fee" binding:"min=0"`       // 首件或者首重的运费
+	AdditionalUnit int32   `json:"additional_unit" binding:"min=0"` // 续件的件数或者续重的克数， 0表示不收续费
+	AdditionalFee  float32 `json:"additional_fee" binding:"min=0"`
+}
+
+type FreightTemplateForm struct {
+	Name          string            `json:"name" binding:"required,max=50"`
+	ChargeType    int32             `json:"charge_type" binding:"required,oneof=1 2"` // 1按件数 2按重量
+	FreeThreshold float32           `json:"free_threshold" binding:"min=0"`           // 商品金额满多少包邮， 0表示不包邮
+	IsDefault     bool              `json:"is_default"`                               // 下单使用默认的模板
+	Rules         []FreightRuleForm `json:"rules" binding:"required,min=1,dive"`
+}
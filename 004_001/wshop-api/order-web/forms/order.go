@@ -1,8 +1,34 @@
 package forms
 
 type CreateOrderForm struct {
-	Address string `json:"address" binding:"required"`
-	Name    string `json:"name" binding:"required"`
-	Mobile  string `json:"mobile" binding:"required,mobile"`
-	Post    string `json:"post" binding:"required"`
+	Province string `json:"province" binding:"required"` // 收货地址的省份和城市， 用来计算运费
+	City     string `json:"city" binding:"required"`
+	Address  string `json:"address" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Mobile   string `json:"mobile" binding:"required,mobile"`
+	Post     string `json:"post" binding:"required"`
+
+	CouponIds []int32 `json:"coupons" binding:"max=3"`                               // 使用的优惠券， 每种类型最多一张
+	PayType   string  `json:"pay_type" binding:"omitempty,oneof=alipay wechat mock"` // 不传的时候使用支付宝
+}
+
+type PayOrderForm struct {
+	PayType string `json:"pay_type" binding:"omitempty,oneof=alipay wechat mock"` // 不传的时候使用下单时选择的支付方式
+}
+
+type PreviewOrderForm struct {
+	Province  string  `json:"province" binding:"required_with=City"` // 收货地址的省份和城市， 不传的时候按照运费模板的默认地区计算运费
+	City      string  `json:"city"`                                  // 为空的时候按照整个省份计算运费
+	CouponIds []int32 `json:"coupons" binding:"max=3"`
+}
+
+type ShipGoodsForm struct {
+	OrderGoodsId int32 `json:"order_goods_id" binding:"required"`
+	Nums         int32 `json:"nums" binding:"required,min=1"`
+}
+
+type ShipOrderForm struct {
+	Carrier    string          `json:"carrier" binding:"required"`
+	TrackingNo string          `json:"tracking_no" binding:"required,max=50"`
+	Goods      []ShipGoodsForm `json:"goods" binding:"dive"` // 拆分发货的时候传递， 不传发出所有未发货的商品
 }
@@ -0,0 +1,19 @@
+package forms
+
+type RefundGoodsForm struct {
+	OrderGoodsId int32 `json:"order_goods_id" binding:"required"`
+	Nums         int32 `json:"nums" binding:"required,min=1"`
+}
+
+type CreateRefundForm struct {
+	OrderId    int32             `json:"order" binding:"required"`
+	RefundType int32             `json:"type" binding:"required,oneof=1 2"` // 1全额退款 2部分退款
+	Reason     string            `json:"reason" binding:"required,max=200"`
+	Images     []string          `json:"images" binding:"max=9,dive,url"` // oss-web上传之后的图片地址
+	Goods      []RefundGoodsForm `json:"goods" binding:"dive"`            // 部分退款的时候必须传递
+}
+
+type AuditRefundForm struct {
+	Approved *bool  `json:"approved" binding:"required"`
+	Remark   string `json:"remark" binding:"max=200"`
+}
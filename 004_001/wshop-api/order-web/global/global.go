@@ -18,4 +18,6 @@ var (
 	OrderSrvClient proto.OrderClient
 
 	InventorySrvClient proto.InventoryClient
+
+	PromotionSrvClient proto.PromotionClient
 )
@@ -0,0 +1,32 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/global"
+	"wshop-api/order-web/payment"
+)
+
+// InitPayment 注册配置了的支付渠道， 没有配置的支付方式下单的时候会返回错误
+func InitPayment() {
+	if c := global.ServerConfig.AliPayInfo; c.AppID != "" {
+		alipay, err := payment.NewAlipay(c.AppID, c.PrivateKey, c.AliPublicKey, c.NotifyURL, c.ReturnURL, c.IsProduction)
+		if err != nil {
+			zap.S().Fatalf("初始化支付宝失败: %s", err.Error())
+		}
+		payment.Register("alipay", alipay)
+	}
+
+	if c := global.ServerConfig.WechatPayInfo; c.MchID != "" {
+		wechat, err := payment.NewWechatPay(c.AppID, c.MchID, c.SerialNo, c.PrivateKey, c.APIv3Key, c.PlatformCert, c.NotifyURL)
+		if err != nil {
+			zap.S().Fatalf("初始化微信支付失败: %s", err.Error())
+		}
+		payment.Register("wechat", wechat)
+	}
+
+	if c := global.ServerConfig.MockPayInfo; c.Secret != "" {
+		zap.S().Warn("启用了模拟支付， 不要在线上环境配置")
+		payment.Register("mock", payment.NewMock(c.Secret, c.CashierURL, c.NotifyURL, c.ReturnURL))
+	}
+}
@@ -22,6 +22,9 @@ func Routers() *gin.Engine {
 	ApiGroup := Router.Group("/o/v1")
 	router.InitOrderRouter(ApiGroup)
 	router.InitShopCartRouter(ApiGroup)
+	router.InitRefundRouter(ApiGroup)
+	router.InitCouponRouter(ApiGroup)
+	router.InitFreightRouter(ApiGroup)
 
 	return Router
 }
@@ -49,4 +49,16 @@ func InitSrvConn() {
 
 	global.InventorySrvClient = proto.NewInventoryClient(invConn)
 
+	promotionConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.PromotionSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+		grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer())),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【营销服务失败】")
+	}
+
+	global.PromotionSrvClient = proto.NewPromotionClient(promotionConn)
+
 }
@@ -35,6 +35,8 @@ func main() {
 	}
 	// 5. 初始化srv的连接
 	initialize.InitSrvConn()
+	// 6. 初始化支付渠道
+	initialize.InitPayment()
 
 	viper.AutomaticEnv()
 	// 如果是本地开发环境端口号固定，线上环境启动获取端口号
@@ -0,0 +1,31 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/satori/go.uuid"
+)
+
+// CartAuth 购物车允许游客使用， 有x-token的时候按照登录用户鉴权， 否则使用头部的x-device-token识别游客
+// 设备token由/shopcarts/device下发， 登录或者注册的时候user-web会把游客的购物车合并到用户的购物车
+func CartAuth() gin.HandlerFunc {
+	jwtAuth := JWTAuth()
+	return func(c *gin.Context) {
+		if c.Request.Header.Get("x-token") != "" {
+			jwtAuth(c)
+			return
+		}
+
+		device := c.Request.Header.Get("x-device-token")
+		if _, err := uuid.FromString(device); err != nil {
+			c.JSON(http.StatusUnauthorized, map[string]string{
+				"msg": "请登录",
+			})
+			c.Abort()
+			return
+		}
+		c.Set("deviceToken", device)
+		c.Next()
+	}
+}
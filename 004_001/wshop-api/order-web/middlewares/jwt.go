@@ -45,6 +45,19 @@ func JWTAuth() gin.HandlerFunc {
 	}
 }
 
+// QueryTokenAuth 浏览器的EventSource不能设置请求头， 允许通过查询参数x-token传递token， 只用在推送的接口上
+func QueryTokenAuth() gin.HandlerFunc {
+	jwtAuth := JWTAuth()
+	return func(c *gin.Context) {
+		if c.Request.Header.Get("x-token") == "" {
+			if token := c.Query("x-token"); token != "" {
+				c.Request.Header.Set("x-token", token)
+			}
+		}
+		jwtAuth(c)
+	}
+}
+
 type JWT struct {
 	SigningKey []byte
 }
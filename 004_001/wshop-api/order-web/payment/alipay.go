@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/smartwalle/alipay/v3"
+
+	"wshop-api/order-web/utils/money"
+)
+
+// Alipay 支付宝电脑网站支付
+type Alipay struct {
+	client    *alipay.Client
+	appId     string
+	notifyURL string
+	returnURL string
+}
+
+func NewAlipay(appId, privateKey, aliPublicKey, notifyURL, returnURL string, isProduction bool) (*Alipay, error) {
+	client, err := alipay.New(appId, privateKey, isProduction)
+	if err != nil {
+		return nil, fmt.Errorf("实例化支付宝失败: %w", err)
+	}
+	if err = client.LoadAliPayPublicKey(aliPublicKey); err != nil {
+		return nil, fmt.Errorf("加载支付宝的公钥失败: %w", err)
+	}
+	return &Alipay{client: client, appId: appId, notifyURL: notifyURL, returnURL: returnURL}, nil
+}
+
+func (a *Alipay) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	var p = alipay.TradePagePay{}
+	p.NotifyURL = a.notifyURL
+	p.ReturnURL = a.returnURL
+	p.Subject = req.Subject
+	p.OutTradeNo = req.OrderSn
+	p.TotalAmount = req.Amount.String() // 支付金额使用分计算， 避免float的精度问题
+	p.ProductCode = "FAST_INSTANT_TRADE_PAY"
+	if !req.ExpireAt.IsZero() {
+		p.TimeExpire = req.ExpireAt.Format("2006-01-02 15:04:05")
+	}
+
+	url, err := a.client.TradePagePay(p)
+	if err != nil {
+		return nil, err
+	}
+	return &PayResult{PayURL: url.String()}, nil
+}
+
+func (a *Alipay) VerifyNotify(req *http.Request) (*Notification, error) {
+	noti, err := a.client.GetTradeNotification(req)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := money.Parse(noti.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	// 支付宝的交易状态和订单状态使用相同的名称
+	n := &Notification{
+		OrderSn: noti.OutTradeNo,
+		TradeNo: noti.TradeNo,
+		Status:  string(noti.TradeStatus),
+		Amount:  amount,
+		AppId:   noti.AppId,
+	}
+	if noti.GmtPayment != "" {
+		n.PayTime, _ = time.ParseInLocation("2006-01-02 15:04:05", noti.GmtPayment, time.Local)
+	}
+	return n, nil
+}
+
+func (a *Alipay) AckNotify(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
+
+func (a *Alipay) CloseTrade(ctx context.Context, orderSn string) error {
+	rsp, err := a.client.TradeClose(alipay.TradeClose{OutTradeNo: orderSn})
+	if err != nil {
+		return err
+	}
+	// 用户还没有扫码或者登录的时候支付宝还没有创建交易， 不需要关闭
+	if rsp.Content.Code != alipay.CodeSuccess && rsp.Content.SubCode != "ACQ.TRADE_NOT_EXIST" {
+		return fmt.Errorf("关闭支付宝交易失败: %s %s %s", rsp.Content.Code, rsp.Content.SubCode, rsp.Content.SubMsg)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"wshop-api/order-web/utils/money"
+)
+
+const mockAppId = "mock"
+
+// Mock 开发和测试环境使用的支付渠道
+// 支付页面是order-web提供的模拟收银台， 用户在收银台确认支付之后， 和真实的支付平台一样异步发送签名的回调通知
+// 交易只保存在内存中， 只能部署一个order-web实例
+type Mock struct {
+	secret     []byte
+	cashierURL string
+	notifyURL  string
+	returnURL  string
+	client     *http.Client
+
+	mu     sync.Mutex
+	trades map[string]*Trade
+}
+
+func NewMock(secret, cashierURL, notifyURL, returnURL string) *Mock {
+	return &Mock{
+		secret:     []byte(secret),
+		cashierURL: cashierURL,
+		notifyURL:  notifyURL,
+		returnURL:  returnURL,
+		client:     &http.Client{Timeout: 5 * time.Second},
+		trades:     make(map[string]*Trade),
+	}
+}
+
+// sign 参数按照key排序后拼接成 k1=v1&k2=v2 的格式， 使用HMAC-SHA256签名
+func (m *Mock) sign(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if k != "sign" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+values.Get(k))
+	}
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(strings.Join(pairs, "&")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (m *Mock) verify(values url.Values) error {
+	if !hmac.Equal([]byte(values.Get("sign")), []byte(m.sign(values))) {
+		return errors.New("模拟支付验签失败")
+	}
+	return nil
+}
+
+func (m *Mock) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	m.mu.Lock()
+	if trade, ok := m.trades[req.OrderSn]; !ok || trade.Status == "TRADE_CLOSED" {
+		m.trades[req.OrderSn] = &Trade{OrderSn: req.OrderSn, Status: "WAIT_BUYER_PAY", Amount: req.Amount}
+	}
+	m.mu.Unlock()
+
+	values := url.Values{}
+	values.Set("out_trade_no", req.OrderSn)
+	values.Set("subject", req.Subject)
+	values.Set("total_amount", req.Amount.String())
+	values.Set("sign", m.sign(values))
+	return &PayResult{PayURL: m.cashierURL + "?" + values.Encode()}, nil
+}
+
+// Cashier 验证收银台链接的签名， 返回收银台中展示的交易
+func (m *Mock) Cashier(values url.Values) (*Trade, error) {
+	if err := m.verify(values); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	trade, ok := m.trades[values.Get("out_trade_no")]
+	if !ok {
+		return nil, ErrTradeNotExist
+	}
+	t := *trade
+	return &t, nil
+}
+
+// Complete 用户在收银台确认支付或者取消支付， 异步发送回调通知， 返回支付完成之后跳转的地址
+func (m *Mock) Complete(orderSn string, paid bool) (string, error) {
+	m.mu.Lock()
+	trade, ok := m.trades[orderSn]
+	if !ok {
+		m.mu.Unlock()
+		return "", ErrTradeNotExist
+	}
+	if trade.Status != "WAIT_BUYER_PAY" {
+		m.mu.Unlock()
+		return "", fmt.Errorf("交易已经结束: %s", trade.Status)
+	}
+	if paid {
+		trade.Status = "TRADE_SUCCESS"
+		trade.TradeNo = fmt.Sprintf("MOCK%d", time.Now().UnixNano())
+	} else {
+		trade.Status = "TRADE_CLOSED"
+	}
+	values := url.Values{}
+	values.Set("app_id", mockAppId)
+	values.Set("out_trade_no", trade.OrderSn)
+	values.Set("trade_no", trade.TradeNo)
+	values.Set("trade_status", trade.Status)
+	values.Set("total_amount", trade.Amount.String())
+	values.Set("notify_time", time.Now().Format("2006-01-02 15:04:05"))
+	if paid {
+		values.Set("gmt_payment", values.Get("notify_time"))
+	}
+	m.mu.Unlock()
+
+	values.Set("sign", m.sign(values))
+	go m.sendNotify(values)
+	return m.returnURL, nil
+}
+
+// sendNotify 和真实的支付平台一样， 没有收到success的时候重新通知
+func (m *Mock) sendNotify(values url.Values) {
+	for i := 0; i < 3; i++ {
+		rsp, err := m.client.PostForm(m.notifyURL, values)
+		if err == nil {
+			rsp.Body.Close()
+			if rsp.StatusCode == http.StatusOK {
+				return
+			}
+			err = fmt.Errorf("状态码 %d", rsp.StatusCode)
+		}
+		zap.S().Warnf("发送模拟支付通知失败: %s", err.Error())
+		time.Sleep(time.Duration(i+1) * time.Second)
+	}
+}
+
+func (m *Mock) VerifyNotify(req *http.Request) (*Notification, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, err
+	}
+	values := req.PostForm
+	if err := m.verify(values); err != nil {
+		return nil, err
+	}
+	amount, err := money.Parse(values.Get("total_amount"))
+	if err != nil {
+		return nil, err
+	}
+	n := &Notification{
+		OrderSn: values.Get("out_trade_no"),
+		TradeNo: values.Get("trade_no"),
+		Status:  values.Get("trade_status"),
+		Amount:  amount,
+		AppId:   values.Get("app_id"),
+	}
+	if gmtPayment := values.Get("gmt_payment"); gmtPayment != "" {
+		n.PayTime, _ = time.ParseInLocation("2006-01-02 15:04:05", gmtPayment, time.Local)
+	}
+	return n, nil
+}
+
+func (m *Mock) AckNotify(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
+
+func (m *Mock) CloseTrade(ctx context.Context, orderSn string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if trade, ok := m.trades[orderSn]; ok && trade.Status == "WAIT_BUYER_PAY" {
+		trade.Status = "TRADE_CLOSED"
+	}
+	return nil
+}
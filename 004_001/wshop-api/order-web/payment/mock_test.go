@@ -0,0 +1,127 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"wshop-api/order-web/utils/money"
+)
+
+func mockNotify(values url.Values) *http.Request {
+	req := httptest.NewRequest("POST", "/pay/mock/notify", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestMockVerifyNotify(t *testing.T) {
+	m := NewMock("secret", "", "", "")
+	values := url.Values{}
+	values.Set("app_id", mockAppId)
+	values.Set("out_trade_no", "sn1")
+	values.Set("trade_no", "MOCK1")
+	values.Set("trade_status", "TRADE_SUCCESS")
+	values.Set("total_amount", "12.34")
+	values.Set("gmt_payment", "2021-03-01 10:00:00")
+	values.Set("sign", m.sign(values))
+
+	n, err := m.VerifyNotify(mockNotify(values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payTime := time.Date(2021, 3, 1, 10, 0, 0, 0, time.Local)
+	if n.OrderSn != "sn1" || n.TradeNo != "MOCK1" || n.Status != "TRADE_SUCCESS" || n.Amount != money.FromCents(1234) ||
+		n.AppId != mockAppId || !n.PayTime.Equal(payTime) {
+		t.Errorf("notification = %+v", n)
+	}
+
+	// 修改金额之后签名不一致
+	tampered := url.Values{}
+	for k, v := range values {
+		tampered[k] = v
+	}
+	tampered.Set("total_amount", "0.01")
+	if _, err := m.VerifyNotify(mockNotify(tampered)); err == nil {
+		t.Error("金额被篡改的通知验签成功了")
+	}
+	// 其他密钥签名的通知
+	if _, err := NewMock("other", "", "", "").VerifyNotify(mockNotify(values)); err == nil {
+		t.Error("密钥不一致的通知验签成功了")
+	}
+	unsigned := url.Values{}
+	for k, v := range values {
+		unsigned[k] = v
+	}
+	unsigned.Del("sign")
+	if _, err := m.VerifyNotify(mockNotify(unsigned)); err == nil {
+		t.Error("没有签名的通知验签成功了")
+	}
+}
+
+func TestMockCashier(t *testing.T) {
+	// 模拟收银台确认支付之后异步通知， 通知需要能通过验签
+	notifications := make(chan *Notification, 1)
+	var m *Mock
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, err := m.VerifyNotify(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.AckNotify(w)
+		notifications <- n
+	}))
+	defer server.Close()
+	m = NewMock("secret", "http://cashier", server.URL, "http://return")
+
+	ctx := context.Background()
+	rsp, err := m.Pay(ctx, &PayRequest{OrderSn: "sn1", Subject: "商品", Amount: money.FromCents(1234)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payURL, err := url.Parse(rsp.PayURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trade, err := m.Cashier(payURL.Query())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trade.OrderSn != "sn1" || trade.Status != "WAIT_BUYER_PAY" || trade.Amount != money.FromCents(1234) {
+		t.Errorf("trade = %+v", trade)
+	}
+	// 收银台链接被篡改
+	query := payURL.Query()
+	query.Set("total_amount", "0.01")
+	if _, err := m.Cashier(query); err == nil {
+		t.Error("篡改的收银台链接验签成功了")
+	}
+
+	returnURL, err := m.Complete("sn1", true)
+	if err != nil || returnURL != "http://return" {
+		t.Fatalf("return = %s, err = %v", returnURL, err)
+	}
+	select {
+	case n := <-notifications:
+		if n.OrderSn != "sn1" || n.Status != "TRADE_SUCCESS" || n.TradeNo == "" || n.Amount != money.FromCents(1234) || n.PayTime.IsZero() {
+			t.Errorf("notification = %+v", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("没有收到支付通知")
+	}
+
+	// 交易已经结束， 不能再次支付或者关闭
+	if _, err := m.Complete("sn1", false); err == nil {
+		t.Error("已经支付的交易又被取消了")
+	}
+	if err := m.CloseTrade(ctx, "sn1"); err != nil {
+		t.Fatal(err)
+	}
+	if trade, _ := m.Cashier(payURL.Query()); trade.Status != "TRADE_SUCCESS" {
+		t.Errorf("关闭交易之后 status = %s", trade.Status)
+	}
+}
@@ -0,0 +1,91 @@
+// Package payment 支付渠道
+// 下单、订单详情和支付平台的回调只依赖Provider， 不关心具体是哪个支付平台
+//
+// 支付渠道的能力按照调用方拆成两半， 每个能力只在一个服务中实现:
+//   - order-web(这里): 发起支付、验证回调通知、应答通知和关闭交易， 这些都发生在用户的请求或者支付平台的回调中
+//   - order_srv(wshop_srvs/order_srv/payment): 原路退款和查询交易， 由售后审核和对账任务在订单服务内部调用
+//
+// 两个服务是不同的go module， 和proto一样各自维护自己的代码， 没有合并成一个接口，
+// 否则两边都要实现用不到的方法， 比如订单服务要配置回调地址和APIv3密钥， order-web要能发起退款
+// 新增支付平台的时候两边都要实现并且使用同一个pay_type注册
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"wshop-api/order-web/utils/money"
+)
+
+// Provider 支付渠道， 支付平台的交易使用我们平台的订单号作为商户订单号
+type Provider interface {
+	// Pay 创建支付， 返回跳转的支付页面或者二维码的内容
+	Pay(ctx context.Context, req *PayRequest) (*PayResult, error)
+	// VerifyNotify 验证支付平台的异步通知， 验证失败的时候返回错误
+	VerifyNotify(req *http.Request) (*Notification, error)
+	// AckNotify 通知处理成功之后的应答， 不应答的话支付平台会重复通知
+	AckNotify(w http.ResponseWriter)
+	// CloseTrade 关闭还没有支付的交易， 切换支付方式的时候关闭之前的交易， 避免重复支付
+	CloseTrade(ctx context.Context, orderSn string) error
+}
+
+type PayRequest struct {
+	OrderSn  string
+	Subject  string
+	Amount   money.Money
+	ExpireAt time.Time // 订单的支付截止时间， 为空的时候使用支付平台默认的时间
+	ClientIP string
+}
+
+type PayResult struct {
+	PayURL string // 跳转到支付平台的支付页面
+	QRCode string // 扫码支付的二维码内容， 由前端生成二维码
+}
+
+// Notification 支付平台的异步通知
+// Status和订单服务的状态一致: TRADE_SUCCESS, TRADE_FINISHED, TRADE_CLOSED, WAIT_BUYER_PAY
+type Notification struct {
+	OrderSn string
+	TradeNo string // 支付平台的交易号
+	Status  string
+	Amount  money.Money // 实际支付的金额
+	AppId   string      // 收款的应用， 用来确认通知是发给我们的
+	PayTime time.Time   // 支付平台的支付时间， 没有支付的时候为空
+}
+
+// Trade 模拟收银台和微信支付通知中的交易
+type Trade struct {
+	OrderSn string
+	TradeNo string
+	Status  string
+	Amount  money.Money
+}
+
+// ErrTradeNotExist 用户还没有打开支付页面的时候， 支付平台中还没有这笔交易
+var ErrTradeNotExist = errors.New("交易不存在")
+
+var (
+	mu        sync.RWMutex
+	providers = make(map[string]Provider)
+)
+
+// Register 注册支付渠道， payType和订单中的pay_type一致
+func Register(payType string, p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[payType] = p
+}
+
+func Get(payType string) (Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[payType]
+	if !ok {
+		return nil, fmt.Errorf("不支持的支付方式: %s", payType)
+	}
+	return p, nil
+}
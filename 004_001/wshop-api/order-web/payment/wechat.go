@@ -0,0 +1,320 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"wshop-api/order-web/utils/money"
+)
+
+const wechatPayGateway = "https://api.mch.weixin.qq.com"
+
+// WechatPay 微信支付v3的Native支付， 返回二维码的内容由前端生成二维码
+// 请求使用商户API证书的私钥签名， 应答和回调通知使用微信支付平台证书验签
+type WechatPay struct {
+	appId        string
+	mchId        string
+	serialNo     string
+	apiV3Key     []byte
+	privateKey   *rsa.PrivateKey
+	platformCert *x509.Certificate
+	notifyURL    string
+	client       *http.Client
+}
+
+func NewWechatPay(appId, mchId, serialNo, privateKey, apiV3Key, platformCert, notifyURL string) (*WechatPay, error) {
+	if len(apiV3Key) != 32 {
+		return nil, errors.New("APIv3密钥的长度必须是32个字节")
+	}
+	priKey, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	// 回调通知一定要验签， 所以平台证书是必须的
+	block, _ := pem.Decode([]byte(platformCert))
+	if block == nil {
+		return nil, errors.New("微信支付平台证书格式不正确")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("解析微信支付平台证书失败: %w", err)
+	}
+	if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+		return nil, errors.New("微信支付平台证书不是RSA证书")
+	}
+
+	return &WechatPay{
+		appId:        appId,
+		mchId:        mchId,
+		serialNo:     serialNo,
+		apiV3Key:     []byte(apiV3Key),
+		privateKey:   priKey,
+		platformCert: cert,
+		notifyURL:    notifyURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// wechatError 微信支付返回的错误， 4xx和5xx的时候应答主体中有错误码
+type wechatError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *wechatError) Error() string {
+	return fmt.Sprintf("微信支付请求失败: %d %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+type wechatAmount struct {
+	Total    int64  `json:"total"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// wechatTransaction 查询订单和支付通知中的交易信息
+type wechatTransaction struct {
+	AppId         string       `json:"appid"`
+	MchId         string       `json:"mchid"`
+	OutTradeNo    string       `json:"out_trade_no"`
+	TransactionId string       `json:"transaction_id"`
+	TradeState    string       `json:"trade_state"`
+	SuccessTime   string       `json:"success_time"` // 支付完成时间， RFC3339格式
+	Amount        wechatAmount `json:"amount"`
+}
+
+// wechatTradeStatus 微信支付的交易状态转换成订单的状态
+func wechatTradeStatus(tradeState string) string {
+	switch tradeState {
+	case "SUCCESS", "REFUND": // 转入退款的交易也是已经支付过的
+		return "TRADE_SUCCESS"
+	case "CLOSED", "REVOKED", "PAYERROR":
+		return "TRADE_CLOSED"
+	default: // NOTPAY, USERPAYING
+		return "WAIT_BUYER_PAY"
+	}
+}
+
+func (t *wechatTransaction) trade() *Trade {
+	return &Trade{
+		OrderSn: t.OutTradeNo,
+		TradeNo: t.TransactionId,
+		Status:  wechatTradeStatus(t.TradeState),
+		Amount:  money.FromCents(t.Amount.Total),
+	}
+}
+
+func (w *WechatPay) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	body := map[string]interface{}{
+		"appid":        w.appId,
+		"mchid":        w.mchId,
+		"description":  req.Subject,
+		"out_trade_no": req.OrderSn,
+		"notify_url":   w.notifyURL,
+		"amount":       wechatAmount{Total: req.Amount.Cents(), Currency: "CNY"},
+	}
+	if !req.ExpireAt.IsZero() {
+		body["time_expire"] = req.ExpireAt.Format(time.RFC3339)
+	}
+	var rsp struct {
+		CodeURL string `json:"code_url"`
+	}
+	if err := w.call(ctx, http.MethodPost, "/v3/pay/transactions/native", body, &rsp); err != nil {
+		return nil, err
+	}
+	return &PayResult{QRCode: rsp.CodeURL}, nil
+}
+
+// wechatNotify 回调通知， 交易信息在resource中加密
+type wechatNotify struct {
+	EventType string `json:"event_type"`
+	Resource  struct {
+		Algorithm      string `json:"algorithm"`
+		Ciphertext     string `json:"ciphertext"`
+		AssociatedData string `json:"associated_data"`
+		Nonce          string `json:"nonce"`
+	} `json:"resource"`
+}
+
+func (w *WechatPay) VerifyNotify(req *http.Request) (*Notification, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = w.verify(req.Header, body); err != nil {
+		return nil, err
+	}
+
+	var notify wechatNotify
+	if err = json.Unmarshal(body, &notify); err != nil {
+		return nil, fmt.Errorf("解析微信支付通知失败: %w", err)
+	}
+	plaintext, err := w.decrypt(notify.Resource.Ciphertext, notify.Resource.Nonce, notify.Resource.AssociatedData)
+	if err != nil {
+		return nil, err
+	}
+	var transaction wechatTransaction
+	if err = json.Unmarshal(plaintext, &transaction); err != nil {
+		return nil, fmt.Errorf("解析微信支付交易失败: %w", err)
+	}
+
+	trade := transaction.trade()
+	n := &Notification{
+		OrderSn: trade.OrderSn,
+		TradeNo: trade.TradeNo,
+		Status:  trade.Status,
+		Amount:  trade.Amount,
+		AppId:   transaction.AppId,
+	}
+	if transaction.SuccessTime != "" {
+		n.PayTime, _ = time.Parse(time.RFC3339, transaction.SuccessTime)
+	}
+	return n, nil
+}
+
+func (w *WechatPay) AckNotify(rw http.ResponseWriter) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	_, _ = rw.Write([]byte(`{"code":"SUCCESS","message":"成功"}`))
+}
+
+func (w *WechatPay) CloseTrade(ctx context.Context, orderSn string) error {
+	path := "/v3/pay/transactions/out-trade-no/" + url.PathEscape(orderSn) + "/close"
+	return w.call(ctx, http.MethodPost, path, map[string]string{"mchid": w.mchId}, nil)
+}
+
+// call 发起一次v3接口请求， path中包含查询参数， 签名的时候要带上
+func (w *WechatPay) call(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	authorization, err := w.authorization(method, path, payload)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequest(method, wechatPayGateway+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Authorization", authorization)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	httpRsp, err := w.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpRsp.Body.Close()
+	rspBody, err := ioutil.ReadAll(httpRsp.Body)
+	if err != nil {
+		return err
+	}
+	if httpRsp.StatusCode/100 != 2 {
+		e := &wechatError{StatusCode: httpRsp.StatusCode}
+		_ = json.Unmarshal(rspBody, e)
+		return e
+	}
+	if err = w.verify(httpRsp.Header, rspBody); err != nil {
+		return err
+	}
+	if result == nil || len(rspBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(rspBody, result)
+}
+
+// authorization 签名串为 请求方法\nURL\n时间戳\n随机串\n请求报文主体\n， 使用SHA256 with RSA签名
+func (w *WechatPay) authorization(method, path string, body []byte) (string, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	nonceStr := hex.EncodeToString(nonce)
+
+	message := method + "\n" + path + "\n" + timestamp + "\n" + nonceStr + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, w.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`WECHATPAY2-SHA256-RSA2048 mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		w.mchId, nonceStr, base64.StdEncoding.EncodeToString(sig), timestamp, w.serialNo), nil
+}
+
+// verify 应答和回调通知的签名串为 时间戳\n随机串\n主体\n
+func (w *WechatPay) verify(header http.Header, body []byte) error {
+	sig, err := base64.StdEncoding.DecodeString(header.Get("Wechatpay-Signature"))
+	if err != nil {
+		return fmt.Errorf("微信支付的签名格式不正确: %w", err)
+	}
+	message := header.Get("Wechatpay-Timestamp") + "\n" + header.Get("Wechatpay-Nonce") + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	if err := rsa.VerifyPKCS1v15(w.platformCert.PublicKey.(*rsa.PublicKey), crypto.SHA256, hashed[:], sig); err != nil {
+		return errors.New("微信支付验签失败")
+	}
+	return nil
+}
+
+// decrypt 回调通知的resource使用APIv3密钥通过AEAD_AES_256_GCM加密
+func (w *WechatPay) decrypt(ciphertext, nonce, associatedData string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("微信支付通知的密文格式不正确: %w", err)
+	}
+	block, err := aes.NewCipher(w.apiV3Key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, []byte(nonce), data, []byte(associatedData))
+	if err != nil {
+		return nil, errors.New("解密微信支付通知失败")
+	}
+	return plaintext, nil
+}
+
+// 商户API证书的私钥是PKCS8格式的PEM
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("商户私钥格式不正确")
+	}
+	if priKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return priKey, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("解析商户私钥失败: %w", err)
+	}
+	priKey, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("商户私钥不是RSA密钥")
+	}
+	return priKey, nil
+}
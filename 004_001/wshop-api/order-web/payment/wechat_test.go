@@ -0,0 +1,185 @@
+package payment
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"wshop-api/order-web/utils/money"
+)
+
+const testApiV3Key = "0123456789abcdef0123456789abcdef"
+
+// wechatTestKeys 测试用的商户私钥和微信支付平台证书， 平台证书是自签名的
+type wechatTestKeys struct {
+	merchantKey  string
+	platformKey  *rsa.PrivateKey
+	platformCert string
+}
+
+func newWechatTestKeys(t *testing.T) *wechatTestKeys {
+	t.Helper()
+	merchantKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(merchantKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	platformKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Tenpay.com Root CA"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &platformKey.PublicKey, platformKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &wechatTestKeys{
+		merchantKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		platformKey:  platformKey,
+		platformCert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+	}
+}
+
+func newTestWechatPay(t *testing.T, keys *wechatTestKeys) *WechatPay {
+	t.Helper()
+	w, err := NewWechatPay("wxappid", "1900000001", "SERIAL", keys.merchantKey, testApiV3Key, keys.platformCert, "https://example.com/notify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// wechatNotifyBody 和微信支付一样使用APIv3密钥加密交易信息
+func wechatNotifyBody(t *testing.T, apiV3Key string, transaction *wechatTransaction) []byte {
+	t.Helper()
+	plaintext, err := json.Marshal(transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher([]byte(apiV3Key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, associatedData := "0123456789ab", "transaction"
+	var notify wechatNotify
+	notify.EventType = "TRANSACTION.SUCCESS"
+	notify.Resource.Algorithm = "AEAD_AES_256_GCM"
+	notify.Resource.Ciphertext = base64.StdEncoding.EncodeToString(gcm.Seal(nil, []byte(nonce), plaintext, []byte(associatedData)))
+	notify.Resource.Nonce = nonce
+	notify.Resource.AssociatedData = associatedData
+	body, err := json.Marshal(&notify)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// signedNotify 使用平台证书的私钥对 时间戳\n随机串\n主体\n 签名
+func signedNotify(t *testing.T, key *rsa.PrivateKey, signedBody, body []byte) *http.Request {
+	t.Helper()
+	timestamp, nonce := "1614556800", "nonce"
+	hashed := sha256.Sum256([]byte(timestamp + "\n" + nonce + "\n" + string(signedBody) + "\n"))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/pay/wechat/notify", bytes.NewReader(body))
+	req.Header.Set("Wechatpay-Timestamp", timestamp)
+	req.Header.Set("Wechatpay-Nonce", nonce)
+	req.Header.Set("Wechatpay-Signature", base64.StdEncoding.EncodeToString(sig))
+	return req
+}
+
+func TestWechatVerifyNotify(t *testing.T) {
+	keys := newWechatTestKeys(t)
+	w := newTestWechatPay(t, keys)
+	body := wechatNotifyBody(t, testApiV3Key, &wechatTransaction{
+		AppId:         "wxappid",
+		MchId:         "1900000001",
+		OutTradeNo:    "sn1",
+		TransactionId: "4200000001",
+		TradeState:    "SUCCESS",
+		SuccessTime:   "2021-03-01T10:00:00+08:00",
+		Amount:        wechatAmount{Total: 1234},
+	})
+
+	n, err := w.VerifyNotify(signedNotify(t, keys.platformKey, body, body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payTime, _ := time.Parse(time.RFC3339, "2021-03-01T10:00:00+08:00")
+	if n.OrderSn != "sn1" || n.TradeNo != "4200000001" || n.Status != "TRADE_SUCCESS" || n.Amount != money.FromCents(1234) ||
+		n.AppId != "wxappid" || !n.PayTime.Equal(payTime) {
+		t.Errorf("notification = %+v", n)
+	}
+
+	// 主体被篡改
+	tampered := bytes.Replace(body, []byte("TRANSACTION.SUCCESS"), []byte("TRANSACTION.FAILURE"), 1)
+	if _, err := w.VerifyNotify(signedNotify(t, keys.platformKey, body, tampered)); err == nil {
+		t.Error("主体被篡改的通知验签成功了")
+	}
+	// 不是微信支付平台证书签名的通知
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.VerifyNotify(signedNotify(t, other, body, body)); err == nil {
+		t.Error("其他私钥签名的通知验签成功了")
+	}
+	// 没有签名
+	if _, err := w.VerifyNotify(httptest.NewRequest("POST", "/pay/wechat/notify", bytes.NewReader(body))); err == nil {
+		t.Error("没有签名的通知验签成功了")
+	}
+	req := signedNotify(t, keys.platformKey, body, body)
+	req.Header.Set("Wechatpay-Signature", "not base64!")
+	if _, err := w.VerifyNotify(req); err == nil {
+		t.Error("签名格式错误的通知验签成功了")
+	}
+	// 签名正确但是不是用我们的APIv3密钥加密的
+	wrongKey := wechatNotifyBody(t, "fedcba9876543210fedcba9876543210", &wechatTransaction{OutTradeNo: "sn1", TradeState: "SUCCESS"})
+	if _, err := w.VerifyNotify(signedNotify(t, keys.platformKey, wrongKey, wrongKey)); err == nil {
+		t.Error("APIv3密钥不一致的通知解密成功了")
+	}
+}
+
+func TestNewWechatPay(t *testing.T) {
+	keys := newWechatTestKeys(t)
+	cases := map[string][]string{
+		"short api key":  {keys.merchantKey, "short", keys.platformCert},
+		"bad merchant":   {"not a key", testApiV3Key, keys.platformCert},
+		"no cert":        {keys.merchantKey, testApiV3Key, ""},
+		"bad cert bytes": {keys.merchantKey, testApiV3Key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("x")}))},
+	}
+	for name, c := range cases {
+		if _, err := NewWechatPay("wxappid", "1900000001", "SERIAL", c[0], c[1], c[2], ""); err == nil {
+			t.Errorf("%s: 应该返回错误", name)
+		}
+	}
+}
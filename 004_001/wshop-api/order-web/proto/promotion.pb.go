@@ -0,0 +1,1907 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.14.0
+// source: promotion.proto
+
+package proto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type CouponTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CouponType       int32   `protobuf:"varint,3,opt,name=couponType,proto3" json:"couponType,omitempty"`             //1(立减),2(折扣),3(满减)
+	ThresholdCents   int64   `protobuf:"varint,4,opt,name=thresholdCents,proto3" json:"thresholdCents,omitempty"`     //满多少可以使用， 单位为分
+	DiscountCents    int64   `protobuf:"varint,5,opt,name=discountCents,proto3" json:"discountCents,omitempty"`       //减多少， 单位为分
+	Percent          int32   `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`                   //折扣券支付的百分比， 85表示85折
+	MaxDiscountCents int64   `protobuf:"varint,7,opt,name=maxDiscountCents,proto3" json:"maxDiscountCents,omitempty"` //折扣券最多优惠的金额， 0表示不限制
+	ScopeType        int32   `protobuf:"varint,8,opt,name=scopeType,proto3" json:"scopeType,omitempty"`               //1(全场),2(指定分类),3(指定品牌)
+	ScopeIds         []int32 `protobuf:"varint,9,rep,packed,name=scopeIds,proto3" json:"scopeIds,omitempty"`
+	Total            int32   `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`               //发放总量， 0表示不限制
+	PerUserLimit     int32   `protobuf:"varint,11,opt,name=perUserLimit,proto3" json:"perUserLimit,omitempty"` //每人限领数量
+	ValidDays        int32   `protobuf:"varint,12,opt,name=validDays,proto3" json:"validDays,omitempty"`       //领取之后的有效天数， 0表示使用模板的有效期
+	StartTime        int64   `protobuf:"varint,13,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime          int64   `protobuf:"varint,14,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *CouponTemplateRequest) Reset() {
+	*x = CouponTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateRequest) ProtoMessage() {}
+
+func (x *CouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CouponTemplateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplateRequest) GetCouponType() int32 {
+	if x != nil {
+		return x.CouponType
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetThresholdCents() int64 {
+	if x != nil {
+		return x.ThresholdCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetMaxDiscountCents() int64 {
+	if x != nil {
+		return x.MaxDiscountCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetScopeType() int32 {
+	if x != nil {
+		return x.ScopeType
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetScopeIds() []int32 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CouponTemplateRequest) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CouponTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CouponType       int32   `protobuf:"varint,3,opt,name=couponType,proto3" json:"couponType,omitempty"`
+	ThresholdCents   int64   `protobuf:"varint,4,opt,name=thresholdCents,proto3" json:"thresholdCents,omitempty"`
+	DiscountCents    int64   `protobuf:"varint,5,opt,name=discountCents,proto3" json:"discountCents,omitempty"`
+	Percent          int32   `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	MaxDiscountCents int64   `protobuf:"varint,7,opt,name=maxDiscountCents,proto3" json:"maxDiscountCents,omitempty"`
+	ScopeType        int32   `protobuf:"varint,8,opt,name=scopeType,proto3" json:"scopeType,omitempty"`
+	ScopeIds         []int32 `protobuf:"varint,9,rep,packed,name=scopeIds,proto3" json:"scopeIds,omitempty"`
+	Total            int32   `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`
+	Claimed          int32   `protobuf:"varint,11,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	PerUserLimit     int32   `protobuf:"varint,12,opt,name=perUserLimit,proto3" json:"perUserLimit,omitempty"`
+	ValidDays        int32   `protobuf:"varint,13,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	StartTime        int64   `protobuf:"varint,14,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime          int64   `protobuf:"varint,15,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *CouponTemplateResponse) Reset() {
+	*x = CouponTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateResponse) ProtoMessage() {}
+
+func (x *CouponTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CouponTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CouponTemplateResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplateResponse) GetCouponType() int32 {
+	if x != nil {
+		return x.CouponType
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetThresholdCents() int64 {
+	if x != nil {
+		return x.ThresholdCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetMaxDiscountCents() int64 {
+	if x != nil {
+		return x.MaxDiscountCents
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetScopeType() int32 {
+	if x != nil {
+		return x.ScopeType
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetScopeIds() []int32 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CouponTemplateResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetClaimed() int32 {
+	if x != nil {
+		return x.Claimed
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CouponTemplateResponse) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CouponTemplateFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Available   bool  `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"` //只返回当前可以领取的
+	Pages       int32 `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums int32 `protobuf:"varint,3,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+}
+
+func (x *CouponTemplateFilterRequest) Reset() {
+	*x = CouponTemplateFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponTemplateFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateFilterRequest) ProtoMessage() {}
+
+func (x *CouponTemplateFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateFilterRequest.ProtoReflect.Descriptor instead.
+func (*CouponTemplateFilterRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CouponTemplateFilterRequest) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CouponTemplateFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *CouponTemplateFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type CouponTemplateListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int32                     `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data  []*CouponTemplateResponse `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CouponTemplateListResponse) Reset() {
+	*x = CouponTemplateListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponTemplateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateListResponse) ProtoMessage() {}
+
+func (x *CouponTemplateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateListResponse.ProtoReflect.Descriptor instead.
+func (*CouponTemplateListResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CouponTemplateListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateListResponse) GetData() []*CouponTemplateResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ClaimCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TemplateId int32 `protobuf:"varint,2,opt,name=templateId,proto3" json:"templateId,omitempty"`
+}
+
+func (x *ClaimCouponRequest) Reset() {
+	*x = ClaimCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponRequest) ProtoMessage() {}
+
+func (x *ClaimCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCouponRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimCouponRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClaimCouponRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+type UserCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    int32                   `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status    int32                   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"` //1(未使用),2(已锁定),3(已使用)
+	OrderSn   string                  `protobuf:"bytes,4,opt,name=orderSn,proto3" json:"orderSn,omitempty"`
+	ValidFrom int64                   `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidTo   int64                   `protobuf:"varint,6,opt,name=validTo,proto3" json:"validTo,omitempty"`
+	Template  *CouponTemplateResponse `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UserCouponResponse) Reset() {
+	*x = UserCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponResponse) ProtoMessage() {}
+
+func (x *UserCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponResponse.ProtoReflect.Descriptor instead.
+func (*UserCouponResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserCouponResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserCouponResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserCouponResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserCouponResponse) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *UserCouponResponse) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *UserCouponResponse) GetValidTo() int64 {
+	if x != nil {
+		return x.ValidTo
+	}
+	return 0
+}
+
+func (x *UserCouponResponse) GetTemplate() *CouponTemplateResponse {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UserCouponFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status      int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pages       int32 `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums int32 `protobuf:"varint,4,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+}
+
+func (x *UserCouponFilterRequest) Reset() {
+	*x = UserCouponFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCouponFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponFilterRequest) ProtoMessage() {}
+
+func (x *UserCouponFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponFilterRequest.ProtoReflect.Descriptor instead.
+func (*UserCouponFilterRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserCouponFilterRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserCouponFilterRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserCouponFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *UserCouponFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type UserCouponListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data  []*UserCouponResponse `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserCouponListResponse) Reset() {
+	*x = UserCouponListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCouponListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponListResponse) ProtoMessage() {}
+
+func (x *UserCouponListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponListResponse.ProtoReflect.Descriptor instead.
+func (*UserCouponListResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserCouponListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserCouponListResponse) GetData() []*UserCouponResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DiscountGoodsItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoodsId    int32 `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	CategoryId int32 `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId    int32 `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	PriceCents int64 `protobuf:"varint,4,opt,name=priceCents,proto3" json:"priceCents,omitempty"`
+	Nums       int32 `protobuf:"varint,5,opt,name=nums,proto3" json:"nums,omitempty"`
+}
+
+func (x *DiscountGoodsItem) Reset() {
+	*x = DiscountGoodsItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscountGoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountGoodsItem) ProtoMessage() {}
+
+func (x *DiscountGoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountGoodsItem.ProtoReflect.Descriptor instead.
+func (*DiscountGoodsItem) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiscountGoodsItem) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *DiscountGoodsItem) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *DiscountGoodsItem) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *DiscountGoodsItem) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *DiscountGoodsItem) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type CalculateDiscountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int32                `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Goods     []*DiscountGoodsItem `protobuf:"bytes,2,rep,name=goods,proto3" json:"goods,omitempty"`
+	CouponIds []int32              `protobuf:"varint,3,rep,packed,name=couponIds,proto3" json:"couponIds,omitempty"`
+}
+
+func (x *CalculateDiscountRequest) Reset() {
+	*x = CalculateDiscountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountRequest) ProtoMessage() {}
+
+func (x *CalculateDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountRequest.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CalculateDiscountRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CalculateDiscountRequest) GetGoods() []*DiscountGoodsItem {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *CalculateDiscountRequest) GetCouponIds() []int32 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+type CouponDiscount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CouponId      int32  `protobuf:"varint,1,opt,name=couponId,proto3" json:"couponId,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountCents int64  `protobuf:"varint,3,opt,name=discountCents,proto3" json:"discountCents,omitempty"`
+}
+
+func (x *CouponDiscount) Reset() {
+	*x = CouponDiscount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponDiscount) ProtoMessage() {}
+
+func (x *CouponDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponDiscount.ProtoReflect.Descriptor instead.
+func (*CouponDiscount) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CouponDiscount) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CouponDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponDiscount) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+type CalculateDiscountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCents    int64             `protobuf:"varint,1,opt,name=totalCents,proto3" json:"totalCents,omitempty"`       //商品总金额
+	DiscountCents int64             `protobuf:"varint,2,opt,name=discountCents,proto3" json:"discountCents,omitempty"` //优惠金额
+	Coupons       []*CouponDiscount `protobuf:"bytes,3,rep,name=coupons,proto3" json:"coupons,omitempty"`
+}
+
+func (x *CalculateDiscountResponse) Reset() {
+	*x = CalculateDiscountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountResponse) ProtoMessage() {}
+
+func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CalculateDiscountResponse) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *CalculateDiscountResponse) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *CalculateDiscountResponse) GetCoupons() []*CouponDiscount {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+type LockCouponsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int32                `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrderSn   string               `protobuf:"bytes,2,opt,name=orderSn,proto3" json:"orderSn,omitempty"`
+	Goods     []*DiscountGoodsItem `protobuf:"bytes,3,rep,name=goods,proto3" json:"goods,omitempty"`
+	CouponIds []int32              `protobuf:"varint,4,rep,packed,name=couponIds,proto3" json:"couponIds,omitempty"`
+}
+
+func (x *LockCouponsRequest) Reset() {
+	*x = LockCouponsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCouponsRequest) ProtoMessage() {}
+
+func (x *LockCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCouponsRequest.ProtoReflect.Descriptor instead.
+func (*LockCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LockCouponsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LockCouponsRequest) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *LockCouponsRequest) GetGoods() []*DiscountGoodsItem {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *LockCouponsRequest) GetCouponIds() []int32 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+type CouponOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderSn string `protobuf:"bytes,1,opt,name=orderSn,proto3" json:"orderSn,omitempty"`
+}
+
+func (x *CouponOrderRequest) Reset() {
+	*x = CouponOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_promotion_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponOrderRequest) ProtoMessage() {}
+
+func (x *CouponOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponOrderRequest.ProtoReflect.Descriptor instead.
+func (*CouponOrderRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CouponOrderRequest) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+var File_promotion_proto protoreflect.FileDescriptor
+
+var file_promotion_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9,
+	0x03, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x79,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd4, 0x03, 0x0a, 0x16, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x73, 0x0a, 0x1b, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x4e,
+	0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x73, 0x22, 0x5f, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4c, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x33,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x73, 0x22, 0x57, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x9b, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x22, 0x7a,
+	0x0a, 0x18, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x6f, 0x6f, 0x64,
+	0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x0e, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x6f,
+	0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x67,
+	0x6f, 0x6f, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6e, 0x32, 0xa9, 0x04, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x47, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1c, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x12, 0x13, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_promotion_proto_rawDescOnce sync.Once
+	file_promotion_proto_rawDescData = file_promotion_proto_rawDesc
+)
+
+func file_promotion_proto_rawDescGZIP() []byte {
+	file_promotion_proto_rawDescOnce.Do(func() {
+		file_promotion_proto_rawDescData = protoimpl.X.CompressGZIP(file_promotion_proto_rawDescData)
+	})
+	return file_promotion_proto_rawDescData
+}
+
+var file_promotion_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_promotion_proto_goTypes = []interface{}{
+	(*CouponTemplateRequest)(nil),       // 0: CouponTemplateRequest
+	(*CouponTemplateResponse)(nil),      // 1: CouponTemplateResponse
+	(*CouponTemplateFilterRequest)(nil), // 2: CouponTemplateFilterRequest
+	(*CouponTemplateListResponse)(nil),  // 3: CouponTemplateListResponse
+	(*ClaimCouponRequest)(nil),          // 4: ClaimCouponRequest
+	(*UserCouponResponse)(nil),          // 5: UserCouponResponse
+	(*UserCouponFilterRequest)(nil),     // 6: UserCouponFilterRequest
+	(*UserCouponListResponse)(nil),      // 7: UserCouponListResponse
+	(*DiscountGoodsItem)(nil),           // 8: DiscountGoodsItem
+	(*CalculateDiscountRequest)(nil),    // 9: CalculateDiscountRequest
+	(*CouponDiscount)(nil),              // 10: CouponDiscount
+	(*CalculateDiscountResponse)(nil),   // 11: CalculateDiscountResponse
+	(*LockCouponsRequest)(nil),          // 12: LockCouponsRequest
+	(*CouponOrderRequest)(nil),          // 13: CouponOrderRequest
+	(*emptypb.Empty)(nil),               // 14: google.protobuf.Empty
+}
+var file_promotion_proto_depIdxs = []int32{
+	1,  // 0: CouponTemplateListResponse.data:type_name -> CouponTemplateResponse
+	1,  // 1: UserCouponResponse.template:type_name -> CouponTemplateResponse
+	5,  // 2: UserCouponListResponse.data:type_name -> UserCouponResponse
+	8,  // 3: CalculateDiscountRequest.goods:type_name -> DiscountGoodsItem
+	10, // 4: CalculateDiscountResponse.coupons:type_name -> CouponDiscount
+	8,  // 5: LockCouponsRequest.goods:type_name -> DiscountGoodsItem
+	0,  // 6: Promotion.CreateCouponTemplate:input_type -> CouponTemplateRequest
+	2,  // 7: Promotion.CouponTemplateList:input_type -> CouponTemplateFilterRequest
+	4,  // 8: Promotion.ClaimCoupon:input_type -> ClaimCouponRequest
+	6,  // 9: Promotion.UserCouponList:input_type -> UserCouponFilterRequest
+	9,  // 10: Promotion.CalculateDiscount:input_type -> CalculateDiscountRequest
+	12, // 11: Promotion.LockCoupons:input_type -> LockCouponsRequest
+	13, // 12: Promotion.ReleaseCoupons:input_type -> CouponOrderRequest
+	13, // 13: Promotion.UseCoupons:input_type -> CouponOrderRequest
+	1,  // 14: Promotion.CreateCouponTemplate:output_type -> CouponTemplateResponse
+	3,  // 15: Promotion.CouponTemplateList:output_type -> CouponTemplateListResponse
+	5,  // 16: Promotion.ClaimCoupon:output_type -> UserCouponResponse
+	7,  // 17: Promotion.UserCouponList:output_type -> UserCouponListResponse
+	11, // 18: Promotion.CalculateDiscount:output_type -> CalculateDiscountResponse
+	11, // 19: Promotion.LockCoupons:output_type -> CalculateDiscountResponse
+	14, // 20: Promotion.ReleaseCoupons:output_type -> google.protobuf.Empty
+	14, // 21: Promotion.UseCoupons:output_type -> google.protobuf.Empty
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_promotion_proto_init() }
+func file_promotion_proto_init() {
+	if File_promotion_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_promotion_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponTemplateFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponTemplateListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCouponFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCouponListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscountGoodsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateDiscountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponDiscount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateDiscountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockCouponsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_promotion_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CouponOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_promotion_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_promotion_proto_goTypes,
+		DependencyIndexes: file_promotion_proto_depIdxs,
+		MessageInfos:      file_promotion_proto_msgTypes,
+	}.Build()
+	File_promotion_proto = out.File
+	file_promotion_proto_rawDesc = nil
+	file_promotion_proto_goTypes = nil
+	file_promotion_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PromotionClient is the client API for Promotion service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PromotionClient interface {
+	//优惠券模板
+	CreateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateResponse, error)
+	CouponTemplateList(ctx context.Context, in *CouponTemplateFilterRequest, opts ...grpc.CallOption) (*CouponTemplateListResponse, error)
+	//用户优惠券
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error)
+	UserCouponList(ctx context.Context, in *UserCouponFilterRequest, opts ...grpc.CallOption) (*UserCouponListResponse, error)
+	//下单使用优惠券
+	CalculateDiscount(ctx context.Context, in *CalculateDiscountRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+	LockCoupons(ctx context.Context, in *LockCouponsRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+	ReleaseCoupons(ctx context.Context, in *CouponOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UseCoupons(ctx context.Context, in *CouponOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type promotionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromotionClient(cc grpc.ClientConnInterface) PromotionClient {
+	return &promotionClient{cc}
+}
+
+func (c *promotionClient) CreateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateResponse, error) {
+	out := new(CouponTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/CreateCouponTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) CouponTemplateList(ctx context.Context, in *CouponTemplateFilterRequest, opts ...grpc.CallOption) (*CouponTemplateListResponse, error) {
+	out := new(CouponTemplateListResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/CouponTemplateList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error) {
+	out := new(UserCouponResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/ClaimCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) UserCouponList(ctx context.Context, in *UserCouponFilterRequest, opts ...grpc.CallOption) (*UserCouponListResponse, error) {
+	out := new(UserCouponListResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/UserCouponList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) CalculateDiscount(ctx context.Context, in *CalculateDiscountRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error) {
+	out := new(CalculateDiscountResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/CalculateDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) LockCoupons(ctx context.Context, in *LockCouponsRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error) {
+	out := new(CalculateDiscountResponse)
+	err := c.cc.Invoke(ctx, "/Promotion/LockCoupons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) ReleaseCoupons(ctx context.Context, in *CouponOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Promotion/ReleaseCoupons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionClient) UseCoupons(ctx context.Context, in *CouponOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Promotion/UseCoupons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromotionServer is the server API for Promotion service.
+type PromotionServer interface {
+	//优惠券模板
+	CreateCouponTemplate(context.Context, *CouponTemplateRequest) (*CouponTemplateResponse, error)
+	CouponTemplateList(context.Context, *CouponTemplateFilterRequest) (*CouponTemplateListResponse, error)
+	//用户优惠券
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponResponse, error)
+	UserCouponList(context.Context, *UserCouponFilterRequest) (*UserCouponListResponse, error)
+	//下单使用优惠券
+	CalculateDiscount(context.Context, *CalculateDiscountRequest) (*CalculateDiscountResponse, error)
+	LockCoupons(context.Context, *LockCouponsRequest) (*CalculateDiscountResponse, error)
+	ReleaseCoupons(context.Context, *CouponOrderRequest) (*emptypb.Empty, error)
+	UseCoupons(context.Context, *CouponOrderRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedPromotionServer can be embedded to have forward compatible implementations.
+type UnimplementedPromotionServer struct {
+}
+
+func (*UnimplementedPromotionServer) CreateCouponTemplate(context.Context, *CouponTemplateRequest) (*CouponTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCouponTemplate not implemented")
+}
+func (*UnimplementedPromotionServer) CouponTemplateList(context.Context, *CouponTemplateFilterRequest) (*CouponTemplateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouponTemplateList not implemented")
+}
+func (*UnimplementedPromotionServer) ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (*UnimplementedPromotionServer) UserCouponList(context.Context, *UserCouponFilterRequest) (*UserCouponListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCouponList not implemented")
+}
+func (*UnimplementedPromotionServer) CalculateDiscount(context.Context, *CalculateDiscountRequest) (*CalculateDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateDiscount not implemented")
+}
+func (*UnimplementedPromotionServer) LockCoupons(context.Context, *LockCouponsRequest) (*CalculateDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockCoupons not implemented")
+}
+func (*UnimplementedPromotionServer) ReleaseCoupons(context.Context, *CouponOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCoupons not implemented")
+}
+func (*UnimplementedPromotionServer) UseCoupons(context.Context, *CouponOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UseCoupons not implemented")
+}
+
+func RegisterPromotionServer(s *grpc.Server, srv PromotionServer) {
+	s.RegisterService(&_Promotion_serviceDesc, srv)
+}
+
+func _Promotion_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/CreateCouponTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).CreateCouponTemplate(ctx, req.(*CouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_CouponTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).CouponTemplateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/CouponTemplateList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).CouponTemplateList(ctx, req.(*CouponTemplateFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/ClaimCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_UserCouponList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).UserCouponList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/UserCouponList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).UserCouponList(ctx, req.(*UserCouponFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_CalculateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).CalculateDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/CalculateDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).CalculateDiscount(ctx, req.(*CalculateDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_LockCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).LockCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/LockCoupons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).LockCoupons(ctx, req.(*LockCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_ReleaseCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).ReleaseCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/ReleaseCoupons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).ReleaseCoupons(ctx, req.(*CouponOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Promotion_UseCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServer).UseCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Promotion/UseCoupons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServer).UseCoupons(ctx, req.(*CouponOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Promotion_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Promotion",
+	HandlerType: (*PromotionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _Promotion_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "CouponTemplateList",
+			Handler:    _Promotion_CouponTemplateList_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _Promotion_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "UserCouponList",
+			Handler:    _Promotion_UserCouponList_Handler,
+		},
+		{
+			MethodName: "CalculateDiscount",
+			Handler:    _Promotion_CalculateDiscount_Handler,
+		},
+		{
+			MethodName: "LockCoupons",
+			Handler:    _Promotion_LockCoupons_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupons",
+			Handler:    _Promotion_ReleaseCoupons_Handler,
+		},
+		{
+			MethodName: "UseCoupons",
+			Handler:    _Promotion_UseCoupons_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promotion.proto",
+}
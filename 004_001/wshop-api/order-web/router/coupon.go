@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wshop-api/order-web/api/coupon"
+	"wshop-api/order-web/middlewares"
+)
+
+func InitCouponRouter(Router *gin.RouterGroup) {
+	CouponRouter := Router.Group("coupons").Use(middlewares.JWTAuth()).Use(middlewares.Trace())
+	{
+		CouponRouter.GET("", coupon.List)                                              // 我的优惠券
+		CouponRouter.GET("/best", coupon.Best)                                         // 购物车选中商品的最优优惠
+		CouponRouter.GET("/templates", coupon.TemplateList)                            // 可以领取的优惠券
+		CouponRouter.POST("/templates", middlewares.IsAdminAuth(), coupon.NewTemplate) // 创建优惠券模板， 需要管理员权限
+		CouponRouter.POST("/templates/:id/claim", coupon.Claim)                        // 领取优惠券
+	}
+}
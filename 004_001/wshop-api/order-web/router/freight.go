@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wshop-api/order-web/api/freight"
+	"wshop-api/order-web/middlewares"
+)
+
+func InitFreightRouter(Router *gin.RouterGroup) {
+	// 运费模板只有管理员可以维护
+	FreightRouter := Router.Group("freights").Use(middlewares.JWTAuth()).Use(middlewares.IsAdminAuth()).Use(middlewares.Trace())
+	{
+		FreightRouter.GET("", freight.List)          // 运费模板列表
+		FreightRouter.POST("", freight.New)          // 新建运费模板
+		FreightRouter.PUT("/:id", freight.Update)    // 修改运费模板
+		FreightRouter.DELETE("/:id", freight.Delete) // 删除运费模板
+	}
+}
@@ -11,12 +11,27 @@ import (
 func InitOrderRouter(Router *gin.RouterGroup) {
 	OrderRouter := Router.Group("orders").Use(middlewares.JWTAuth()).Use(middlewares.Trace())
 	{
-		OrderRouter.GET("", order.List)       // 订单列表
-		OrderRouter.POST("", order.New)       // 新建订单
-		OrderRouter.GET("/:id", order.Detail) // 订单详情
+		OrderRouter.GET("", order.List)                                      // 订单列表
+		OrderRouter.POST("", order.New)                                      // 新建订单
+		OrderRouter.POST("/preview", order.Preview)                          // 结算预览
+		OrderRouter.GET("/:id", order.Detail)                                // 订单详情
+		OrderRouter.POST("/:id/pay", order.Pay)                              // 发起支付， 可以切换支付方式
+		OrderRouter.POST("/:id/cancel", order.Cancel)                        // 取消订单
+		OrderRouter.POST("/:id/confirm", order.Confirm)                      // 确认收货
+		OrderRouter.POST("/:id/ship", middlewares.IsAdminAuth(), order.Ship) // 订单发货， 需要管理员权限
+		OrderRouter.GET("/:id/shipments", order.Shipments)                   // 订单的物流信息
+	}
+	// 订单状态的推送是长连接， 单独使用一个分组
+	EventRouter := Router.Group("orders").Use(middlewares.QueryTokenAuth())
+	{
+		EventRouter.GET("/:id/events", order.Events) // 订单状态变化的推送(Server-Sent Events)
 	}
 	PayRouter := Router.Group("pay")
 	{
-		PayRouter.POST("alipay/notify", pay.Notify)
+		PayRouter.POST("alipay/notify", pay.Notify("alipay"))
+		PayRouter.POST("wechat/notify", pay.Notify("wechat"))
+		PayRouter.POST("mock/notify", pay.Notify("mock"))
+		PayRouter.GET("mock/cashier", pay.MockCashier) // 模拟收银台
+		PayRouter.POST("mock/cashier", pay.MockPay)
 	}
 }
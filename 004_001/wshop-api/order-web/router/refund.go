@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wshop-api/order-web/api/refund"
+	"wshop-api/order-web/middlewares"
+)
+
+func InitRefundRouter(Router *gin.RouterGroup) {
+	RefundRouter := Router.Group("refunds").Use(middlewares.JWTAuth()).Use(middlewares.Trace())
+	{
+		RefundRouter.GET("", refund.List)                                   // 退款列表
+		RefundRouter.POST("", refund.New)                                   // 申请退款
+		RefundRouter.GET("/:id", refund.Detail)                             // 退款详情
+		RefundRouter.PATCH("/:id", middlewares.IsAdminAuth(), refund.Audit) // 审核退款， 需要管理员权限
+	}
+}
@@ -7,7 +7,10 @@ import (
 )
 
 func InitShopCartRouter(Router *gin.RouterGroup) {
-	GoodsRouter := Router.Group("shopcarts").Use(middlewares.JWTAuth())
+	// 游客的设备token， 不需要登录
+	Router.POST("shopcarts/device", shop_cart.Device)
+
+	GoodsRouter := Router.Group("shopcarts").Use(middlewares.CartAuth())
 	{
 		GoodsRouter.GET("", shop_cart.List)          // 购物车列表
 		GoodsRouter.DELETE("/:id", shop_cart.Delete) // 删除条目
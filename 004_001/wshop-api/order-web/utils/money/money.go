@@ -0,0 +1,147 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money 金额， 单位为分
+// 数据库中使用DECIMAL(10,2)保存， proto中使用int64的分传递， 避免float在计算和传递过程中的精度问题
+type Money int64
+
+func FromCents(cents int64) Money {
+	return Money(cents)
+}
+
+// FromYuan 把旧的float金额转换成分， 四舍五入到分
+func FromYuan(yuan float32) Money {
+	return Money(math.Round(float64(yuan) * 100))
+}
+
+// FromProto 兼容新旧两种字段， 分的字段有值的时候优先使用
+func FromProto(cents int64, yuan float32) Money {
+	if cents != 0 {
+		return Money(cents)
+	}
+	return FromYuan(yuan)
+}
+
+// Parse 解析 "12.34"、"12.3"、"12" 这样的字符串， 超过两位小数返回错误
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	yuanPart, centPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		yuanPart, centPart = s[:i], s[i+1:]
+	}
+	// DECIMAL(10,2)读出来的一定是两位小数， 更多的小数位只能是0
+	if len(centPart) > 2 {
+		if strings.Trim(centPart[2:], "0") != "" {
+			return 0, fmt.Errorf("金额 %s 超过了两位小数", s)
+		}
+		centPart = centPart[:2]
+	}
+	for len(centPart) < 2 {
+		centPart += "0"
+	}
+	if yuanPart == "" {
+		yuanPart = "0"
+	}
+
+	// 符号只能出现在最前面， ParseInt会接受"-1"、"+1"
+	yuan, err := strconv.ParseInt(yuanPart, 10, 64)
+	if err != nil || strings.ContainsAny(yuanPart, "+-") {
+		return 0, fmt.Errorf("金额 %s 格式不正确", s)
+	}
+	cents, err := strconv.ParseInt(centPart, 10, 64)
+	if err != nil || cents < 0 {
+		return 0, fmt.Errorf("金额 %s 格式不正确", s)
+	}
+
+	m := Money(yuan*100 + cents)
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+// Yuan 转换成float， 只用来填充proto中旧的float字段， 不要再用来计算
+func (m Money) Yuan() float32 {
+	return float32(m) / 100
+}
+
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Mul 单价乘以数量
+func (m Money) Mul(nums int32) Money {
+	return m * Money(nums)
+}
+
+// Sum 计算多个金额的和
+func Sum(items ...Money) Money {
+	var total Money
+	for _, item := range items {
+		total += item
+	}
+	return total
+}
+
+// String 格式化成两位小数， 支付宝等支付渠道需要的金额格式
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Value 实现 driver.Valuer 接口， 以字符串写入DECIMAL字段， 不经过float
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan 实现 sql.Scanner 接口
+// 数据库中保存的是元， 不同的驱动会把DECIMAL读成字符串、整数或者浮点数， 都按照元格式化之后由Parse解析， 单位保持一致
+func (m *Money) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', 2, 64)
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', 2, 32)
+	default:
+		return fmt.Errorf("不支持的金额类型: %T", value)
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
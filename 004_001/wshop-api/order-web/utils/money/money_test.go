@@ -0,0 +1,111 @@
+package money
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Money
+	}{
+		{"12.34", 1234},
+		{"12.3", 1230},
+		{"12", 1200},
+		{".5", 50},
+		{" 3.10 ", 310},
+		{"12.3400", 1234},
+		{"0", 0},
+		{"-0.05", -5},
+		{"-12.34", -1234},
+	}
+	for _, c := range cases {
+		got, err := Parse(c.in)
+		if err != nil || got != c.want {
+			t.Errorf("Parse(%q) = %d, %v, want %d", c.in, got, err, c.want)
+		}
+	}
+
+	for _, in := range []string{"12.345", "abc", "1.a", "1.-5", "--1", "+1", "-+1"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) 应该返回错误", in)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := map[Money]string{
+		0:     "0.00",
+		5:     "0.05",
+		1230:  "12.30",
+		-5:    "-0.05",
+		-1234: "-12.34",
+	}
+	for m, want := range cases {
+		if got := m.String(); got != want {
+			t.Errorf("Money(%d).String() = %q, want %q", int64(m), got, want)
+		}
+	}
+}
+
+func TestFromYuan(t *testing.T) {
+	// float32的0.29乘以100是28.99999， 需要四舍五入
+	cases := map[float32]Money{0.29: 29, 19.99: 1999, -0.29: -29, 0.125: 13}
+	for yuan, want := range cases {
+		if got := FromYuan(yuan); got != want {
+			t.Errorf("FromYuan(%v) = %d, want %d", yuan, got, want)
+		}
+	}
+	if got := FromProto(0, 1.5); got != 150 {
+		t.Errorf("FromProto(0, 1.5) = %d", got)
+	}
+	if got := FromProto(99, 1.5); got != 99 {
+		t.Errorf("FromProto(99, 1.5) = %d", got)
+	}
+}
+
+func TestScan(t *testing.T) {
+	// 不同的驱动返回的类型不同， 都按照元解析
+	cases := []struct {
+		value interface{}
+		want  Money
+	}{
+		{nil, 0},
+		{[]byte("12.34"), 1234},
+		{"-12.34", -1234},
+		{int64(12), 1200},
+		{int64(-3), -300},
+		{float64(19.99), 1999},
+		{0.1 + 0.2, 30},
+		{float64(-0.5), -50},
+		{float32(1.1), 110},
+	}
+	for _, c := range cases {
+		m := Money(1)
+		if err := m.Scan(c.value); err != nil || m != c.want {
+			t.Errorf("Scan(%#v) = %d, %v, want %d", c.value, m, err, c.want)
+		}
+	}
+
+	var m Money
+	if err := m.Scan("12.345"); err == nil {
+		t.Error("超过两位小数的金额应该返回错误")
+	}
+	if err := m.Scan(true); err == nil {
+		t.Error("不支持的类型应该返回错误")
+	}
+}
+
+func TestValue(t *testing.T) {
+	for _, m := range []Money{0, 5, 1234, -5, -1234} {
+		v, err := m.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var scanned Money
+		if err := scanned.Scan(v); err != nil || scanned != m {
+			t.Errorf("Money(%d)写入%v之后读出%d, %v", int64(m), v, scanned, err)
+		}
+	}
+	if v, _ := Money(-1234).Value(); v != "-12.34" {
+		t.Errorf("Value = %v", v)
+	}
+}
@@ -118,6 +118,20 @@ func GetUserList(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, reMap)
 }
 
+// mergeGuestCart 登录之前作为游客加入购物车的商品合并到用户的购物车， 合并失败不影响登录
+func mergeGuestCart(c *gin.Context, userId int32) {
+	deviceToken := c.Request.Header.Get("x-device-token")
+	if deviceToken == "" {
+		return
+	}
+	if _, err := global.OrderSrvClient.MergeCart(context.Background(), &proto.MergeCartRequest{
+		UserId:      userId,
+		DeviceToken: deviceToken,
+	}); err != nil {
+		zap.S().Errorw("[mergeGuestCart] 合并 【购物车】失败", "msg", err.Error())
+	}
+}
+
 func PassWordLogin(c *gin.Context) {
 	// 表单验证
 	passwordLoginForm := forms.PassWordLoginForm{}
@@ -181,6 +195,7 @@ func PassWordLogin(c *gin.Context) {
 					return
 				}
 
+				mergeGuestCart(c, rsp.Id)
 				c.JSON(http.StatusOK, gin.H{
 					"id":         rsp.Id,
 					"nick_name":  rsp.NickName,
@@ -254,6 +269,7 @@ func Register(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c, user.Id)
 	c.JSON(http.StatusOK, gin.H{
 		"id":         user.Id,
 		"nick_name":  user.NickName,
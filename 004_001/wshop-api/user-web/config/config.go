@@ -6,6 +6,10 @@ type UserSrvConfig struct {
 	Name string `mapstructure:"name" json:"name"`
 }
 
+type OrderSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
 type JWTConfig struct {
 	SigningKey string `mapstructure:"key" json:"key"`
 }
@@ -36,6 +40,8 @@ type ServerConfig struct {
 	AliSmsInfo  AliSmsConfig  `mapstructure:"sms" json:"sms"`
 	RedisInfo   RedisConfig   `mapstructure:"redis" json:"redis"`
 	ConsulInfo  ConsulConfig  `mapstructure:"consul" json:"consul"`
+	// 订单服务的配置， 登录之后合并游客的购物车
+	OrderSrvInfo OrderSrvConfig `mapstructure:"order_srv" json:"order_srv"`
 }
 
 type NacosConfig struct {
@@ -13,5 +13,6 @@ var (
 
 	NacosConfig *config.NacosConfig = &config.NacosConfig{}
 
-	UserSrvClient proto.UserClient
+	UserSrvClient  proto.UserClient
+	OrderSrvClient proto.OrderClient
 )
@@ -24,6 +24,17 @@ func InitSrvConn() {
 
 	userSrvClient := proto.NewUserClient(userConn)
 	global.UserSrvClient = userSrvClient
+
+	// 登录之后合并游客的购物车
+	orderConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.OrderSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【订单服务失败】")
+	}
+	global.OrderSrvClient = proto.NewOrderClient(orderConn)
 }
 
 func InitSrvConn2() {
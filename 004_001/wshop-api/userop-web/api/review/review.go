@@ -0,0 +1,151 @@
+package review
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"wshop-api/userop-web/api"
+	"wshop-api/userop-web/forms"
+	"wshop-api/userop-web/global"
+	"wshop-api/userop-web/models"
+	"wshop-api/userop-web/proto"
+)
+
+func reviewToMap(value *proto.ReviewResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           value.Id,
+		"user_id":      value.UserId,
+		"goods_id":     value.GoodsId,
+		"order_id":     value.OrderId,
+		"order_goods":  value.OrderGoodsId,
+		"rating":       value.Rating,
+		"content":      value.Content,
+		"images":       value.Images,
+		"status":       value.Status,
+		"admin_remark": value.AdminRemark,
+		"reply":        value.Reply,
+		"reply_time":   value.ReplyTime,
+		"add_time":     value.AddTime,
+	}
+}
+
+func List(ctx *gin.Context) {
+	request := &proto.ReviewFilterRequest{}
+
+	// 普通用户只能看到自己的评价， 管理员可以按照商品和审核状态过滤
+	userId, _ := ctx.Get("userId")
+	claims, _ := ctx.Get("claims")
+	model := claims.(*models.CustomClaims)
+	if model.AuthorityId == 1 {
+		request.UserId = int32(userId.(uint))
+	} else {
+		goodsId, _ := strconv.Atoi(ctx.DefaultQuery("goods", "0"))
+		request.GoodsId = int32(goodsId)
+		reviewStatus, _ := strconv.Atoi(ctx.DefaultQuery("status", "0"))
+		request.Status = int32(reviewStatus)
+	}
+
+	pages, _ := strconv.Atoi(ctx.DefaultQuery("p", "0"))
+	request.Pages = int32(pages)
+	perNums, _ := strconv.Atoi(ctx.DefaultQuery("pnum", "0"))
+	request.PagePerNums = int32(perNums)
+
+	rsp, err := global.ReviewClient.ReviewList(context.Background(), request)
+	if err != nil {
+		zap.S().Errorw("获取评价列表失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	result := make([]interface{}, 0)
+	for _, value := range rsp.Data {
+		result = append(result, reviewToMap(value))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  result,
+	})
+}
+
+func New(ctx *gin.Context) {
+	reviewForm := forms.ReviewForm{}
+	if err := ctx.ShouldBindJSON(&reviewForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	userId, _ := ctx.Get("userId")
+	rsp, err := global.ReviewClient.CreateReview(context.Background(), &proto.ReviewRequest{
+		UserId:       int32(userId.(uint)),
+		OrderGoodsId: reviewForm.OrderGoodsId,
+		Rating:       reviewForm.Rating,
+		Content:      reviewForm.Content,
+		Images:       reviewForm.Images,
+	})
+	if err != nil {
+		zap.S().Errorw("添加评价失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reviewToMap(rsp))
+}
+
+func Audit(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	auditForm := forms.ReviewAuditForm{}
+	if err := ctx.ShouldBindJSON(&auditForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	_, err = global.ReviewClient.AuditReview(context.Background(), &proto.ReviewAuditRequest{
+		Id:          int32(i),
+		Approved:    *auditForm.Approved,
+		AdminRemark: auditForm.Remark,
+	})
+	if err != nil {
+		zap.S().Errorw("审核评价失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+func Reply(ctx *gin.Context) {
+	id := ctx.Param("id")
+	i, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	replyForm := forms.ReviewReplyForm{}
+	if err := ctx.ShouldBindJSON(&replyForm); err != nil {
+		api.HandleValidatorError(ctx, err)
+		return
+	}
+
+	_, err = global.ReviewClient.ReplyReview(context.Background(), &proto.ReviewReplyRequest{
+		Id:    int32(i),
+		Reply: replyForm.Reply,
+	})
+	if err != nil {
+		zap.S().Errorw("回复评价失败")
+		api.HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,17 @@
+package forms
+
+type ReviewForm struct {
+	OrderGoodsId int32    `form:"order_goods" json:"order_goods" binding:"required"`
+	Rating       int32    `form:"rating" json:"rating" binding:"required,min=1,max=5"`
+	Content      string   `form:"content" json:"content" binding:"required,max=500"`
+	Images       []string `form:"images" json:"images" binding:"max=9,dive,url"` // 先通过oss-web上传， 这里只提交图片的地址
+}
+
+type ReviewReplyForm struct {
+	Reply string `form:"reply" json:"reply" binding:"required,max=500"`
+}
+
+type ReviewAuditForm struct {
+	Approved *bool  `form:"approved" json:"approved" binding:"required"`
+	Remark   string `form:"remark" json:"remark" binding:"max=200"`
+}
@@ -18,4 +18,5 @@ var (
 	MessageClient proto.MessageClient
 	AddressClient proto.AddressClient
 	UserFavClient proto.UserFavClient
+	ReviewClient  proto.ReviewClient
 )
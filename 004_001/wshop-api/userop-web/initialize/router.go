@@ -23,6 +23,7 @@ func Routers() *gin.Engine {
 	router.InitUserFavRouter(ApiGroup)
 	router.InitMessageRouter(ApiGroup)
 	router.InitAddressRouter(ApiGroup)
+	router.InitReviewRouter(ApiGroup)
 
 	return Router
 }
@@ -35,4 +35,5 @@ func InitSrvConn() {
 	global.UserFavClient = proto.NewUserFavClient(userOpConn)
 	global.MessageClient = proto.NewMessageClient(userOpConn)
 	global.AddressClient = proto.NewAddressClient(userOpConn)
+	global.ReviewClient = proto.NewReviewClient(userOpConn)
 }
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"wshop-api/userop-web/api/review"
+	"wshop-api/userop-web/middlewares"
+)
+
+func InitReviewRouter(Router *gin.RouterGroup) {
+	ReviewRouter := Router.Group("reviews").Use(middlewares.JWTAuth())
+	{
+		ReviewRouter.GET("", review.List)                                        // 用户自己的评价， 管理员可以查看所有的评价
+		ReviewRouter.POST("", review.New)                                        // 评价购买过的商品
+		ReviewRouter.PATCH("/:id", middlewares.IsAdminAuth(), review.Audit)      // 审核评价
+		ReviewRouter.POST("/:id/reply", middlewares.IsAdminAuth(), review.Reply) // 商家回复
+	}
+}
@@ -12,6 +12,7 @@ require (
 	github.com/golang/protobuf v1.4.3
 	github.com/grpc-ecosystem/grpc-opentracing v0.0.0-20180507213350-8e809c8a8645
 	github.com/hashicorp/consul/api v1.3.0
+	github.com/mattn/go-sqlite3 v1.14.6 // indirect
 	github.com/mbobakov/grpc-consul-resolver v1.4.3
 	github.com/nacos-group/nacos-sdk-go v1.0.5
 	github.com/olivere/elastic/v7 v7.0.23
@@ -27,5 +28,6 @@ require (
 	google.golang.org/grpc v1.35.0
 	google.golang.org/protobuf v1.25.0
 	gorm.io/driver/mysql v1.0.3
+	gorm.io/driver/sqlite v1.1.4
 	gorm.io/gorm v1.20.11
 )
@@ -13,6 +13,7 @@ import (
 	"wshop_srvs/goods_srv/global"
 	"wshop_srvs/goods_srv/model"
 	"wshop_srvs/goods_srv/proto"
+	"wshop_srvs/goods_srv/utils/money"
 )
 
 type GoodsServer struct {
@@ -21,23 +22,28 @@ type GoodsServer struct {
 
 func ModelToResponse(goods model.Goods) proto.GoodsInfoResponse {
 	return proto.GoodsInfoResponse{
-		Id:              goods.ID,
-		CategoryId:      goods.CategoryID,
-		Name:            goods.Name,
-		GoodsSn:         goods.GoodsSn,
-		ClickNum:        goods.ClickNum,
-		SoldNum:         goods.SoldNum,
-		FavNum:          goods.FavNum,
-		MarketPrice:     goods.MarketPrice,
-		ShopPrice:       goods.ShopPrice,
-		GoodsBrief:      goods.GoodsBrief,
-		ShipFree:        goods.ShipFree,
-		GoodsFrontImage: goods.GoodsFrontImage,
-		IsNew:           goods.IsNew,
-		IsHot:           goods.IsHot,
-		OnSale:          goods.OnSale,
-		DescImages:      goods.DescImages,
-		Images:          goods.Images,
+		Id:               goods.ID,
+		CategoryId:       goods.CategoryID,
+		Name:             goods.Name,
+		GoodsSn:          goods.GoodsSn,
+		ClickNum:         goods.ClickNum,
+		SoldNum:          goods.SoldNum,
+		FavNum:           goods.FavNum,
+		Rating:           goods.Rating,
+		ReviewNum:        goods.ReviewNum,
+		Weight:           goods.Weight,
+		MarketPrice:      goods.MarketPrice.Yuan(),
+		ShopPrice:        goods.ShopPrice.Yuan(),
+		MarketPriceCents: goods.MarketPrice.Cents(),
+		ShopPriceCents:   goods.ShopPrice.Cents(),
+		GoodsBrief:       goods.GoodsBrief,
+		ShipFree:         goods.ShipFree,
+		GoodsFrontImage:  goods.GoodsFrontImage,
+		IsNew:            goods.IsNew,
+		IsHot:            goods.IsHot,
+		OnSale:           goods.OnSale,
+		DescImages:       goods.DescImages,
+		Images:           goods.Images,
 		Category: &proto.CategoryBriefInfoResponse{
 			Id:   goods.Category.ID,
 			Name: goods.Category.Name,
@@ -126,7 +132,17 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	case req.PagePerNums <= 0:
 		req.PagePerNums = 10
 	}
-	result, err := global.EsClient.Search().Index(model.EsGoods{}.GetIndexName()).Query(q).From(int(req.Pages)).Size(int(req.PagePerNums)).Do(context.Background())
+	search := global.EsClient.Search().Index(model.EsGoods{}.GetIndexName()).Query(q)
+	switch req.Sort {
+	case "":
+	case "rating":
+		search = search.Sort("rating", false).Sort("review_num", false)
+	case "review_num":
+		search = search.Sort("review_num", false)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的排序方式")
+	}
+	result, err := search.From(int(req.Pages)).Size(int(req.PagePerNums)).Do(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +162,16 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 		return nil, re.Error
 	}
 
+	// mysql按照id返回， 需要按照es的顺序排列
+	goodsMap := make(map[int32]model.Goods)
 	for _, good := range goods {
+		goodsMap[good.ID] = good
+	}
+	for _, id := range goodsIds {
+		good, ok := goodsMap[id]
+		if !ok {
+			continue
+		}
 		goodsInfoResponse := ModelToResponse(good)
 		goodsListResponse.Data = append(goodsListResponse.Data, &goodsInfoResponse)
 	}
@@ -160,7 +185,7 @@ func (s *GoodsServer) BatchGetGoods(ctx context.Context, req *proto.BatchGoodsId
 	var goods []model.Goods
 
 	// 调用where并不会真正执行sql 只是用来生成sql的 当调用find， first才会去执行sql，
-	result := global.DB.Where(req.Id).Find(&goods)
+	result := global.DB.Preload("Brands").Where(req.Id).Find(&goods)
 	for _, good := range goods {
 		goodsInfoResponse := ModelToResponse(good)
 		goodsListResponse.Data = append(goodsListResponse.Data, &goodsInfoResponse)
@@ -198,8 +223,8 @@ func (s *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoodsInf
 		CategoryID:      category.ID,
 		Name:            req.Name,
 		GoodsSn:         req.GoodsSn,
-		MarketPrice:     req.MarketPrice,
-		ShopPrice:       req.ShopPrice,
+		MarketPrice:     money.FromProto(req.MarketPriceCents, req.MarketPrice),
+		ShopPrice:       money.FromProto(req.ShopPriceCents, req.ShopPrice),
 		GoodsBrief:      req.GoodsBrief,
 		ShipFree:        req.ShipFree,
 		Images:          req.Images,
@@ -208,6 +233,7 @@ func (s *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoodsInf
 		IsNew:           req.IsNew,
 		IsHot:           req.IsHot,
 		OnSale:          req.OnSale,
+		Weight:          req.Weight,
 	}
 
 	// srv之间互相调用了
@@ -253,8 +279,8 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	goods.CategoryID = category.ID
 	goods.Name = req.Name
 	goods.GoodsSn = req.GoodsSn
-	goods.MarketPrice = req.MarketPrice
-	goods.ShopPrice = req.ShopPrice
+	goods.MarketPrice = money.FromProto(req.MarketPriceCents, req.MarketPrice)
+	goods.ShopPrice = money.FromProto(req.ShopPriceCents, req.ShopPrice)
 	goods.GoodsBrief = req.GoodsBrief
 	goods.ShipFree = req.ShipFree
 	goods.Images = req.Images
@@ -263,6 +289,7 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	goods.IsNew = req.IsNew
 	goods.IsHot = req.IsHot
 	goods.OnSale = req.OnSale
+	goods.Weight = req.Weight
 
 	tx := global.DB.Begin()
 	result := tx.Save(&goods)
@@ -273,3 +300,18 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	tx.Commit()
 	return &emptypb.Empty{}, nil
 }
+
+// UpdateGoodsRating 评价服务重新统计之后把评分和评价数写回商品， 保存的时候会同步到es
+func (s *GoodsServer) UpdateGoodsRating(ctx context.Context, req *proto.GoodsRatingRequest) (*emptypb.Empty, error) {
+	var goods model.Goods
+	if result := global.DB.First(&goods, req.GoodsId); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "商品不存在")
+	}
+
+	goods.Rating = req.Rating
+	goods.ReviewNum = req.ReviewNum
+	if result := global.DB.Save(&goods); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "更新商品评分失败")
+	}
+	return &emptypb.Empty{}, nil
+}
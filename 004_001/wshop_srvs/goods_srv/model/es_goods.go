@@ -13,7 +13,9 @@ type EsGoods struct {
 	ClickNum    int32   `json:"click_num"`
 	SoldNum     int32   `json:"sold_num"`
 	FavNum      int32   `json:"fav_num"`
-	MarketPrice float32 `json:"market_price"`
+	ReviewNum   int32   `json:"review_num"`
+	Rating      float32 `json:"rating"`
+	MarketPrice float32 `json:"market_price"` // es中的价格只用来做区间过滤和排序， 保留以元为单位的float
 	GoodsBrief  string  `json:"goods_brief"`
 	ShopPrice   float32 `json:"shop_price"`
 }
@@ -59,6 +61,12 @@ func (EsGoods) GetMapping() string {
 					"type" : "text",
 					"analyzer":"ik_max_word"
 				},
+				"rating" : {
+					"type" : "float"
+				},
+				"review_num" : {
+					"type" : "integer"
+				},
 				"on_sale" : {
 					"type" : "boolean"
 				},
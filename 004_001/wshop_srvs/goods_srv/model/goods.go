@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 
 	"wshop_srvs/goods_srv/global"
+	"wshop_srvs/goods_srv/utils/money"
 )
 
 // Category
@@ -63,17 +64,20 @@ type Goods struct {
 	IsNew    bool `gorm:"default:false;not null"`
 	IsHot    bool `gorm:"default:false;not null"`
 
-	Name            string   `gorm:"type:varchar(500);not null"`
-	GoodsSn         string   `gorm:"type:varchar(50);not null"`
-	ClickNum        int32    `gorm:"type:int;default:0;not null"`
-	SoldNum         int32    `gorm:"type:int;default:0;not null"`
-	FavNum          int32    `gorm:"type:int;default:0;not null"`
-	MarketPrice     float32  `gorm:"not null"`
-	ShopPrice       float32  `gorm:"not null"`
-	GoodsBrief      string   `gorm:"type:varchar(100);not null"`
-	Images          GormList `gorm:"type:varchar(1000);not null"`
-	DescImages      GormList `gorm:"type:varchar(5000);not null"`
-	GoodsFrontImage string   `gorm:"type:varchar(200);not null"`
+	Name            string      `gorm:"type:varchar(500);not null"`
+	GoodsSn         string      `gorm:"type:varchar(50);not null"`
+	ClickNum        int32       `gorm:"type:int;default:0;not null"`
+	SoldNum         int32       `gorm:"type:int;default:0;not null"`
+	FavNum          int32       `gorm:"type:int;default:0;not null"`
+	ReviewNum       int32       `gorm:"type:int;default:0;not null"`
+	Rating          float32     `gorm:"type:decimal(3,2);default:0;not null"` // 审核通过的评价的平均星级
+	MarketPrice     money.Money `gorm:"type:decimal(10,2);not null"`
+	ShopPrice       money.Money `gorm:"type:decimal(10,2);not null"`
+	GoodsBrief      string      `gorm:"type:varchar(100);not null"`
+	Images          GormList    `gorm:"type:varchar(1000);not null"`
+	DescImages      GormList    `gorm:"type:varchar(5000);not null"`
+	GoodsFrontImage string      `gorm:"type:varchar(200);not null"`
+	Weight          int32       `gorm:"type:int;default:0;not null"` // 单位为克， 按重量计算运费的时候使用
 }
 
 func (g *Goods) AfterCreate(tx *gorm.DB) (err error) {
@@ -89,9 +93,11 @@ func (g *Goods) AfterCreate(tx *gorm.DB) (err error) {
 		ClickNum:    g.ClickNum,
 		SoldNum:     g.SoldNum,
 		FavNum:      g.FavNum,
-		MarketPrice: g.MarketPrice,
+		Rating:      g.Rating,
+		ReviewNum:   g.ReviewNum,
+		MarketPrice: g.MarketPrice.Yuan(),
 		GoodsBrief:  g.GoodsBrief,
-		ShopPrice:   g.ShopPrice,
+		ShopPrice:   g.ShopPrice.Yuan(),
 	}
 
 	_, err = global.EsClient.Index().Index(esModel.GetIndexName()).BodyJson(esModel).Id(strconv.Itoa(int(g.ID))).Do(context.Background())
@@ -114,9 +120,11 @@ func (g *Goods) AfterUpdate(tx *gorm.DB) (err error) {
 		ClickNum:    g.ClickNum,
 		SoldNum:     g.SoldNum,
 		FavNum:      g.FavNum,
-		MarketPrice: g.MarketPrice,
+		Rating:      g.Rating,
+		ReviewNum:   g.ReviewNum,
+		MarketPrice: g.MarketPrice.Yuan(),
 		GoodsBrief:  g.GoodsBrief,
-		ShopPrice:   g.ShopPrice,
+		ShopPrice:   g.ShopPrice.Yuan(),
 	}
 
 	_, err = global.EsClient.Update().Index(esModel.GetIndexName()).Doc(esModel).Id(strconv.Itoa(int(g.ID))).Do(context.Background())
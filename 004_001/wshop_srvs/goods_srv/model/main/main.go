@@ -49,9 +49,73 @@ func main() {
 	//
 	// _ = db.AutoMigrate(&model.Category{},
 	// 	&model.Brands{}, &model.GoodsCategoryBrand{}, &model.Banner{}, &model.Goods{})
+	MigrateMoney()
+	MigrateRating()
+	MigrateWeight()
 	Mysql2Es()
 }
 
+// MigrateMoney 把商品价格从float改成DECIMAL(10,2)， mysql在转换的时候会四舍五入到分
+// 可以重复执行， 已经是DECIMAL的字段再执行一次不会有变化
+func MigrateMoney() {
+	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_goods_srv?charset=utf8mb4&parseTime=True&loc=Local"
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err = db.Exec("ALTER TABLE goods MODIFY market_price DECIMAL(10,2) NOT NULL, MODIFY shop_price DECIMAL(10,2) NOT NULL").Error; err != nil {
+		panic(err)
+	}
+}
+
+// MigrateRating 给商品加上评价数和评分字段， 需要在Mysql2Es之前执行
+func MigrateRating() {
+	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_goods_srv?charset=utf8mb4&parseTime=True&loc=Local"
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if !db.Migrator().HasColumn(&model.Goods{}, "ReviewNum") {
+		if err = db.Migrator().AddColumn(&model.Goods{}, "ReviewNum"); err != nil {
+			panic(err)
+		}
+	}
+	if !db.Migrator().HasColumn(&model.Goods{}, "Rating") {
+		if err = db.Migrator().AddColumn(&model.Goods{}, "Rating"); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// MigrateWeight 给商品加上重量字段， 按重量计算运费的时候使用
+func MigrateWeight() {
+	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_goods_srv?charset=utf8mb4&parseTime=True&loc=Local"
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if !db.Migrator().HasColumn(&model.Goods{}, "Weight") {
+		if err = db.Migrator().AddColumn(&model.Goods{}, "Weight"); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func Mysql2Es() {
 	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_goods_srv?charset=utf8mb4&parseTime=True&loc=Local"
 
@@ -98,9 +162,11 @@ func Mysql2Es() {
 			ClickNum:    g.ClickNum,
 			SoldNum:     g.SoldNum,
 			FavNum:      g.FavNum,
-			MarketPrice: g.MarketPrice,
+			Rating:      g.Rating,
+			ReviewNum:   g.ReviewNum,
+			MarketPrice: g.MarketPrice.Yuan(),
 			GoodsBrief:  g.GoodsBrief,
-			ShopPrice:   g.ShopPrice,
+			ShopPrice:   g.ShopPrice.Yuan(),
 		}
 
 		_, err = global.EsClient.Index().Index(esModel.GetIndexName()).BodyJson(esModel).Id(strconv.Itoa(int(g.ID))).Do(context.Background())
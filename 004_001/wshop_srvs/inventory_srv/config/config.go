@@ -13,12 +13,32 @@ type ConsulConfig struct {
 	Port int    `mapstructure:"port" json:"port"`
 }
 
+type OutboxConfig struct {
+	BatchSize   int    `mapstructure:"batch_size" json:"batch_size"`     // 每次发送的消息数量
+	Interval    int    `mapstructure:"interval" json:"interval"`         // 轮询的间隔， 单位毫秒
+	MetricsAddr string `mapstructure:"metrics_addr" json:"metrics_addr"` // 监控指标的地址， 配置之后可以通过/debug/vars查看outbox的积压
+}
+
+type MQConfig struct {
+	Adapter string `mapstructure:"adapter" json:"adapter"` // rocketmq(默认), memory(进程内的实现， 只能在单个进程中调试)
+	Host    string `mapstructure:"host" json:"host"`       // rocketmq name server的地址
+	Port    int    `mapstructure:"port" json:"port"`
+}
+
+type RoutingConfig struct {
+	Strategy   string `mapstructure:"strategy" json:"strategy"`       // nearest(默认， 优先同一个省份的仓库), priority(只按照仓库的优先级)
+	AllowSplit bool   `mapstructure:"allow_split" json:"allow_split"` // 一个仓库的库存不够的时候是否拆成多个仓库发货
+}
+
 type ServerConfig struct {
-	Name       string       `mapstructure:"name" json:"name"`
-	Host       string       `mapstructure:"host" json:"host"`
-	Tags       []string     `mapstructure:"tags" json:"tags"`
-	MysqlInfo  MysqlConfig  `mapstructure:"mysql" json:"mysql"`
-	ConsulInfo ConsulConfig `mapstructure:"consul" json:"consul"`
+	Name        string        `mapstructure:"name" json:"name"`
+	Host        string        `mapstructure:"host" json:"host"`
+	Tags        []string      `mapstructure:"tags" json:"tags"`
+	MysqlInfo   MysqlConfig   `mapstructure:"mysql" json:"mysql"`
+	ConsulInfo  ConsulConfig  `mapstructure:"consul" json:"consul"`
+	MQInfo      MQConfig      `mapstructure:"mq" json:"mq"`
+	OutboxInfo  OutboxConfig  `mapstructure:"outbox" json:"outbox"`
+	RoutingInfo RoutingConfig `mapstructure:"routing" json:"routing"`
 }
 
 type NacosConfig struct {
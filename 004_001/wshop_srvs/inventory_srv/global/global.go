@@ -3,12 +3,18 @@ package global
 import (
 	"gorm.io/gorm"
 	"wshop_srvs/inventory_srv/config"
+	"wshop_srvs/inventory_srv/mq"
+	"wshop_srvs/inventory_srv/outbox"
 )
 
 var (
 	DB           *gorm.DB
 	ServerConfig config.ServerConfig
 	NacosConfig  config.NacosConfig
+
+	MQBroker    mq.Broker
+	MQProducer  mq.DelayProducer
+	OutboxRelay *outbox.Relay
 )
 
 // func init() {
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/outbox"
+)
+
+// 测试使用sqlite， 和线上一样通过gorm访问， 不需要启动mysql
+// model中的类型带有mysql的列注释， sqlite不支持， 建表之前去掉
+
+var columnComment = regexp.MustCompile(`(?i)\s+comment\s+'[^']*'`)
+
+type sqliteConn struct {
+	*sql.DB
+}
+
+func (c sqliteConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.DB.ExecContext(ctx, columnComment.ReplaceAllString(query, ""), args...)
+}
+
+// setupDB 每个测试使用一个新的数据库， 替换global.DB
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	sqlDB, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "inventory.db")+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqliteConn{sqlDB}}, &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&model.OutboxMessage{}, &model.InventoryNew{}, &model.StockTccRecord{}, &model.StockSellDetail{}, &model.StockRebackRecord{},
+		&model.Warehouse{}, &model.WarehouseStock{}, &model.InventoryHistory{}); err != nil {
+		t.Fatal(err)
+	}
+
+	oldDB, oldRelay, oldConfig := global.DB, global.OutboxRelay, global.ServerConfig
+	t.Cleanup(func() {
+		global.DB, global.OutboxRelay, global.ServerConfig = oldDB, oldRelay, oldConfig
+	})
+	global.DB = db
+	// 测试中不启动relay， 只需要Notify不panic
+	global.OutboxRelay = outbox.NewRelay(outbox.GormStore{DB: db}, nil)
+	return db
+}
+
+// createWarehouse 新建仓库并且设置商品的库存， stocks是goods -> num
+func createWarehouse(t *testing.T, db *gorm.DB, warehouse model.Warehouse, stocks map[int32]int32) int32 {
+	t.Helper()
+	if err := db.Create(&warehouse).Error; err != nil {
+		t.Fatal(err)
+	}
+	for goods, num := range stocks {
+		if err := ensureStock(db, warehouse.ID, goods); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := changeStock(db, stockOp{Type: model.INV_SET, Source: "test"}, warehouse.ID, goods, num, 0); err != nil || !ok {
+			t.Fatalf("设置库存失败: %v", err)
+		}
+	}
+	return warehouse.ID
+}
+
+// stockOf 商品在仓库中的库存和冻结的库存
+func stockOf(t *testing.T, db *gorm.DB, warehouse, goods int32) (int32, int32) {
+	t.Helper()
+	var stock model.WarehouseStock
+	db.Where(&model.WarehouseStock{Warehouse: warehouse, Goods: goods}).Find(&stock)
+	return stock.Stocks, stock.Freeze
+}
+
+// totalOf inventory表中商品的合计
+func totalOf(t *testing.T, db *gorm.DB, goods int32) (int32, int32) {
+	t.Helper()
+	var inv model.InventoryNew
+	db.Where(&model.InventoryNew{Goods: goods}).Find(&inv)
+	return inv.Stocks, inv.Freeze
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/proto"
+)
+
+// stockOp 修改库存的原因， 记录在库存流水中
+type stockOp struct {
+	Type     string
+	OrderSn  string
+	Operator int32  // 管理员操作的时候记录管理员， 下单和归还都是0
+	Source   string // 修改库存的接口
+}
+
+// addHistory 在修改库存的事务中追加一条流水
+// 更新之后仓库的库存行已经被当前事务锁住， 查询到的就是修改之后的值， 减去变化量就是修改之前的值
+func addHistory(tx *gorm.DB, op stockOp, warehouse, goods, stocks, freeze int32) error {
+	var stock model.WarehouseStock
+	if result := tx.Where(&model.WarehouseStock{Warehouse: warehouse, Goods: goods}).First(&stock); result.Error != nil {
+		return result.Error
+	}
+	history := model.InventoryHistory{
+		Goods:        goods,
+		Warehouse:    warehouse,
+		Type:         op.Type,
+		StocksBefore: stock.Stocks - stocks,
+		StocksAfter:  stock.Stocks,
+		FreezeBefore: stock.Freeze - freeze,
+		FreezeAfter:  stock.Freeze,
+		OrderSn:      op.OrderSn,
+		Operator:     op.Operator,
+		Source:       op.Source,
+	}
+	return tx.Create(&history).Error
+}
+
+// InvHistory 查询库存流水， 所有的条件都是可选的， 最新的流水在前面
+func (*InventoryServer) InvHistory(ctx context.Context, req *proto.InvHistoryRequest) (*proto.InvHistoryResponse, error) {
+	localDB := global.DB.Model(&model.InventoryHistory{})
+	if req.GoodsId != 0 {
+		localDB = localDB.Where("goods = ?", req.GoodsId)
+	}
+	if req.WarehouseId != 0 {
+		localDB = localDB.Where("warehouse = ?", req.WarehouseId)
+	}
+	if req.OrderSn != "" {
+		localDB = localDB.Where("order_sn = ?", req.OrderSn)
+	}
+	if req.Type != "" {
+		localDB = localDB.Where("type = ?", req.Type)
+	}
+	if req.Operator != 0 {
+		localDB = localDB.Where("operator = ?", req.Operator)
+	}
+	if req.StartTime != "" {
+		start, err := time.ParseInLocation("2006-01-02 15:04:05", req.StartTime, time.Local)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "开始时间格式错误")
+		}
+		localDB = localDB.Where("add_time >= ?", start)
+	}
+	if req.EndTime != "" {
+		end, err := time.ParseInLocation("2006-01-02 15:04:05", req.EndTime, time.Local)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "结束时间格式错误")
+		}
+		localDB = localDB.Where("add_time < ?", end)
+	}
+
+	var rsp proto.InvHistoryResponse
+	var total int64
+	if result := localDB.Count(&total); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存流水失败")
+	}
+	rsp.Total = int32(total)
+
+	var histories []model.InventoryHistory
+	if result := localDB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Order("id desc").Find(&histories); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存流水失败")
+	}
+	for _, history := range histories {
+		rsp.Data = append(rsp.Data, &proto.InvHistoryInfo{
+			Id:           history.ID,
+			GoodsId:      history.Goods,
+			WarehouseId:  history.Warehouse,
+			Type:         history.Type,
+			StocksBefore: history.StocksBefore,
+			StocksAfter:  history.StocksAfter,
+			FreezeBefore: history.FreezeBefore,
+			FreezeAfter:  history.FreezeAfter,
+			OrderSn:      history.OrderSn,
+			Operator:     history.Operator,
+			Source:       history.Source,
+			AddTime:      history.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &rsp, nil
+}
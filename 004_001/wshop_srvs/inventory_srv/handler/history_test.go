@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/proto"
+)
+
+func TestInvHistory(t *testing.T) {
+	db := setupDB(t)
+	shanghai := createWarehouse(t, db, model.Warehouse{Name: "上海仓", Province: "上海市"}, nil)
+	guangzhou := createWarehouse(t, db, model.Warehouse{Name: "广州仓", Province: "广东省"}, nil)
+	s := &InventoryServer{}
+	ctx := context.Background()
+
+	steps := []func() error{
+		func() error {
+			_, err := s.SetInv(ctx, &proto.GoodsInvInfo{GoodsId: 1, Num: 10, WarehouseId: shanghai, Operator: 7})
+			return err
+		},
+		// 库存没有变化的时候不记录流水
+		func() error {
+			_, err := s.SetInv(ctx, &proto.GoodsInvInfo{GoodsId: 1, Num: 10, WarehouseId: shanghai, Operator: 7})
+			return err
+		},
+		func() error {
+			_, err := s.Sell(ctx, &proto.SellInfo{OrderSn: "o1", Province: "上海市", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 3}}})
+			return err
+		},
+		func() error {
+			_, err := s.Reback(ctx, &proto.SellInfo{OrderSn: "o1", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 1}}})
+			return err
+		},
+		func() error {
+			_, err := s.TransferStock(ctx, &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: shanghai, ToWarehouseId: guangzhou, Num: 4, Operator: 8})
+			return err
+		},
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	rsp, err := s.InvHistory(ctx, &proto.InvHistoryRequest{GoodsId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type entry struct {
+		Warehouse                                            int32
+		Type                                                 string
+		StocksBefore, StocksAfter, FreezeBefore, FreezeAfter int32
+		OrderSn                                              string
+		Operator                                             int32
+		Source                                               string
+	}
+	var got []entry
+	for _, h := range rsp.Data {
+		got = append(got, entry{h.WarehouseId, h.Type, h.StocksBefore, h.StocksAfter, h.FreezeBefore, h.FreezeAfter, h.OrderSn, h.Operator, h.Source})
+	}
+	// 最新的流水在前面
+	want := []entry{
+		{guangzhou, model.INV_TRANSFER, 0, 4, 0, 0, "", 8, "TransferStock"},
+		{shanghai, model.INV_TRANSFER, 8, 4, 0, 0, "", 8, "TransferStock"},
+		{shanghai, model.INV_REBACK, 7, 8, 0, 0, "o1", 0, "Reback"},
+		{shanghai, model.INV_SELL, 10, 7, 0, 0, "o1", 0, "Sell"},
+		{shanghai, model.INV_SET, 0, 10, 0, 0, "", 7, "SetInv"},
+	}
+	if rsp.Total != int32(len(want)) || !reflect.DeepEqual(got, want) {
+		t.Fatalf("流水 total=%d\n got %v\nwant %v", rsp.Total, got, want)
+	}
+
+	// 每个仓库中上一条流水的after就是下一条的before， 最后一条就是当前的库存
+	for _, warehouse := range []int32{shanghai, guangzhou} {
+		rsp, err := s.InvHistory(ctx, &proto.InvHistoryRequest{GoodsId: 1, WarehouseId: warehouse})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i+1 < len(rsp.Data); i++ {
+			if rsp.Data[i].StocksBefore != rsp.Data[i+1].StocksAfter {
+				t.Errorf("仓库%d的流水不连续: %v", warehouse, rsp.Data)
+			}
+		}
+		if stocks, _ := stockOf(t, db, warehouse, 1); rsp.Data[0].StocksAfter != stocks {
+			t.Errorf("仓库%d最后一条流水 = %d, 库存 = %d", warehouse, rsp.Data[0].StocksAfter, stocks)
+		}
+	}
+}
+
+func TestInvHistoryFilter(t *testing.T) {
+	db := setupDB(t)
+	warehouse := createWarehouse(t, db, model.Warehouse{Name: "上海仓"}, map[int32]int32{1: 5, 2: 5})
+	s := &InventoryServer{}
+	ctx := context.Background()
+	if _, err := s.TrySell(ctx, &proto.SellInfo{OrderSn: "o1", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 2}, {GoodsId: 2, Num: 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmSell(ctx, &proto.SellInfo{OrderSn: "o1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		req   *proto.InvHistoryRequest
+		total int32
+	}{
+		{"all", &proto.InvHistoryRequest{}, 6},
+		{"goods", &proto.InvHistoryRequest{GoodsId: 2}, 3},
+		{"order", &proto.InvHistoryRequest{OrderSn: "o1"}, 4},
+		{"type", &proto.InvHistoryRequest{Type: model.INV_FREEZE}, 2},
+		{"warehouse", &proto.InvHistoryRequest{WarehouseId: warehouse + 1}, 0},
+		{"page", &proto.InvHistoryRequest{Pages: 2, PagePerNums: 4}, 6},
+	}
+	for _, tt := range tests {
+		rsp, err := s.InvHistory(ctx, tt.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if rsp.Total != tt.total {
+			t.Errorf("%s: total = %d, want %d", tt.name, rsp.Total, tt.total)
+		}
+	}
+
+	// 确认的时候冻结的库存和库存一起减少
+	rsp, _ := s.InvHistory(ctx, &proto.InvHistoryRequest{GoodsId: 1, Type: model.INV_CONFIRM})
+	if len(rsp.Data) != 1 || rsp.Data[0].StocksBefore != 5 || rsp.Data[0].StocksAfter != 3 || rsp.Data[0].FreezeBefore != 2 || rsp.Data[0].FreezeAfter != 0 {
+		t.Errorf("确认的流水 = %v", rsp.Data)
+	}
+
+	if _, err := s.InvHistory(ctx, &proto.InvHistoryRequest{StartTime: "2021-01-01"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("时间格式错误 err = %v, want InvalidArgument", err)
+	}
+}
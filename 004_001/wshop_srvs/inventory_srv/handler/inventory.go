@@ -3,18 +3,14 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/consumer"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
-	goredislib "github.com/go-redis/redis/v8"
-	"github.com/go-redsync/redsync/v4"
-	"github.com/go-redsync/redsync/v4/redis/goredis/v8"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/mq"
+	"wshop_srvs/inventory_srv/outbox"
 
 	"wshop_srvs/inventory_srv/global"
 	"wshop_srvs/inventory_srv/proto"
@@ -24,14 +20,44 @@ type InventoryServer struct {
 	proto.UnimplementedInventoryServer
 }
 
+// SetInv 设置商品在仓库中的库存， 没有指定仓库的时候设置默认仓库
 func (*InventoryServer) SetInv(ctx context.Context, req *proto.GoodsInvInfo) (*emptypb.Empty, error) {
-	// 设置库存， 如果我要更新库存
-	var inv model.Inventory
-	global.DB.Where(&model.Inventory{Goods: req.GoodsId}).First(&inv)
-	inv.Goods = req.GoodsId
-	inv.Stocks = req.Num
+	if req.Num < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "库存不能小于0")
+	}
 
-	global.DB.Save(&inv)
+	tx := global.DB.Begin()
+	warehouse := req.WarehouseId
+	if warehouse == 0 {
+		var err error
+		if warehouse, err = defaultWarehouse(tx); err != nil {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "查询仓库失败")
+		}
+	} else if result := tx.First(&model.Warehouse{}, warehouse); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "仓库不存在")
+	}
+
+	if err := ensureStock(tx, warehouse, req.GoodsId); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "设置库存失败")
+	}
+	var stock model.WarehouseStock
+	tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.WarehouseStock{Warehouse: warehouse, Goods: req.GoodsId}).First(&stock)
+	if req.Num < stock.Freeze {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "库存不能少于冻结的库存")
+	}
+	// 只修改差值， inventory表中的合计跟着变化
+	if delta := req.Num - stock.Stocks; delta != 0 {
+		op := stockOp{Type: model.INV_SET, Operator: req.Operator, Source: "SetInv"}
+		if ok, err := changeStock(tx, op, warehouse, req.GoodsId, delta, 0); err != nil || !ok {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "设置库存失败")
+		}
+	}
+	tx.Commit()
 	return &emptypb.Empty{}, nil
 }
 
@@ -46,274 +72,148 @@ func (*InventoryServer) InvDetail(ctx context.Context, req *proto.GoodsInvInfo)
 	}, nil
 }
 
-func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	// 扣减库存， 本地事务 [1:10,  2:5, 3: 20]
-	// 数据库基本的一个应用场景：数据库事务
-	// 并发情况之下 可能会出现超卖 1
-	client := goredislib.NewClient(&goredislib.Options{
-		Addr: "192.168.0.249:6379",
-	})
-	pool := goredis.NewPool(client) // or, pool := redigo.NewPool(...)
-	rs := redsync.New(pool)
-
-	tx := global.DB.Begin()
-	// m.Lock() //获取锁 这把锁有问题吗？  假设有10w的并发， 这里并不是请求的同一件商品  这个锁就没有问题了吗？
+// BatchInvDetail 批量查询可用库存， TCC冻结的库存不能再卖出
+func (*InventoryServer) BatchInvDetail(ctx context.Context, req *proto.BatchInvRequest) (*proto.BatchInvResponse, error) {
+	var rsp proto.BatchInvResponse
+	if len(req.GoodsIds) == 0 {
+		return &rsp, nil
+	}
 
-	// 这个时候应该先查询表，然后确定这个订单是否已经扣减过库存了，已经扣减过了就别扣减了
-	// 并发时候会有漏洞， 同一个时刻发送了重复了多次， 使用锁，分布式锁
-	sellDetail := model.StockSellDetail{
-		OrderSn: req.OrderSn,
-		Status:  1,
+	var invs []model.InventoryNew
+	if result := global.DB.Where("goods in ?", req.GoodsIds).Find(&invs); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存信息失败")
 	}
-	var details []model.GoodsDetail
-	for _, goodInfo := range req.GoodsInfo {
-		details = append(details, model.GoodsDetail{
-			Goods: goodInfo.GoodsId,
-			Num:   goodInfo.Num,
+	for _, inv := range invs {
+		rsp.Data = append(rsp.Data, &proto.GoodsInvInfo{
+			GoodsId: inv.Goods,
+			Num:     inv.Stocks - inv.Freeze,
 		})
+	}
+	return &rsp, nil
+}
 
-		var inv model.Inventory
-		// if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Inventory{Goods:goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-		//	tx.Rollback() //回滚之前的操作
-		//	return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		// }
-
-		// for {
-		mutex := rs.NewMutex(fmt.Sprintf("goods_%d", goodInfo.GoodsId))
-		if err := mutex.Lock(); err != nil {
-			return nil, status.Errorf(codes.Internal, "获取redis分布式锁异常")
-		}
+func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
+	// 扣减库存， 本地事务 [1:10,  2:5, 3: 20]
+	// 商品在每个仓库中的库存加上行锁， 同一个商品的并发扣减串行执行， 不会超卖
+	// 根据收货地址的省份选择发货的仓库， 扣减明细中记录每个仓库扣减的数量， 归还的时候还回原来的仓库
+	tx := global.DB.Begin()
+	allocations, err := allocate(tx, req.GoodsInfo, req.Province)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		}
-		// 判断库存是否充足
-		if inv.Stocks < goodInfo.Num {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+	op := stockOp{Type: model.INV_SELL, OrderSn: req.OrderSn, Source: "Sell"}
+	var details model.GoodsDetailList
+	for _, allocation := range allocations {
+		if ok, err := changeStock(tx, op, allocation.Warehouse, allocation.Goods, -allocation.Num, 0); err != nil || !ok {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "扣减库存失败")
 		}
-		// 扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Stocks -= goodInfo.Num
-		tx.Save(&inv)
+		details = append(details, model.GoodsDetail{Goods: allocation.Goods, Num: allocation.Num, Warehouse: allocation.Warehouse})
+	}
 
-		if ok, err := mutex.Unlock(); !ok || err != nil {
-			return nil, status.Errorf(codes.Internal, "释放redis分布式锁异常")
-		}
-		// update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
-		// 这种写法有瑕疵，为什么？
-		// 零值 对于int类型来说 默认值是0 这种会被gorm给忽略掉
-		// if result := tx.Model(&model.Inventory{}).Select("Stocks", "Version").Where("goods = ? and version= ?", goodInfo.GoodsId, inv.Version).Updates(model.Inventory{Stocks: inv.Stocks, Version: inv.Version+1}); result.RowsAffected == 0 {
-		//	zap.S().Info("库存扣减失败")
-		// }else{
-		//	break
-		// }
-		// }
-		// tx.Save(&inv)
+	// 写sell detail表， 订单号的唯一索引保证同一个订单只会扣减一次
+	sellDetail := model.StockSellDetail{
+		OrderSn: req.OrderSn,
+		Status:  1,
+		Detail:  details,
 	}
-	sellDetail.Detail = details
-	// 写sell detail表
 	if result := tx.Create(&sellDetail); result.RowsAffected == 0 {
 		tx.Rollback()
 		return nil, status.Errorf(codes.Internal, "保存库存扣减历史失败")
 	}
 	tx.Commit() // 需要自己手动提交操作
-	// m.Unlock() //释放锁
 	return &emptypb.Empty{}, nil
 }
 
 func (*InventoryServer) Reback(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	// 库存归还： 1：订单超时归还 2. 订单创建失败，归还之前扣减的库存 3. 手动归还
-	tx := global.DB.Begin()
+	// 库存归还： 1：订单超时归还 2. 订单创建失败，归还之前扣减的库存 3. 手动归还(售后退款)
+	// 以rebackSn保证幂等， 归还记录和归还在同一个事务中写入， 重试的请求不会重复归还
+	// 有订单号的时候按照订单的扣减明细归还到发货的仓库， 并且从扣减明细中去掉归还的数量， 之后的AutoReback只归还剩下的部分
+	// 没有订单号的时候归还到指定的仓库， 没有指定仓库的时候归还到默认仓库
+	rebackSn := req.RebackSn
+	if rebackSn == "" {
+		rebackSn = req.OrderSn
+	}
+	if rebackSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少归还单号")
+	}
 	for _, goodInfo := range req.GoodsInfo {
-		var inv model.Inventory
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		if goodInfo.Num <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "归还数量不合法")
 		}
-
-		// 扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Stocks += goodInfo.Num
-		tx.Save(&inv)
 	}
-	tx.Commit() // 需要自己手动提交操作
-	return &emptypb.Empty{}, nil
-}
-
-func (*InventoryServer) TrySell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	// 扣减库存， 本地事务 [1:10,  2:5, 3: 20]
-	// 数据库基本的一个应用场景：数据库事务
-	// 并发情况之下 可能会出现超卖 1
-	client := goredislib.NewClient(&goredislib.Options{
-		Addr: "192.168.0.249:6379",
-	})
-	pool := goredis.NewPool(client) // or, pool := redigo.NewPool(...)
-	rs := redsync.New(pool)
 
 	tx := global.DB.Begin()
-	// m.Lock() //获取锁 这把锁有问题吗？  假设有10w的并发， 这里并不是请求的同一件商品  这个锁就没有问题了吗？
-	for _, goodInfo := range req.GoodsInfo {
-		var inv model.InventoryNew
-		// if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Inventory{Goods:goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-		//	tx.Rollback() //回滚之前的操作
-		//	return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		// }
+	if result := tx.Where(&model.StockRebackRecord{RebackSn: rebackSn}).Find(&model.StockRebackRecord{}); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "查询库存归还记录失败")
+	} else if result.RowsAffected > 0 {
+		// 已经归还过了
+		tx.Rollback()
+		return &emptypb.Empty{}, nil
+	}
 
-		// for {
-		mutex := rs.NewMutex(fmt.Sprintf("goods_%d", goodInfo.GoodsId))
-		if err := mutex.Lock(); err != nil {
-			return nil, status.Errorf(codes.Internal, "获取redis分布式锁异常")
+	var details model.GoodsDetailList
+	if req.OrderSn != "" {
+		// 订单没有扣减过库存的时候不能归还， 否则会凭空多出库存
+		var sellDetail model.StockSellDetail
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.StockSellDetail{OrderSn: req.OrderSn}).First(&sellDetail); result.RowsAffected == 0 {
+			tx.Rollback()
+			return nil, status.Errorf(codes.NotFound, "订单没有扣减库存的记录")
 		}
-
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		if sellDetail.Status != 1 {
+			tx.Rollback()
+			return nil, status.Errorf(codes.FailedPrecondition, "订单的库存已经全部归还")
 		}
-		// 判断库存是否充足
-		if inv.Stocks < goodInfo.Num {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+		remain := sellDetail.Detail
+		for _, goodInfo := range req.GoodsInfo {
+			var taken model.GoodsDetailList
+			var ok bool
+			if taken, remain, ok = takeSold(remain, goodInfo.GoodsId, goodInfo.Num); !ok {
+				tx.Rollback()
+				return nil, status.Errorf(codes.FailedPrecondition, "商品%d归还的数量超出订单扣减的数量", goodInfo.GoodsId)
+			}
+			details = append(details, taken...)
 		}
-		// 扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		// inv.Stocks -= goodInfo.Num
-		inv.Freeze += goodInfo.Num
-		tx.Save(&inv)
-
-		if ok, err := mutex.Unlock(); !ok || err != nil {
-			return nil, status.Errorf(codes.Internal, "释放redis分布式锁异常")
+		// 全部归还之后和AutoReback一样把扣减明细标记为已归还
+		updates := map[string]interface{}{"detail": remain}
+		if len(remain) == 0 {
+			updates["status"] = 2
 		}
-		// update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
-		// 这种写法有瑕疵，为什么？
-		// 零值 对于int类型来说 默认值是0 这种会被gorm给忽略掉
-		// if result := tx.Model(&model.Inventory{}).Select("Stocks", "Version").Where("goods = ? and version= ?", goodInfo.GoodsId, inv.Version).Updates(model.Inventory{Stocks: inv.Stocks, Version: inv.Version+1}); result.RowsAffected == 0 {
-		//	zap.S().Info("库存扣减失败")
-		// }else{
-		//	break
-		// }
-		// }
-		// tx.Save(&inv)
-	}
-	tx.Commit() // 需要自己手动提交操作
-	// m.Unlock() //释放锁
-	return &emptypb.Empty{}, nil
-}
-
-func (*InventoryServer) ConfirmSell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	// 扣减库存， 本地事务 [1:10,  2:5, 3: 20]
-	// 数据库基本的一个应用场景：数据库事务
-	// 并发情况之下 可能会出现超卖 1
-	client := goredislib.NewClient(&goredislib.Options{
-		Addr: "192.168.0.249:6379",
-	})
-	pool := goredis.NewPool(client) // or, pool := redigo.NewPool(...)
-	rs := redsync.New(pool)
-
-	tx := global.DB.Begin()
-	// m.Lock() //获取锁 这把锁有问题吗？  假设有10w的并发， 这里并不是请求的同一件商品  这个锁就没有问题了吗？
-	for _, goodInfo := range req.GoodsInfo {
-		var inv model.InventoryNew
-		// if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Inventory{Goods:goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-		//	tx.Rollback() //回滚之前的操作
-		//	return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		// }
-
-		// for {
-		mutex := rs.NewMutex(fmt.Sprintf("goods_%d", goodInfo.GoodsId))
-		if err := mutex.Lock(); err != nil {
-			return nil, status.Errorf(codes.Internal, "获取redis分布式锁异常")
-		}
-
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		if result := tx.Model(&model.StockSellDetail{}).Where(&model.StockSellDetail{OrderSn: req.OrderSn}).Updates(updates); result.Error != nil {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "保存库存扣减明细失败")
 		}
-		// 判断库存是否充足
-		if inv.Stocks < goodInfo.Num {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+	} else {
+		for _, goodInfo := range req.GoodsInfo {
+			details = append(details, model.GoodsDetail{Goods: goodInfo.GoodsId, Num: goodInfo.Num, Warehouse: goodInfo.WarehouseId})
 		}
-		// 扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Stocks -= goodInfo.Num
-		inv.Freeze -= goodInfo.Num
-		tx.Save(&inv)
+	}
 
-		if ok, err := mutex.Unlock(); !ok || err != nil {
-			return nil, status.Errorf(codes.Internal, "释放redis分布式锁异常")
-		}
-		// update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
-		// 这种写法有瑕疵，为什么？
-		// 零值 对于int类型来说 默认值是0 这种会被gorm给忽略掉
-		// if result := tx.Model(&model.Inventory{}).Select("Stocks", "Version").Where("goods = ? and version= ?", goodInfo.GoodsId, inv.Version).Updates(model.Inventory{Stocks: inv.Stocks, Version: inv.Version+1}); result.RowsAffected == 0 {
-		//	zap.S().Info("库存扣减失败")
-		// }else{
-		//	break
-		// }
-		// }
-		// tx.Save(&inv)
+	if err := rebackStock(tx, stockOp{Type: model.INV_REBACK, OrderSn: req.OrderSn, Source: "Reback"}, details); err != nil {
+		tx.Rollback()
+		zap.S().Errorf("归还库存失败: %s", err.Error())
+		return nil, status.Errorf(codes.Internal, "归还库存失败")
+	}
+	// 唯一索引保证并发的重复请求只有一个会成功
+	record := model.StockRebackRecord{RebackSn: rebackSn, OrderSn: req.OrderSn, Detail: details}
+	if result := tx.Create(&record); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Aborted, "库存归还正在处理中")
 	}
 	tx.Commit() // 需要自己手动提交操作
-	// m.Unlock() //释放锁
 	return &emptypb.Empty{}, nil
 }
 
-func (*InventoryServer) CancelSell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	// 扣减库存， 本地事务 [1:10,  2:5, 3: 20]
-	// 数据库基本的一个应用场景：数据库事务
-	// 并发情况之下 可能会出现超卖 1
-	client := goredislib.NewClient(&goredislib.Options{
-		Addr: "192.168.0.249:6379",
-	})
-	pool := goredis.NewPool(client) // or, pool := redigo.NewPool(...)
-	rs := redsync.New(pool)
-
-	tx := global.DB.Begin()
-	// m.Lock() //获取锁 这把锁有问题吗？  假设有10w的并发， 这里并不是请求的同一件商品  这个锁就没有问题了吗？
-	for _, goodInfo := range req.GoodsInfo {
-		var inv model.InventoryNew
-		// if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Inventory{Goods:goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-		//	tx.Rollback() //回滚之前的操作
-		//	return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		// }
-
-		// for {
-		mutex := rs.NewMutex(fmt.Sprintf("goods_%d", goodInfo.GoodsId))
-		if err := mutex.Lock(); err != nil {
-			return nil, status.Errorf(codes.Internal, "获取redis分布式锁异常")
-		}
-
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-		}
-		// 判断库存是否充足
-		if inv.Stocks < goodInfo.Num {
-			tx.Rollback() // 回滚之前的操作
-			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
-		}
-		// 扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Freeze -= goodInfo.Num
-		tx.Save(&inv)
-
-		if ok, err := mutex.Unlock(); !ok || err != nil {
-			return nil, status.Errorf(codes.Internal, "释放redis分布式锁异常")
-		}
-		// update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
-		// 这种写法有瑕疵，为什么？
-		// 零值 对于int类型来说 默认值是0 这种会被gorm给忽略掉
-		// if result := tx.Model(&model.Inventory{}).Select("Stocks", "Version").Where("goods = ? and version= ?", goodInfo.GoodsId, inv.Version).Updates(model.Inventory{Stocks: inv.Stocks, Version: inv.Version+1}); result.RowsAffected == 0 {
-		//	zap.S().Info("库存扣减失败")
-		// }else{
-		//	break
-		// }
-		// }
-		// tx.Save(&inv)
-	}
-	tx.Commit() // 需要自己手动提交操作
-	// m.Unlock() //释放锁
-	return &emptypb.Empty{}, nil
+// StockRebacked 库存归还之后发送的stock_rebacked事件
+type StockRebacked struct {
+	OrderSn string
+	Detail  model.GoodsDetailList
 }
 
-func AutoReback(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+func AutoReback(ctx context.Context, msgs ...*mq.Message) (mq.ConsumeResult, error) {
 	type OrderInfo struct {
 		OrderSn string
 	}
@@ -325,31 +225,40 @@ func AutoReback(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.Co
 		err := json.Unmarshal(msgs[i].Body, &orderInfo)
 		if err != nil {
 			zap.S().Errorf("解析json失败： %v\n", msgs[i].Body)
-			return consumer.ConsumeSuccess, nil
+			return mq.ConsumeSuccess, nil
 		}
 
 		// 去将inv的库存加回去 将selldetail的status设置为2， 要在事务中进行
 		tx := global.DB.Begin()
 		var sellDetail model.StockSellDetail
-		if result := tx.Model(&model.StockSellDetail{}).Where(&model.StockSellDetail{OrderSn: orderInfo.OrderSn, Status: 1}).First(&sellDetail); result.RowsAffected == 0 {
-			return consumer.ConsumeSuccess, nil
+		// 加上行锁， 和售后的Reback串行执行， 只归还还没有被Reback归还的部分
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.StockSellDetail{OrderSn: orderInfo.OrderSn, Status: 1}).First(&sellDetail); result.RowsAffected == 0 {
+			tx.Rollback()
+			return mq.ConsumeSuccess, nil
 		}
-		// 如果查询到那么逐个归还库存
-		for _, orderGood := range sellDetail.Detail {
-			// update怎么用
-			// 先查询一下inventory表在， update语句的 update xx set stocks=stocks+2
-			if result := tx.Model(&model.Inventory{}).Where(&model.Inventory{Goods: orderGood.Goods}).Update("stocks", gorm.Expr("stocks+?", orderGood.Num)); result.RowsAffected == 0 {
-				tx.Rollback()
-				return consumer.ConsumeRetryLater, nil
-			}
+		// 如果查询到那么逐个归还到扣减的仓库
+		op := stockOp{Type: model.INV_REBACK, OrderSn: sellDetail.OrderSn, Source: "AutoReback"}
+		if err := rebackStock(tx, op, sellDetail.Detail); err != nil {
+			tx.Rollback()
+			zap.S().Errorf("订单%s归还库存失败: %s", orderInfo.OrderSn, err.Error())
+			return mq.ConsumeRetryLater, nil
 		}
 
 		if result := tx.Model(&model.StockSellDetail{}).Where(&model.StockSellDetail{OrderSn: orderInfo.OrderSn}).Update("status", 2); result.RowsAffected == 0 {
 			tx.Rollback()
-			return consumer.ConsumeRetryLater, nil
+			return mq.ConsumeRetryLater, nil
+		}
+
+		// 库存归还的事件和归还在同一个事务中写入， 提交之后由relay发送给关心库存变化的服务
+		body, _ := json.Marshal(StockRebacked{OrderSn: sellDetail.OrderSn, Detail: sellDetail.Detail})
+		if err := outbox.Add(tx, "stock_rebacked", body, 0); err != nil {
+			tx.Rollback()
+			zap.S().Errorf("保存库存归还事件失败: %s", err.Error())
+			return mq.ConsumeRetryLater, nil
 		}
 		tx.Commit()
-		return consumer.ConsumeSuccess, nil
+		global.OutboxRelay.Notify()
+		return mq.ConsumeSuccess, nil
 	}
-	return consumer.ConsumeSuccess, nil
+	return mq.ConsumeSuccess, nil
 }
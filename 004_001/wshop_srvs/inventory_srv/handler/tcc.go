@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/proto"
+)
+
+// TCC模式的库存扣减
+// try冻结库存， confirm把冻结的库存扣掉， cancel释放冻结的库存， 三个接口都以订单号保证幂等
+// 每个订单在stocktccrecord表中有一条记录， 查询的时候加上行锁， 同一个订单的三个接口串行执行
+
+// lockTccRecord 锁住订单的分支事务记录， 不存在的时候返回false
+func lockTccRecord(tx *gorm.DB, orderSn string) (model.StockTccRecord, bool) {
+	var record model.StockTccRecord
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.StockTccRecord{OrderSn: orderSn}).First(&record)
+	return record, result.RowsAffected > 0
+}
+
+func (*InventoryServer) TrySell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+
+	tx := global.DB.Begin()
+	if record, ok := lockTccRecord(tx, req.OrderSn); ok {
+		tx.Rollback()
+		// cancel已经先到达了(空回滚)， 这个时候不能再冻结库存， 否则冻结的库存永远不会被释放
+		if record.Status == model.TCC_CANCELED {
+			return nil, status.Errorf(codes.Aborted, "订单的库存事务已经取消")
+		}
+		// 重复的try
+		return &emptypb.Empty{}, nil
+	}
+
+	// 和Sell一样选择发货的仓库， 冻结每个仓库中的库存， 可以售卖的库存是stocks-freeze
+	allocations, err := allocate(tx, req.GoodsInfo, req.Province)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	op := stockOp{Type: model.INV_FREEZE, OrderSn: req.OrderSn, Source: "TrySell"}
+	var details model.GoodsDetailList
+	for _, allocation := range allocations {
+		if ok, err := changeStock(tx, op, allocation.Warehouse, allocation.Goods, 0, allocation.Num); err != nil || !ok {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "冻结库存失败")
+		}
+		details = append(details, model.GoodsDetail{Goods: allocation.Goods, Num: allocation.Num, Warehouse: allocation.Warehouse})
+	}
+
+	// 唯一索引保证同一个订单只会冻结一次， 和并发的try或者cancel冲突的时候让调用方重试
+	record := model.StockTccRecord{OrderSn: req.OrderSn, Status: model.TCC_TRIED, Detail: details}
+	if result := tx.Create(&record); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Aborted, "订单的库存事务正在处理中")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
+
+func (*InventoryServer) ConfirmSell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+
+	tx := global.DB.Begin()
+	record, ok := lockTccRecord(tx, req.OrderSn)
+	if !ok {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单没有冻结库存")
+	}
+	switch record.Status {
+	case model.TCC_CONFIRMED:
+		tx.Rollback()
+		return &emptypb.Empty{}, nil
+	case model.TCC_CANCELED:
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单的库存事务已经取消")
+	}
+
+	// 按照try时每个仓库冻结的数量扣减， 不使用请求中的商品
+	op := stockOp{Type: model.INV_CONFIRM, OrderSn: req.OrderSn, Source: "ConfirmSell"}
+	for _, detail := range record.Detail {
+		if ok, err := changeStock(tx, op, detail.Warehouse, detail.Goods, -detail.Num, -detail.Num); err != nil || !ok {
+			tx.Rollback()
+			zap.S().Errorf("订单%s扣减冻结库存失败， 商品: %d", req.OrderSn, detail.Goods)
+			return nil, status.Errorf(codes.Internal, "扣减冻结库存失败")
+		}
+	}
+
+	if result := tx.Model(&model.StockTccRecord{}).Where("id = ?", record.ID).Update("status", model.TCC_CONFIRMED); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存库存事务状态失败")
+	}
+	// 和Sell一样记录扣减明细， 订单超时或者取消的时候AutoReback根据这条记录归还库存
+	sellDetail := model.StockSellDetail{OrderSn: req.OrderSn, Status: 1, Detail: record.Detail}
+	if result := tx.Create(&sellDetail); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存库存扣减历史失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
+
+func (*InventoryServer) CancelSell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+
+	tx := global.DB.Begin()
+	record, ok := lockTccRecord(tx, req.OrderSn)
+	if !ok {
+		// 空回滚: try没有执行或者还没有到达， 留下一条已取消的记录防止之后的try冻结库存
+		record = model.StockTccRecord{OrderSn: req.OrderSn, Status: model.TCC_CANCELED}
+		if result := tx.Create(&record); result.Error != nil {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Aborted, "订单的库存事务正在处理中")
+		}
+		tx.Commit()
+		return &emptypb.Empty{}, nil
+	}
+	switch record.Status {
+	case model.TCC_CANCELED:
+		tx.Rollback()
+		return &emptypb.Empty{}, nil
+	case model.TCC_CONFIRMED:
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单的库存事务已经确认")
+	}
+
+	op := stockOp{Type: model.INV_CANCEL, OrderSn: req.OrderSn, Source: "CancelSell"}
+	for _, detail := range record.Detail {
+		if ok, err := changeStock(tx, op, detail.Warehouse, detail.Goods, 0, -detail.Num); err != nil || !ok {
+			tx.Rollback()
+			zap.S().Errorf("订单%s释放冻结库存失败， 商品: %d", req.OrderSn, detail.Goods)
+			return nil, status.Errorf(codes.Internal, "释放冻结库存失败")
+		}
+	}
+
+	if result := tx.Model(&model.StockTccRecord{}).Where("id = ?", record.ID).Update("status", model.TCC_CANCELED); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存库存事务状态失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/proto"
+	"wshop_srvs/inventory_srv/routing"
+)
+
+// 多仓库的库存
+// 每个仓库的库存保存在warehousestock表中， inventory表中是所有仓库的合计， 查询可以售卖的库存的时候不需要关心仓库
+// 修改库存都通过changeStock， 在同一个事务中修改仓库的库存和合计， 并且追加一条库存流水
+
+// defaultWarehouse 默认仓库是id最小的仓库， 设置库存时没有指定仓库以及之前没有记录仓库的扣减明细都使用默认仓库
+// 还没有仓库的时候创建一个
+func defaultWarehouse(tx *gorm.DB) (int32, error) {
+	var warehouse model.Warehouse
+	if result := tx.Order("id").Limit(1).Find(&warehouse); result.Error != nil {
+		return 0, result.Error
+	}
+	if warehouse.ID != 0 {
+		return warehouse.ID, nil
+	}
+	warehouse = model.Warehouse{Name: "默认仓库"}
+	if result := tx.Create(&warehouse); result.Error != nil {
+		return 0, result.Error
+	}
+	return warehouse.ID, nil
+}
+
+// ensureStock 商品在仓库中还没有库存记录的时候创建一条， inventory表中没有合计的时候也创建
+func ensureStock(tx *gorm.DB, warehouse, goods int32) error {
+	if result := tx.Where(&model.WarehouseStock{Warehouse: warehouse, Goods: goods}).FirstOrCreate(&model.WarehouseStock{}); result.Error != nil {
+		return result.Error
+	}
+	if result := tx.Where(&model.InventoryNew{Goods: goods}).FirstOrCreate(&model.InventoryNew{}); result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// changeStock 修改商品在仓库中的库存和冻结的库存， 同时修改inventory表中的合计， warehouse为0的时候使用默认仓库
+// 条件更新保证可以售卖的库存(stocks-freeze)和冻结的库存不会小于0， 不满足条件的时候返回false
+func changeStock(tx *gorm.DB, op stockOp, warehouse, goods, stocks, freeze int32) (bool, error) {
+	if warehouse == 0 {
+		var err error
+		if warehouse, err = defaultWarehouse(tx); err != nil {
+			return false, err
+		}
+	}
+	updates := map[string]interface{}{
+		"stocks": gorm.Expr("stocks + ?", stocks),
+		"freeze": gorm.Expr("freeze + ?", freeze),
+	}
+	result := tx.Model(&model.WarehouseStock{}).
+		Where("warehouse = ? and goods = ? and stocks + ? >= freeze + ? and freeze + ? >= 0", warehouse, goods, stocks, freeze, freeze).
+		Updates(updates)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	if result := tx.Model(&model.InventoryNew{}).Where(&model.InventoryNew{Goods: goods}).Updates(updates); result.Error != nil {
+		return false, result.Error
+	}
+	if err := addHistory(tx, op, warehouse, goods, stocks, freeze); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// allocate 锁住商品在每个仓库中的库存， 按照配置的策略选择发货的仓库
+// 返回的错误是grpc的status， 调用方回滚事务之后直接返回
+func allocate(tx *gorm.DB, goodsInfo []*proto.GoodsInvInfo, province string) ([]routing.Allocation, error) {
+	var items []routing.Item
+	var goodsIds []int32
+	for _, goodInfo := range goodsInfo {
+		items = append(items, routing.Item{Goods: goodInfo.GoodsId, Num: goodInfo.Num})
+		goodsIds = append(goodsIds, goodInfo.GoodsId)
+	}
+
+	var warehouses []model.Warehouse
+	if result := tx.Where("disabled = ?", false).Find(&warehouses); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询仓库失败")
+	}
+	// 按照固定的顺序加锁， 避免并发扣减的时候死锁
+	var rows []model.WarehouseStock
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("goods in ?", goodsIds).Order("goods, warehouse").Find(&rows); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存信息失败")
+	}
+
+	stocks := make(routing.Stocks)
+	found := make(map[int32]bool)
+	for _, row := range rows {
+		found[row.Goods] = true
+		if stocks[row.Warehouse] == nil {
+			stocks[row.Warehouse] = make(map[int32]int32)
+		}
+		stocks[row.Warehouse][row.Goods] = row.Stocks - row.Freeze
+	}
+	for _, goodsId := range goodsIds {
+		if !found[goodsId] {
+			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		}
+	}
+
+	candidates := make([]routing.Warehouse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		candidates = append(candidates, routing.Warehouse{ID: warehouse.ID, Province: warehouse.Province, Priority: warehouse.Priority})
+	}
+	router := routing.Router{
+		Strategy:   global.ServerConfig.RoutingInfo.Strategy,
+		AllowSplit: global.ServerConfig.RoutingInfo.AllowSplit,
+	}
+	allocations, err := router.Route(province, items, candidates, stocks)
+	if err != nil {
+		return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+	}
+	return allocations, nil
+}
+
+// takeSold 从订单剩下的扣减明细中取出归还的数量， 返回每个仓库归还的数量和剩下的扣减明细
+// 归还的数量超出剩下的扣减数量的时候返回false
+func takeSold(sold model.GoodsDetailList, goods, num int32) (taken, remain model.GoodsDetailList, ok bool) {
+	remain = make(model.GoodsDetailList, 0, len(sold))
+	for _, detail := range sold {
+		if detail.Goods == goods && num > 0 {
+			n := detail.Num
+			if n > num {
+				n = num
+			}
+			taken = append(taken, model.GoodsDetail{Goods: goods, Num: n, Warehouse: detail.Warehouse})
+			detail.Num -= n
+			num -= n
+		}
+		if detail.Num > 0 {
+			remain = append(remain, detail)
+		}
+	}
+	return taken, remain, num == 0
+}
+
+// rebackStock 把库存归还到明细中记录的仓库， 没有记录仓库或者仓库已经删除的时候归还到默认仓库
+func rebackStock(tx *gorm.DB, op stockOp, details model.GoodsDetailList) error {
+	for _, detail := range details {
+		warehouse := detail.Warehouse
+		if warehouse != 0 {
+			if result := tx.Find(&model.Warehouse{}, warehouse); result.Error != nil {
+				return result.Error
+			} else if result.RowsAffected == 0 {
+				warehouse = 0
+			}
+		}
+		if warehouse == 0 {
+			var err error
+			if warehouse, err = defaultWarehouse(tx); err != nil {
+				return err
+			}
+		}
+		if err := ensureStock(tx, warehouse, detail.Goods); err != nil {
+			return err
+		}
+		if ok, err := changeStock(tx, op, warehouse, detail.Goods, detail.Num, 0); err != nil {
+			return err
+		} else if !ok {
+			return status.Errorf(codes.Internal, "归还库存失败")
+		}
+	}
+	return nil
+}
+
+func warehouseInfo(warehouse *model.Warehouse) *proto.WarehouseInfo {
+	return &proto.WarehouseInfo{
+		Id:       warehouse.ID,
+		Name:     warehouse.Name,
+		Province: warehouse.Province,
+		City:     warehouse.City,
+		Address:  warehouse.Address,
+		Priority: warehouse.Priority,
+		Disabled: warehouse.Disabled,
+	}
+}
+
+func (*InventoryServer) WarehouseList(ctx context.Context, req *emptypb.Empty) (*proto.WarehouseListResponse, error) {
+	var warehouses []model.Warehouse
+	if result := global.DB.Order("id").Find(&warehouses); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询仓库失败")
+	}
+	rsp := proto.WarehouseListResponse{Total: int32(len(warehouses))}
+	for i := range warehouses {
+		rsp.Data = append(rsp.Data, warehouseInfo(&warehouses[i]))
+	}
+	return &rsp, nil
+}
+
+func (*InventoryServer) CreateWarehouse(ctx context.Context, req *proto.WarehouseInfo) (*proto.WarehouseInfo, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "仓库名称不能为空")
+	}
+	if result := global.DB.Where(&model.Warehouse{Name: req.Name}).First(&model.Warehouse{}); result.RowsAffected == 1 {
+		return nil, status.Errorf(codes.AlreadyExists, "仓库已存在")
+	}
+
+	warehouse := model.Warehouse{
+		Name:     req.Name,
+		Province: req.Province,
+		City:     req.City,
+		Address:  req.Address,
+		Priority: req.Priority,
+		Disabled: req.Disabled,
+	}
+	if result := global.DB.Create(&warehouse); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "新建仓库失败")
+	}
+	return warehouseInfo(&warehouse), nil
+}
+
+// UpdateWarehouse 修改仓库， 请求中的字段会全部覆盖
+func (*InventoryServer) UpdateWarehouse(ctx context.Context, req *proto.WarehouseInfo) (*emptypb.Empty, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "仓库名称不能为空")
+	}
+	var warehouse model.Warehouse
+	if result := global.DB.First(&warehouse, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "仓库不存在")
+	}
+
+	warehouse.Name = req.Name
+	warehouse.Province = req.Province
+	warehouse.City = req.City
+	warehouse.Address = req.Address
+	warehouse.Priority = req.Priority
+	warehouse.Disabled = req.Disabled
+	if result := global.DB.Save(&warehouse); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "修改仓库失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteWarehouse 仓库中还有库存或者冻结的库存的时候不能删除， 先调拨到其他仓库或者停用
+func (*InventoryServer) DeleteWarehouse(ctx context.Context, req *proto.WarehouseInfo) (*emptypb.Empty, error) {
+	tx := global.DB.Begin()
+	var warehouse model.Warehouse
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&warehouse, req.Id); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "仓库不存在")
+	}
+	var count int64
+	tx.Model(&model.WarehouseStock{}).Where("warehouse = ? and (stocks > 0 or freeze > 0)", warehouse.ID).Count(&count)
+	if count > 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "仓库中还有库存")
+	}
+	if result := tx.Delete(&warehouse); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "删除仓库失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
+
+func (*InventoryServer) WarehouseInvDetail(ctx context.Context, req *proto.GoodsInvInfo) (*proto.WarehouseInvResponse, error) {
+	type result struct {
+		Warehouse int32
+		Name      string
+		Stocks    int32
+		Freeze    int32
+	}
+	var rows []result
+	if r := global.DB.Model(&model.WarehouseStock{}).
+		Select("warehousestock.warehouse, warehouse.name, warehousestock.stocks, warehousestock.freeze").
+		Joins("join warehouse on warehouse.id = warehousestock.warehouse and warehouse.deleted_at is null").
+		Where("warehousestock.goods = ?", req.GoodsId).
+		Order("warehousestock.warehouse").
+		Scan(&rows); r.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存信息失败")
+	}
+
+	rsp := proto.WarehouseInvResponse{GoodsId: req.GoodsId}
+	for _, row := range rows {
+		rsp.Data = append(rsp.Data, &proto.WarehouseStockInfo{
+			WarehouseId:   row.Warehouse,
+			WarehouseName: row.Name,
+			Stocks:        row.Stocks,
+			Freeze:        row.Freeze,
+		})
+	}
+	return &rsp, nil
+}
+
+// TransferStock 把可以售卖的库存从一个仓库调拨到另一个仓库， 冻结的库存不能调拨
+func (*InventoryServer) TransferStock(ctx context.Context, req *proto.TransferStockRequest) (*emptypb.Empty, error) {
+	if req.Num <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "调拨数量不合法")
+	}
+	if req.FromWarehouseId == req.ToWarehouseId {
+		return nil, status.Errorf(codes.InvalidArgument, "调出和调入的仓库相同")
+	}
+
+	tx := global.DB.Begin()
+	var count int64
+	tx.Model(&model.Warehouse{}).Where("id in ?", []int32{req.FromWarehouseId, req.ToWarehouseId}).Count(&count)
+	if count != 2 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "仓库不存在")
+	}
+	if err := ensureStock(tx, req.ToWarehouseId, req.GoodsId); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "调拨库存失败")
+	}
+	// 按照仓库的顺序加锁， 避免两个方向同时调拨的时候死锁
+	var stocks []model.WarehouseStock
+	tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("goods = ? and warehouse in ?", req.GoodsId, []int32{req.FromWarehouseId, req.ToWarehouseId}).
+		Order("warehouse").Find(&stocks)
+
+	op := stockOp{Type: model.INV_TRANSFER, Operator: req.Operator, Source: "TransferStock"}
+	ok, err := changeStock(tx, op, req.FromWarehouseId, req.GoodsId, -req.Num, 0)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "调拨库存失败")
+	}
+	if !ok {
+		tx.Rollback()
+		return nil, status.Errorf(codes.ResourceExhausted, "调出仓库的库存不足")
+	}
+	if ok, err := changeStock(tx, op, req.ToWarehouseId, req.GoodsId, req.Num, 0); err != nil || !ok {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "调拨库存失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
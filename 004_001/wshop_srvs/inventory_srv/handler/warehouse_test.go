@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/inventory_srv/config"
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/mq"
+	"wshop_srvs/inventory_srv/proto"
+)
+
+func TestSellRouting(t *testing.T) {
+	db := setupDB(t)
+	shanghai := createWarehouse(t, db, model.Warehouse{Name: "上海仓", Province: "上海市"}, map[int32]int32{1: 5, 2: 5})
+	guangzhou := createWarehouse(t, db, model.Warehouse{Name: "广州仓", Province: "广东省", Priority: 10}, map[int32]int32{1: 5, 2: 5})
+	s := &InventoryServer{}
+
+	// 同一个省份的仓库优先
+	if _, err := s.Sell(context.Background(), &proto.SellInfo{OrderSn: "sh", Province: "上海市", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+	// 没有同一个省份的仓库的时候按照优先级
+	if _, err := s.Sell(context.Background(), &proto.SellInfo{OrderSn: "zj", Province: "浙江省", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if stocks, _ := stockOf(t, db, shanghai, 1); stocks != 3 {
+		t.Errorf("上海仓的库存 = %d, want 3", stocks)
+	}
+	if stocks, _ := stockOf(t, db, guangzhou, 1); stocks != 4 {
+		t.Errorf("广州仓的库存 = %d, want 4", stocks)
+	}
+	if stocks, _ := totalOf(t, db, 1); stocks != 7 {
+		t.Errorf("合计库存 = %d, want 7", stocks)
+	}
+
+	var sellDetail model.StockSellDetail
+	db.Where(&model.StockSellDetail{OrderSn: "sh"}).First(&sellDetail)
+	want := model.GoodsDetailList{{Goods: 1, Num: 2, Warehouse: shanghai}}
+	if !reflect.DeepEqual(sellDetail.Detail, want) {
+		t.Errorf("扣减明细 = %v, want %v", sellDetail.Detail, want)
+	}
+
+	// 一个仓库不够并且不允许拆单
+	_, err := s.Sell(context.Background(), &proto.SellInfo{OrderSn: "big", Province: "上海市", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 6}}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("err = %v, want ResourceExhausted", err)
+	}
+	if stocks, _ := totalOf(t, db, 1); stocks != 7 {
+		t.Errorf("失败之后合计库存 = %d, want 7", stocks)
+	}
+
+	_, err = s.Sell(context.Background(), &proto.SellInfo{OrderSn: "none", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 3, Num: 1}}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("没有库存信息的商品 err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSellSplitAndAutoReback(t *testing.T) {
+	db := setupDB(t)
+	shanghai := createWarehouse(t, db, model.Warehouse{Name: "上海仓", Province: "上海市"}, map[int32]int32{1: 5})
+	guangzhou := createWarehouse(t, db, model.Warehouse{Name: "广州仓", Province: "广东省"}, map[int32]int32{1: 5})
+	global.ServerConfig.RoutingInfo = config.RoutingConfig{Strategy: "nearest", AllowSplit: true}
+	s := &InventoryServer{}
+
+	if _, err := s.Sell(context.Background(), &proto.SellInfo{OrderSn: "split", Province: "广东省", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 8}}}); err != nil {
+		t.Fatal(err)
+	}
+	var sellDetail model.StockSellDetail
+	db.Where(&model.StockSellDetail{OrderSn: "split"}).First(&sellDetail)
+	want := model.GoodsDetailList{{Goods: 1, Num: 5, Warehouse: guangzhou}, {Goods: 1, Num: 3, Warehouse: shanghai}}
+	if !reflect.DeepEqual(sellDetail.Detail, want) {
+		t.Fatalf("扣减明细 = %v, want %v", sellDetail.Detail, want)
+	}
+
+	// 超时归还的库存回到扣减的仓库， 重复的消息不会重复归还
+	msg := &mq.Message{Body: []byte(`{"OrderSn":"split"}`)}
+	for i := 0; i < 2; i++ {
+		if result, _ := AutoReback(context.Background(), msg); result != mq.ConsumeSuccess {
+			t.Fatalf("AutoReback = %v", result)
+		}
+	}
+	if stocks, _ := stockOf(t, db, shanghai, 1); stocks != 5 {
+		t.Errorf("上海仓的库存 = %d, want 5", stocks)
+	}
+	if stocks, _ := stockOf(t, db, guangzhou, 1); stocks != 5 {
+		t.Errorf("广州仓的库存 = %d, want 5", stocks)
+	}
+	if stocks, _ := totalOf(t, db, 1); stocks != 10 {
+		t.Errorf("合计库存 = %d, want 10", stocks)
+	}
+}
+
+func TestAutoRebackLegacyDetail(t *testing.T) {
+	db := setupDB(t)
+	first := createWarehouse(t, db, model.Warehouse{Name: "默认仓库"}, map[int32]int32{1: 1})
+	createWarehouse(t, db, model.Warehouse{Name: "广州仓"}, map[int32]int32{1: 1})
+	// 多仓库之前的扣减明细没有仓库， 归还到默认仓库
+	db.Create(&model.StockSellDetail{OrderSn: "legacy", Status: 1, Detail: model.GoodsDetailList{{Goods: 1, Num: 2}}})
+
+	if result, _ := AutoReback(context.Background(), &mq.Message{Body: []byte(`{"OrderSn":"legacy"}`)}); result != mq.ConsumeSuccess {
+		t.Fatalf("AutoReback = %v", result)
+	}
+	if stocks, _ := stockOf(t, db, first, 1); stocks != 3 {
+		t.Errorf("默认仓库的库存 = %d, want 3", stocks)
+	}
+}
+
+func TestRebackUnknownOrder(t *testing.T) {
+	db := setupDB(t)
+	warehouse := createWarehouse(t, db, model.Warehouse{Name: "默认仓库"}, map[int32]int32{1: 1})
+
+	_, err := (&InventoryServer{}).Reback(context.Background(), &proto.SellInfo{OrderSn: "unknown", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 1}}})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("err = %v, want NotFound", err)
+	}
+	if stocks, _ := stockOf(t, db, warehouse, 1); stocks != 1 {
+		t.Errorf("库存 = %d, want 1", stocks)
+	}
+}
+
+func TestTransferStock(t *testing.T) {
+	db := setupDB(t)
+	from := createWarehouse(t, db, model.Warehouse{Name: "上海仓"}, map[int32]int32{1: 5})
+	to := createWarehouse(t, db, model.Warehouse{Name: "广州仓"}, nil)
+	s := &InventoryServer{}
+
+	if _, err := s.TransferStock(context.Background(), &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: from, ToWarehouseId: to, Num: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if stocks, _ := stockOf(t, db, from, 1); stocks != 2 {
+		t.Errorf("调出仓库的库存 = %d, want 2", stocks)
+	}
+	if stocks, _ := stockOf(t, db, to, 1); stocks != 3 {
+		t.Errorf("调入仓库的库存 = %d, want 3", stocks)
+	}
+	if stocks, _ := totalOf(t, db, 1); stocks != 5 {
+		t.Errorf("调拨之后合计库存 = %d, want 5", stocks)
+	}
+
+	// 冻结的库存不能调拨
+	if _, err := s.TrySell(context.Background(), &proto.SellInfo{OrderSn: "tcc", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 3}}}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		req  *proto.TransferStockRequest
+		code codes.Code
+	}{
+		{"frozen", &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: to, ToWarehouseId: from, Num: 1}, codes.ResourceExhausted},
+		{"insufficient", &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: from, ToWarehouseId: to, Num: 3}, codes.ResourceExhausted},
+		{"same warehouse", &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: from, ToWarehouseId: from, Num: 1}, codes.InvalidArgument},
+		{"no warehouse", &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: from, ToWarehouseId: 100, Num: 1}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		if _, err := s.TransferStock(context.Background(), tt.req); status.Code(err) != tt.code {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+}
+
+func TestDeleteWarehouse(t *testing.T) {
+	db := setupDB(t)
+	from := createWarehouse(t, db, model.Warehouse{Name: "上海仓"}, map[int32]int32{1: 2})
+	to := createWarehouse(t, db, model.Warehouse{Name: "广州仓"}, nil)
+	s := &InventoryServer{}
+
+	if _, err := s.DeleteWarehouse(context.Background(), &proto.WarehouseInfo{Id: from}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("还有库存的时候 err = %v, want FailedPrecondition", err)
+	}
+	if _, err := s.TransferStock(context.Background(), &proto.TransferStockRequest{GoodsId: 1, FromWarehouseId: from, ToWarehouseId: to, Num: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteWarehouse(context.Background(), &proto.WarehouseInfo{Id: from}); err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := s.WarehouseInvDetail(context.Background(), &proto.GoodsInvInfo{GoodsId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rsp.Data) != 1 || rsp.Data[0].WarehouseId != to || rsp.Data[0].Stocks != 2 {
+		t.Errorf("删除之后每个仓库的库存 = %v", rsp.Data)
+	}
+}
+
+func TestTccWarehouse(t *testing.T) {
+	db := setupDB(t)
+	warehouse := createWarehouse(t, db, model.Warehouse{Name: "上海仓"}, map[int32]int32{1: 5})
+	s := &InventoryServer{}
+	goods := []*proto.GoodsInvInfo{{GoodsId: 1, Num: 2}}
+
+	for _, orderSn := range []string{"confirm", "cancel"} {
+		if _, err := s.TrySell(context.Background(), &proto.SellInfo{OrderSn: orderSn, GoodsInfo: goods}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if stocks, freeze := stockOf(t, db, warehouse, 1); stocks != 5 || freeze != 4 {
+		t.Fatalf("try之后 stocks=%d freeze=%d, want 5 4", stocks, freeze)
+	}
+	if _, err := s.ConfirmSell(context.Background(), &proto.SellInfo{OrderSn: "confirm"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CancelSell(context.Background(), &proto.SellInfo{OrderSn: "cancel"}); err != nil {
+		t.Fatal(err)
+	}
+	if stocks, freeze := stockOf(t, db, warehouse, 1); stocks != 3 || freeze != 0 {
+		t.Errorf("confirm和cancel之后 stocks=%d freeze=%d, want 3 0", stocks, freeze)
+	}
+	if stocks, freeze := totalOf(t, db, 1); stocks != 3 || freeze != 0 {
+		t.Errorf("合计 stocks=%d freeze=%d, want 3 0", stocks, freeze)
+	}
+}
+
+func TestRebackIdempotent(t *testing.T) {
+	db := setupDB(t)
+	warehouse := createWarehouse(t, db, model.Warehouse{Name: "上海仓"}, map[int32]int32{1: 5, 2: 5})
+	s := &InventoryServer{}
+	ctx := context.Background()
+	if _, err := s.Sell(ctx, &proto.SellInfo{OrderSn: "o1", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 3}, {GoodsId: 2, Num: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 同一个退款单重试的时候只归还一次
+	refund := &proto.SellInfo{OrderSn: "o1", RebackSn: "r1", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 1}}}
+	for i := 0; i < 2; i++ {
+		if _, err := s.Reback(ctx, refund); err != nil {
+			t.Fatalf("第%d次归还: %v", i+1, err)
+		}
+	}
+	if stocks, _ := stockOf(t, db, warehouse, 1); stocks != 3 {
+		t.Fatalf("退款之后的库存 = %d, want 3", stocks)
+	}
+
+	tests := []struct {
+		name string
+		req  *proto.SellInfo
+		code codes.Code
+	}{
+		{"over return", &proto.SellInfo{OrderSn: "o1", RebackSn: "r2", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 3}}}, codes.FailedPrecondition},
+		{"not sold", &proto.SellInfo{OrderSn: "o1", RebackSn: "r3", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 3, Num: 1}}}, codes.FailedPrecondition},
+		{"bad num", &proto.SellInfo{OrderSn: "o1", RebackSn: "r4", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 0}}}, codes.InvalidArgument},
+		{"no sn", &proto.SellInfo{GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 1}}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		if _, err := s.Reback(ctx, tt.req); status.Code(err) != tt.code {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+
+	// 超时归还只归还退款之后剩下的部分
+	if result, _ := AutoReback(ctx, &mq.Message{Body: []byte(`{"OrderSn":"o1"}`)}); result != mq.ConsumeSuccess {
+		t.Fatalf("AutoReback = %v", result)
+	}
+	if stocks, _ := stockOf(t, db, warehouse, 1); stocks != 5 {
+		t.Errorf("商品1的库存 = %d, want 5", stocks)
+	}
+	if stocks, _ := stockOf(t, db, warehouse, 2); stocks != 5 {
+		t.Errorf("商品2的库存 = %d, want 5", stocks)
+	}
+
+	// 全部归还之后扣减明细标记为已归还， 之后的退款不能再归还
+	var sellDetail model.StockSellDetail
+	db.Where(&model.StockSellDetail{OrderSn: "o1"}).First(&sellDetail)
+	if sellDetail.Status != 2 {
+		t.Errorf("扣减明细 status = %d, want 2", sellDetail.Status)
+	}
+	if _, err := s.Reback(ctx, &proto.SellInfo{OrderSn: "o1", RebackSn: "r5", GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 2, Num: 1}}}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("全部归还之后 err = %v, want FailedPrecondition", err)
+	}
+}
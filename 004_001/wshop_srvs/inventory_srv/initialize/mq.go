@@ -0,0 +1,50 @@
+package initialize
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/inventory_srv/config"
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/mq"
+)
+
+// InitMQ 初始化消息队列和全局的producer， 返回的函数在退出的时候关闭producer
+func InitMQ() func() {
+	broker, err := newBroker(global.ServerConfig.MQInfo)
+	if err != nil {
+		zap.S().Fatalf("初始化消息队列失败: %s", err.Error())
+	}
+	global.MQBroker = broker
+
+	// 本地消息表的relay使用
+	p, err := global.MQBroker.NewProducer()
+	if err != nil {
+		zap.S().Fatalf("生成producer失败: %s", err.Error())
+	}
+	if err = p.Start(); err != nil {
+		zap.S().Fatalf("启动producer失败: %s", err.Error())
+	}
+	global.MQProducer = p
+
+	return func() {
+		_ = p.Shutdown()
+	}
+}
+
+// newBroker 根据配置生成消息队列， rocketmq没有配置name server的地址的时候返回错误
+func newBroker(c config.MQConfig) (mq.Broker, error) {
+	switch c.Adapter {
+	case "", "rocketmq":
+		if c.Host == "" || c.Port == 0 {
+			return nil, errors.New("没有配置rocketmq的name server地址(mq.host, mq.port)")
+		}
+		return mq.NewRocketMQ(fmt.Sprintf("%s:%d", c.Host, c.Port)), nil
+	case "memory":
+		zap.S().Warn("使用进程内的消息队列， 收不到其他服务的消息")
+		return mq.NewMemoryBroker(), nil
+	}
+	return nil, fmt.Errorf("不支持的消息队列: %s", c.Adapter)
+}
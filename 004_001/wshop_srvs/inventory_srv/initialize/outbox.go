@@ -0,0 +1,53 @@
+package initialize
+
+import (
+	"context"
+	"expvar"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/inventory_srv/global"
+	"wshop_srvs/inventory_srv/outbox"
+)
+
+// InitOutbox 启动本地消息表的relay， 返回的函数在退出的时候停止relay
+func InitOutbox() func() {
+	c := global.ServerConfig.OutboxInfo
+	relay := outbox.NewRelay(outbox.GormStore{DB: global.DB}, global.MQProducer)
+	if c.BatchSize > 0 {
+		relay.BatchSize = c.BatchSize
+	}
+	if c.Interval > 0 {
+		relay.Interval = time.Duration(c.Interval) * time.Millisecond
+	}
+	global.OutboxRelay = relay
+
+	// 积压的数量和最早一条消息等待的时间， 通过/debug/vars查看
+	expvar.Publish("outbox", expvar.Func(func() interface{} {
+		return relay.Stats()
+	}))
+	if c.MetricsAddr != "" {
+		// 只暴露/debug/vars， 不使用DefaultServeMux， 避免其他包注册到默认mux上的handler(比如pprof)被一起暴露出去
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			if err := http.ListenAndServe(c.MetricsAddr, mux); err != nil {
+				zap.S().Errorf("启动监控指标服务失败: %s", err.Error())
+			}
+		}()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
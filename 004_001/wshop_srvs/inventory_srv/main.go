@@ -3,8 +3,6 @@ package main
 import (
 	"flag"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2"
-	"github.com/apache/rocketmq-client-go/v2/consumer"
 	"github.com/satori/go.uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -31,6 +29,8 @@ func main() {
 	initialize.InitLogger()
 	initialize.InitConfig()
 	initialize.InitDB()
+	shutdownMQ := initialize.InitMQ()
+	stopOutbox := initialize.InitOutbox()
 	zap.S().Info(global.ServerConfig)
 
 	flag.Parse()
@@ -68,12 +68,12 @@ func main() {
 	zap.S().Debugf("启动服务器, 端口： %d", *Port)
 
 	// 监听库存归还topic
-	c, _ := rocketmq.NewPushConsumer(
-		consumer.WithNameServer([]string{"192.168.0.249:9876"}),
-		consumer.WithGroupName("wshop-inventory"),
-	)
+	c, err := global.MQBroker.NewPushConsumer("wshop-inventory")
+	if err != nil {
+		zap.S().Panic("生成consumer失败:", err.Error())
+	}
 
-	if err := c.Subscribe("order_reback", consumer.MessageSelector{}, handler.AutoReback); err != nil {
+	if err := c.Subscribe("order_reback", handler.AutoReback); err != nil {
 		fmt.Println("读取消息失败")
 	}
 	_ = c.Start()
@@ -84,6 +84,8 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	_ = c.Shutdown()
+	stopOutbox()
+	shutdownMQ()
 	if err = register_client.DeRegister(serviceId); err != nil {
 		zap.S().Info("注销失败:", err.Error())
 	} else {
@@ -5,15 +5,35 @@ import (
 	"encoding/json"
 )
 
-//type Stock struct {
-//	BaseModel
-//	Name string
-//	Address string
-//}
+// Warehouse 发货的仓库， 商品的库存按照仓库保存在warehousestock表中
+type Warehouse struct {
+	BaseModel
+	Name     string `gorm:"type:varchar(50);not null"`
+	Province string `gorm:"type:varchar(10)"` // 和收货地址的省份相同的时候优先发货
+	City     string `gorm:"type:varchar(10)"`
+	Address  string `gorm:"type:varchar(200)"`
+	Priority int32  `gorm:"type:int;not null;default:0"` // 数字越大越优先发货
+	Disabled bool   `gorm:"not null;default:false"`      // 停用的仓库不再发货， 已经扣减的库存还可以归还
+}
+
+// WarehouseStock 商品在一个仓库中的库存
+// inventory表中的stocks和freeze是所有仓库的合计， 在同一个事务中一起修改
+type WarehouseStock struct {
+	BaseModel
+	Warehouse int32 `gorm:"type:int;index:idx_warehouse_goods,unique;not null"`
+	Goods     int32 `gorm:"type:int;index:idx_warehouse_goods,unique;index;not null"`
+	Stocks    int32 `gorm:"type:int;not null;default:0"`
+	Freeze    int32 `gorm:"type:int;not null;default:0"` // TCC冻结的库存
+}
+
+func (WarehouseStock) TableName() string {
+	return "warehousestock"
+}
 
 type GoodsDetail struct {
-	Goods int32
-	Num   int32
+	Goods     int32
+	Num       int32
+	Warehouse int32 // 发货的仓库， 之前的记录中没有仓库， 归还到默认仓库
 }
 type GoodsDetailList []GoodsDetail
 
@@ -33,12 +53,18 @@ type Inventory struct {
 	Version int32 `gorm:"type:int"` //分布式锁的乐观锁
 }
 
+// InventoryNew 和Inventory是同一张表， 多了冻结库存的字段， TCC模式使用
+// 可以售卖的库存是stocks-freeze
 type InventoryNew struct {
 	BaseModel
 	Goods   int32 `gorm:"type:int;index"`
 	Stocks  int32 `gorm:"type:int"`
-	Version int32 `gorm:"type:int"` //分布式锁的乐观锁
-	Freeze  int32 `gorm:"type:int"` //冻结库存
+	Version int32 `gorm:"type:int"`                    //分布式锁的乐观锁
+	Freeze  int32 `gorm:"type:int;not null;default:0"` //冻结库存
+}
+
+func (InventoryNew) TableName() string {
+	return "inventory"
 }
 
 type Delivery struct {
@@ -51,17 +77,72 @@ type Delivery struct {
 type StockSellDetail struct {
 	OrderSn string          `gorm:"type:varchar(200);index:idx_order_sn,unique;"`
 	Status  int32           `gorm:"type:varchar(200)"` //1 表示已扣减 2. 表示已归还
-	Detail  GoodsDetailList `gorm:"type:varchar(200)"`
+	Detail  GoodsDetailList `gorm:"type:text"`         // 拆单发货的时候一种商品有多条明细， varchar(200)不够用
 }
 
 func (StockSellDetail) TableName() string {
 	return "stockselldetail"
 }
 
-//type InventoryHistory struct {
-//	user int32
-//	goods int32
-//	nums int32
-//	order int32
-//	status int32 //1. 表示库存是预扣减， 幂等性， 2. 表示已经支付
-//}
+// StockRebackRecord Reback归还库存的记录， 和归还在同一个事务中写入
+// 唯一索引保证同一个归还单号(退款单号， 没有的时候是订单号)只会归还一次， 重试的请求直接返回成功
+type StockRebackRecord struct {
+	BaseModel
+	RebackSn string          `gorm:"type:varchar(200);index:idx_reback_sn,unique;not null"`
+	OrderSn  string          `gorm:"type:varchar(200);index"`
+	Detail   GoodsDetailList `gorm:"type:text"` // 每个仓库归还的数量
+}
+
+func (StockRebackRecord) TableName() string {
+	return "stockrebackrecord"
+}
+
+const (
+	TCC_TRIED     = iota + 1 // 已经冻结库存
+	TCC_CONFIRMED            // 已经扣减冻结的库存
+	TCC_CANCELED             // 已经释放冻结的库存， 或者是空回滚
+)
+
+// StockTccRecord TCC分支事务的记录， 一个订单号只有一条
+// 用来保证try/confirm/cancel的幂等， 没有try的cancel(空回滚)也会留下记录， 之后到达的try会被拒绝(防悬挂)
+type StockTccRecord struct {
+	BaseModel
+	OrderSn string          `gorm:"type:varchar(200);index:idx_tcc_order_sn,unique;not null"`
+	Status  int32           `gorm:"type:int comment '状态: 1(已冻结),2(已确认),3(已取消)';not null"`
+	Detail  GoodsDetailList `gorm:"type:text"` // 每个仓库冻结的数量
+}
+
+func (StockTccRecord) TableName() string {
+	return "stocktccrecord"
+}
+
+// 库存流水的类型
+const (
+	INV_SET      = "set"      // 管理员设置库存
+	INV_SELL     = "sell"     // 下单扣减
+	INV_REBACK   = "reback"   // 订单取消或者退款归还
+	INV_FREEZE   = "freeze"   // TCC冻结
+	INV_CONFIRM  = "confirm"  // TCC扣减冻结的库存
+	INV_CANCEL   = "cancel"   // TCC释放冻结的库存
+	INV_TRANSFER = "transfer" // 仓库之间调拨， 调出和调入各有一条
+)
+
+// InventoryHistory 库存流水， 每次修改仓库的库存都在同一个事务中追加一条， 不会修改和删除
+// 一个仓库中商品的流水按照id排列， 上一条的after就是下一条的before
+type InventoryHistory struct {
+	BaseModel
+	Goods        int32  `gorm:"type:int;index:idx_goods_warehouse;not null"`
+	Warehouse    int32  `gorm:"type:int;index:idx_goods_warehouse;not null"`
+	Type         string `gorm:"type:varchar(20);not null"`
+	StocksBefore int32  `gorm:"type:int;not null"`
+	StocksAfter  int32  `gorm:"type:int;not null"`
+	FreezeBefore int32  `gorm:"type:int;not null"`
+	FreezeAfter  int32  `gorm:"type:int;not null"`
+	OrderSn      string `gorm:"type:varchar(200);index"`
+	Operator     int32  `gorm:"type:int;not null;default:0"` // 操作的管理员， 0是系统自动修改的
+	Source       string `gorm:"type:varchar(50)"`            // 修改库存的接口， 比如Sell、AutoReback
+}
+
+func (InventoryHistory) TableName() string {
+	return "inventoryhistory"
+}
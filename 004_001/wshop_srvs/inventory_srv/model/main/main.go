@@ -21,6 +21,45 @@ func genMd5(code string) string {
 	return hex.EncodeToString(Md5.Sum(nil))
 }
 
+// migrateWarehouse 之前只有inventory表中的总库存， 第一次迁移的时候创建默认仓库， 把总库存和冻结的库存都放到默认仓库中
+// 同时给每个商品写一条期初的库存流水
+func migrateWarehouse(db *gorm.DB) error {
+	var count int64
+	db.Model(&model.Warehouse{}).Count(&count)
+	if count > 0 {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		warehouse := model.Warehouse{Name: "默认仓库"}
+		if err := tx.Create(&warehouse).Error; err != nil {
+			return err
+		}
+		var invs []model.InventoryNew
+		if err := tx.Find(&invs).Error; err != nil {
+			return err
+		}
+		for _, inv := range invs {
+			stock := model.WarehouseStock{Warehouse: warehouse.ID, Goods: inv.Goods, Stocks: inv.Stocks, Freeze: inv.Freeze}
+			if err := tx.Create(&stock).Error; err != nil {
+				return err
+			}
+			// 迁移时的库存作为流水的期初
+			history := model.InventoryHistory{
+				Goods:       inv.Goods,
+				Warehouse:   warehouse.ID,
+				Type:        model.INV_SET,
+				StocksAfter: inv.Stocks,
+				FreezeAfter: inv.Freeze,
+				Source:      "migrate",
+			}
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func main() {
 	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_inventory_srv?charset=utf8mb4&parseTime=True&loc=Local"
 
@@ -44,6 +83,11 @@ func main() {
 		panic(err)
 	}
 
+	_ = db.AutoMigrate(&model.OutboxMessage{}, &model.InventoryNew{}, &model.StockTccRecord{}, &model.StockSellDetail{}, &model.StockRebackRecord{},
+		&model.Warehouse{}, &model.WarehouseStock{}, &model.InventoryHistory{})
+	if err := migrateWarehouse(db); err != nil {
+		panic(err)
+	}
 	// _ = db.AutoMigrate(&model.Inventory{}, &model.StockSellDetail{})
 	// // 插入一条数据
 	// orderDetail := model.StockSellDetail{
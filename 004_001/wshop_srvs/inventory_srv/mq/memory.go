@@ -0,0 +1,455 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultDelayLevels rocketmq默认的18个延时等级
+var DefaultDelayLevels = []time.Duration{
+	time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 3 * time.Minute, 4 * time.Minute, 5 * time.Minute,
+	6 * time.Minute, 7 * time.Minute, 8 * time.Minute, 9 * time.Minute, 10 * time.Minute,
+	20 * time.Minute, 30 * time.Minute, time.Hour, 2 * time.Hour,
+}
+
+var (
+	ErrBrokerClosed       = errors.New("broker已经关闭")
+	ErrProducerNotStarted = errors.New("producer还没有启动")
+)
+
+const (
+	defaultRetryDelay        = 10 * time.Second // rocketmq重试从延时等级3开始
+	defaultCheckInterval     = time.Minute      // rocketmq默认每分钟回查一次
+	defaultMaxReconsumeTimes = 16               // 超过之后进入死信队列
+	defaultMaxCheckTimes     = 15               // 超过之后丢弃半消息
+)
+
+type MemoryOption func(*MemoryBroker)
+
+// WithDelayLevels 自定义延时等级对应的时间， 测试的时候可以把时间改短
+func WithDelayLevels(levels ...time.Duration) MemoryOption {
+	return func(b *MemoryBroker) {
+		b.delayLevels = levels
+	}
+}
+
+// WithRetryDelay 消费失败之后重新投递的间隔
+func WithRetryDelay(d time.Duration) MemoryOption {
+	return func(b *MemoryBroker) {
+		b.retryDelay = d
+	}
+}
+
+// WithCheckInterval 事务回查的间隔， 小于等于0的时候只能通过CheckTransactions手动回查
+func WithCheckInterval(d time.Duration) MemoryOption {
+	return func(b *MemoryBroker) {
+		b.checkInterval = d
+	}
+}
+
+// WithMaxReconsumeTimes 最多重新投递的次数
+func WithMaxReconsumeTimes(n int32) MemoryOption {
+	return func(b *MemoryBroker) {
+		b.maxReconsumeTimes = n
+	}
+}
+
+// MemoryBroker 进程内的消息队列， 支持事务消息回查、延时等级和消费失败重试
+// 语义和rocketmq保持一致: 每个消费者组都会收到一份消息， 同一个组内的多个消费者轮流消费
+type MemoryBroker struct {
+	delayLevels       []time.Duration
+	retryDelay        time.Duration
+	checkInterval     time.Duration
+	maxReconsumeTimes int32
+	maxCheckTimes     int
+
+	mu           sync.Mutex
+	idle         *sync.Cond
+	inflight     int // 正在消费和等待投递的消息数量
+	seq          int64
+	groups       map[string]*memoryGroup
+	unrouted     map[string][]*Message // 还没有消费者组订阅的消息， 第一个订阅的组会收到
+	halfMessages map[string]*halfMessage
+	timers       map[*int]*time.Timer
+	dead         []*Message
+	closed       bool
+	stop         chan struct{}
+}
+
+type memoryGroup struct {
+	topics    map[string]bool
+	consumers []*memoryConsumer
+	next      int
+	backlog   []*Message // 没有运行中的消费者时暂存的消息
+}
+
+type halfMessage struct {
+	msg        *Message
+	listener   TransactionListener
+	checkTimes int
+}
+
+func NewMemoryBroker(opts ...MemoryOption) *MemoryBroker {
+	b := &MemoryBroker{
+		delayLevels:       DefaultDelayLevels,
+		retryDelay:        defaultRetryDelay,
+		checkInterval:     defaultCheckInterval,
+		maxReconsumeTimes: defaultMaxReconsumeTimes,
+		maxCheckTimes:     defaultMaxCheckTimes,
+		groups:            make(map[string]*memoryGroup),
+		unrouted:          make(map[string][]*Message),
+		halfMessages:      make(map[string]*halfMessage),
+		timers:            make(map[*int]*time.Timer),
+		stop:              make(chan struct{}),
+	}
+	b.idle = sync.NewCond(&b.mu)
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	if b.checkInterval > 0 {
+		go b.checkLoop()
+	}
+	return b
+}
+
+func (b *MemoryBroker) NewProducer() (DelayProducer, error) {
+	return &memoryProducer{broker: b}, nil
+}
+
+func (b *MemoryBroker) NewTransactionProducer(listener TransactionListener) (TransactionProducer, error) {
+	return &memoryTransactionProducer{memoryProducer: memoryProducer{broker: b}, listener: listener}, nil
+}
+
+func (b *MemoryBroker) NewPushConsumer(group string) (PushConsumer, error) {
+	c := &memoryConsumer{broker: b, group: group, handlers: make(map[string]MessageHandler)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	g := b.groupLocked(group)
+	g.consumers = append(g.consumers, c)
+	return c, nil
+}
+
+// Wait 等待所有已经发送的消息消费完成， 包括还没有到期的延时消息和等待重试的消息
+func (b *MemoryBroker) Wait() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for b.inflight > 0 {
+		b.idle.Wait()
+	}
+}
+
+// CheckTransactions 立即回查所有状态未知的事务消息
+func (b *MemoryBroker) CheckTransactions() {
+	b.mu.Lock()
+	halves := make([]*halfMessage, 0, len(b.halfMessages))
+	for _, h := range b.halfMessages {
+		halves = append(halves, h)
+	}
+	b.mu.Unlock()
+
+	for _, h := range halves {
+		state := h.listener.CheckLocalTransaction(copyMessage(h.msg))
+
+		b.mu.Lock()
+		if _, ok := b.halfMessages[h.msg.TransactionId]; ok {
+			h.checkTimes++
+			if state == UnknowState && h.checkTimes >= b.maxCheckTimes {
+				state = RollbackMessageState
+			}
+			b.endTransactionLocked(h.msg.TransactionId, state)
+		}
+		b.mu.Unlock()
+	}
+}
+
+// DeadLetters 超过最大重试次数的消息
+func (b *MemoryBroker) DeadLetters() []*Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*Message(nil), b.dead...)
+}
+
+// Close 停止事务回查， 还没有到期的延时消息和重试消息会被丢弃
+func (b *MemoryBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	close(b.stop)
+	for key, t := range b.timers {
+		t.Stop()
+		delete(b.timers, key)
+		b.doneLocked()
+	}
+}
+
+func (b *MemoryBroker) checkLoop() {
+	ticker := time.NewTicker(b.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.CheckTransactions()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+func (b *MemoryBroker) groupLocked(name string) *memoryGroup {
+	g, ok := b.groups[name]
+	if !ok {
+		g = &memoryGroup{topics: make(map[string]bool)}
+		b.groups[name] = g
+	}
+	return g
+}
+
+// publishLocked 把消息投递给所有订阅了这个topic的消费者组
+func (b *MemoryBroker) publishLocked(msg *Message) {
+	b.seq++
+	msg = copyMessage(msg)
+	msg.MsgId = fmt.Sprintf("MEM%020d", b.seq)
+
+	routed := false
+	for _, g := range b.groups {
+		if g.topics[msg.Topic] {
+			routed = true
+			b.dispatchLocked(g, copyMessage(msg))
+		}
+	}
+	if !routed {
+		b.unrouted[msg.Topic] = append(b.unrouted[msg.Topic], msg)
+	}
+}
+
+// dispatchLocked 在组内轮流选择一个运行中的消费者消费， 没有的时候放到组的积压队列中
+func (b *MemoryBroker) dispatchLocked(g *memoryGroup, msg *Message) {
+	for i := 0; i < len(g.consumers); i++ {
+		c := g.consumers[(g.next+i)%len(g.consumers)]
+		if handler, ok := c.handlers[msg.Topic]; ok && c.running {
+			g.next = (g.next + i + 1) % len(g.consumers)
+			b.inflight++
+			go b.consume(g, handler, msg)
+			return
+		}
+	}
+	g.backlog = append(g.backlog, msg)
+}
+
+func (b *MemoryBroker) consume(g *memoryGroup, handler MessageHandler, msg *Message) {
+	result, err := safeHandle(handler, copyMessage(msg))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer b.doneLocked()
+
+	if result == ConsumeSuccess && err == nil {
+		return
+	}
+	if msg.ReconsumeTimes >= b.maxReconsumeTimes {
+		b.dead = append(b.dead, msg)
+		return
+	}
+	retry := copyMessage(msg)
+	retry.ReconsumeTimes++
+	b.scheduleLocked(b.retryDelay, func() {
+		b.dispatchLocked(g, retry)
+	})
+}
+
+// scheduleLocked 延时执行fn， fn执行的时候持有锁
+func (b *MemoryBroker) scheduleLocked(d time.Duration, fn func()) {
+	b.inflight++
+	key := new(int)
+	b.timers[key] = time.AfterFunc(d, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.timers[key]; !ok {
+			return // Close的时候已经取消了
+		}
+		delete(b.timers, key)
+		fn()
+		b.doneLocked()
+	})
+}
+
+func (b *MemoryBroker) endTransactionLocked(transactionId string, state LocalTransactionState) {
+	h, ok := b.halfMessages[transactionId]
+	if !ok {
+		return
+	}
+	switch state {
+	case CommitMessageState:
+		delete(b.halfMessages, transactionId)
+		b.publishLocked(h.msg)
+	case RollbackMessageState:
+		delete(b.halfMessages, transactionId)
+	}
+}
+
+func (b *MemoryBroker) doneLocked() {
+	b.inflight--
+	if b.inflight == 0 {
+		b.idle.Broadcast()
+	}
+}
+
+type memoryProducer struct {
+	broker  *MemoryBroker
+	started bool
+}
+
+func (p *memoryProducer) Start() error {
+	p.broker.mu.Lock()
+	defer p.broker.mu.Unlock()
+	p.started = true
+	return nil
+}
+
+func (p *memoryProducer) Shutdown() error {
+	p.broker.mu.Lock()
+	defer p.broker.mu.Unlock()
+	p.started = false
+	return nil
+}
+
+func (p *memoryProducer) SendSync(ctx context.Context, msg *Message) error {
+	b := p.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := p.checkLocked(); err != nil {
+		return err
+	}
+	b.publishLocked(msg)
+	return nil
+}
+
+func (p *memoryProducer) SendDelay(ctx context.Context, msg *Message, level int) error {
+	b := p.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := p.checkLocked(); err != nil {
+		return err
+	}
+	if level <= 0 || level > len(b.delayLevels) {
+		return ErrInvalidDelayLevel
+	}
+
+	msg = copyMessage(msg)
+	b.scheduleLocked(b.delayLevels[level-1], func() {
+		b.publishLocked(msg)
+	})
+	return nil
+}
+
+func (p *memoryProducer) checkLocked() error {
+	if p.broker.closed {
+		return ErrBrokerClosed
+	}
+	if !p.started {
+		return ErrProducerNotStarted
+	}
+	return nil
+}
+
+type memoryTransactionProducer struct {
+	memoryProducer
+	listener TransactionListener
+}
+
+func (p *memoryTransactionProducer) SendMessageInTransaction(ctx context.Context, msg *Message) (LocalTransactionState, error) {
+	b := p.broker
+	b.mu.Lock()
+	if err := p.checkLocked(); err != nil {
+		b.mu.Unlock()
+		return UnknowState, err
+	}
+	// 先保存半消息， 本地事务执行的过程中进程退出的话还可以通过回查确定状态
+	b.seq++
+	half := copyMessage(msg)
+	half.TransactionId = fmt.Sprintf("TX%020d", b.seq)
+	b.halfMessages[half.TransactionId] = &halfMessage{msg: half, listener: p.listener}
+	b.mu.Unlock()
+
+	state := p.listener.ExecuteLocalTransaction(copyMessage(half))
+
+	b.mu.Lock()
+	b.endTransactionLocked(half.TransactionId, state)
+	b.mu.Unlock()
+	return state, nil
+}
+
+type memoryConsumer struct {
+	broker   *MemoryBroker
+	group    string
+	handlers map[string]MessageHandler
+	running  bool
+}
+
+func (c *memoryConsumer) Subscribe(topic string, handler MessageHandler) error {
+	b := c.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c.running {
+		return errors.New("需要在Start之前订阅")
+	}
+	c.handlers[topic] = handler
+
+	g := b.groupLocked(c.group)
+	g.topics[topic] = true
+	// 订阅之前发送的消息交给第一个订阅的组
+	g.backlog = append(g.backlog, b.unrouted[topic]...)
+	delete(b.unrouted, topic)
+	return nil
+}
+
+func (c *memoryConsumer) Start() error {
+	b := c.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrBrokerClosed
+	}
+	c.running = true
+
+	g := b.groupLocked(c.group)
+	backlog := g.backlog
+	g.backlog = nil
+	for _, msg := range backlog {
+		b.dispatchLocked(g, msg)
+	}
+	return nil
+}
+
+func (c *memoryConsumer) Shutdown() error {
+	b := c.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c.running = false
+	return nil
+}
+
+// safeHandle 消费的时候panic当作消费失败处理
+func safeHandle(handler MessageHandler, msg *Message) (result ConsumeResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = ConsumeRetryLater, fmt.Errorf("消费消息panic: %v", r)
+		}
+	}()
+	return handler(context.Background(), msg)
+}
+
+func copyMessage(msg *Message) *Message {
+	m := *msg
+	m.Body = append([]byte(nil), msg.Body...)
+	return &m
+}
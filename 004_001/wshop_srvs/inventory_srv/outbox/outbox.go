@@ -0,0 +1,38 @@
+// Package outbox 本地消息表
+// 业务数据和待发送的消息在同一个数据库事务中写入， 事务提交之后由Relay异步发送到消息队列，
+// 进程在提交和发送之间退出的时候， 重启之后的Relay(或者其他实例的Relay)会继续发送， 消息至少会被发送一次
+package outbox
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/inventory_srv/model"
+)
+
+// Store 本地消息表的存储
+type Store interface {
+	// Pending 查询已经到了发送时间的待发送消息， 按照写入的顺序返回
+	Pending(now time.Time, limit int) ([]*model.OutboxMessage, error)
+	// Claim 抢占一条消息， 抢占成功之后until之前其他的Relay不会发送这条消息
+	Claim(msg *model.OutboxMessage, until time.Time) (bool, error)
+	// MarkSent 标记为已发送
+	MarkSent(msg *model.OutboxMessage, at time.Time) error
+	// MarkFailed 保存发送失败之后的重试次数、状态和下一次发送的时间
+	MarkFailed(msg *model.OutboxMessage) error
+	// PendingStats 待发送消息的数量和最早一条消息的写入时间
+	PendingStats() (count int64, oldest *time.Time, err error)
+}
+
+// Add 在业务事务中写入一条待发送的消息， delayLevel大于0的时候发送延时消息
+func Add(tx *gorm.DB, topic string, body []byte, delayLevel int32) error {
+	msg := model.OutboxMessage{
+		Topic:       topic,
+		Body:        string(body),
+		DelayLevel:  delayLevel,
+		Status:      model.OUTBOX_PENDING,
+		NextRetryAt: time.Now(),
+	}
+	return tx.Create(&msg).Error
+}
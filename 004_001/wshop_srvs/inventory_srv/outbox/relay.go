@@ -0,0 +1,193 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/inventory_srv/model"
+	"wshop_srvs/inventory_srv/mq"
+)
+
+const (
+	defaultBatchSize    = 100
+	defaultInterval     = time.Second
+	defaultClaimTimeout = 30 * time.Second // 发送超时的时间要比这个短， 否则会被其他实例重复发送
+	defaultMaxAttempts  = 16
+	defaultMaxBackoff   = 5 * time.Minute
+)
+
+// Stats outbox的监控指标
+type Stats struct {
+	Pending    int64   `json:"pending"`     // 待发送的消息数量
+	LagSeconds float64 `json:"lag_seconds"` // 最早一条待发送消息已经等待的时间
+	Sent       int64   `json:"sent"`        // 发送成功的次数
+	Retried    int64   `json:"retried"`     // 发送失败等待重试的次数
+	Failed     int64   `json:"failed"`      // 超过重试次数放弃的消息数量
+}
+
+// Relay 把本地消息表中的消息发送到消息队列
+type Relay struct {
+	store    Store
+	producer mq.DelayProducer
+
+	BatchSize    int
+	Interval     time.Duration // 轮询的间隔， 调用Notify可以立即发送
+	ClaimTimeout time.Duration
+	MaxAttempts  int32
+	MaxBackoff   time.Duration
+
+	now    func() time.Time
+	notify chan struct{}
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+func NewRelay(store Store, producer mq.DelayProducer) *Relay {
+	return &Relay{
+		store:        store,
+		producer:     producer,
+		BatchSize:    defaultBatchSize,
+		Interval:     defaultInterval,
+		ClaimTimeout: defaultClaimTimeout,
+		MaxAttempts:  defaultMaxAttempts,
+		MaxBackoff:   defaultMaxBackoff,
+		now:          time.Now,
+		notify:       make(chan struct{}, 1),
+	}
+}
+
+// Notify 业务事务提交之后调用， 不用等到下一次轮询
+func (r *Relay) Notify() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run 持续发送消息直到ctx被取消
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		sent, err := r.RunOnce(ctx)
+		if err != nil {
+			zap.S().Errorf("发送本地消息失败: %s", err.Error())
+		}
+		// 一批没有发送完的时候继续发送
+		if err == nil && sent >= r.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.notify:
+		}
+	}
+}
+
+// RunOnce 发送一批到期的消息， 返回发送成功的数量
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	now := r.now()
+	msgs, err := r.store.Pending(now, r.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, msg := range msgs {
+		ok, err := r.store.Claim(msg, now.Add(r.ClaimTimeout))
+		if err != nil {
+			return sent, err
+		}
+		if !ok {
+			continue // 被其他实例抢占了
+		}
+
+		if err := r.publish(ctx, msg); err != nil {
+			r.fail(msg, err)
+			continue
+		}
+		// 这里失败的话， 抢占过期之后消息会被再发送一次， 消费者需要保证幂等
+		if err := r.store.MarkSent(msg, r.now()); err != nil {
+			return sent, err
+		}
+		sent++
+		r.mu.Lock()
+		r.stats.Sent++
+		r.mu.Unlock()
+	}
+
+	return sent, r.refreshLag()
+}
+
+// Stats 返回当前的监控指标
+func (r *Relay) Stats() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+func (r *Relay) publish(ctx context.Context, msg *model.OutboxMessage) error {
+	m := mq.NewMessage(msg.Topic, []byte(msg.Body))
+	if msg.DelayLevel > 0 {
+		return r.producer.SendDelay(ctx, m, int(msg.DelayLevel))
+	}
+	return r.producer.SendSync(ctx, m)
+}
+
+// fail 发送失败之后按照指数退避重试， 超过重试次数之后不再发送
+func (r *Relay) fail(msg *model.OutboxMessage, err error) {
+	msg.Attempts++
+	msg.LastError = err.Error()
+	if len(msg.LastError) > 200 {
+		msg.LastError = msg.LastError[:200]
+	}
+
+	r.mu.Lock()
+	if msg.Attempts >= r.MaxAttempts {
+		msg.Status = model.OUTBOX_FAILED
+		r.stats.Failed++
+		zap.S().Errorf("本地消息%d发送失败超过%d次， 不再重试: %s", msg.ID, r.MaxAttempts, msg.LastError)
+	} else {
+		msg.NextRetryAt = r.now().Add(r.backoff(msg.Attempts))
+		r.stats.Retried++
+	}
+	r.mu.Unlock()
+
+	if err := r.store.MarkFailed(msg); err != nil {
+		zap.S().Errorf("保存本地消息%d的发送结果失败: %s", msg.ID, err.Error())
+	}
+}
+
+func (r *Relay) backoff(attempts int32) time.Duration {
+	d := time.Second
+	for i := int32(1); i < attempts && d < r.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.MaxBackoff {
+		d = r.MaxBackoff
+	}
+	return d
+}
+
+func (r *Relay) refreshLag() error {
+	count, oldest, err := r.store.PendingStats()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats.Pending = count
+	r.stats.LagSeconds = 0
+	if oldest != nil {
+		r.stats.LagSeconds = r.now().Sub(*oldest).Seconds()
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package routing 选择订单的商品从哪些仓库发货
+// 只根据仓库和可以售卖的库存计算， 不访问数据库， 扣减库存由调用方在同一个事务中完成
+package routing
+
+import (
+	"errors"
+	"sort"
+)
+
+const (
+	// Nearest 优先从和收货地址同一个省份的仓库发货， 同一个省份有多个仓库的时候按照优先级
+	Nearest = "nearest"
+	// Priority 只按照仓库的优先级发货
+	Priority = "priority"
+)
+
+// ErrInsufficient 所有仓库的库存加起来也不够
+var ErrInsufficient = errors.New("库存不足")
+
+type Warehouse struct {
+	ID       int32
+	Province string
+	Priority int32 // 数字越大越优先发货
+}
+
+type Item struct {
+	Goods int32
+	Num   int32
+}
+
+// Allocation 一种商品从一个仓库发出的数量
+type Allocation struct {
+	Warehouse int32
+	Goods     int32
+	Num       int32
+}
+
+// Stocks 每个仓库中每种商品可以售卖的库存， warehouse -> goods -> num
+type Stocks map[int32]map[int32]int32
+
+type Router struct {
+	Strategy   string // nearest(默认)或者priority
+	AllowSplit bool   // 没有一个仓库可以发出全部商品的时候， 是否允许拆成多个仓库发货
+}
+
+// rank 按照发货的先后顺序排列仓库
+func (r Router) rank(province string, warehouses []Warehouse) []Warehouse {
+	ranked := make([]Warehouse, len(warehouses))
+	copy(ranked, warehouses)
+	nearest := r.Strategy != Priority && province != ""
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if nearest && (a.Province == province) != (b.Province == province) {
+			return a.Province == province
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.ID < b.ID
+	})
+	return ranked
+}
+
+// Route 计算每种商品从哪些仓库发货
+// 优先选择一个可以发出全部商品的仓库， 没有的时候如果允许拆单， 每种商品按照仓库的顺序依次扣减
+func (r Router) Route(province string, items []Item, warehouses []Warehouse, stocks Stocks) ([]Allocation, error) {
+	// 同一种商品出现多次的时候合并
+	var merged []Item
+	index := make(map[int32]int)
+	for _, item := range items {
+		if i, ok := index[item.Goods]; ok {
+			merged[i].Num += item.Num
+			continue
+		}
+		index[item.Goods] = len(merged)
+		merged = append(merged, item)
+	}
+
+	ranked := r.rank(province, warehouses)
+	for _, w := range ranked {
+		enough := true
+		for _, item := range merged {
+			if stocks[w.ID][item.Goods] < item.Num {
+				enough = false
+				break
+			}
+		}
+		if enough {
+			allocations := make([]Allocation, 0, len(merged))
+			for _, item := range merged {
+				allocations = append(allocations, Allocation{Warehouse: w.ID, Goods: item.Goods, Num: item.Num})
+			}
+			return allocations, nil
+		}
+	}
+	if !r.AllowSplit {
+		return nil, ErrInsufficient
+	}
+
+	var allocations []Allocation
+	for _, item := range merged {
+		remain := item.Num
+		for _, w := range ranked {
+			if remain == 0 {
+				break
+			}
+			num := stocks[w.ID][item.Goods]
+			if num <= 0 {
+				continue
+			}
+			if num > remain {
+				num = remain
+			}
+			allocations = append(allocations, Allocation{Warehouse: w.ID, Goods: item.Goods, Num: num})
+			remain -= num
+		}
+		if remain > 0 {
+			return nil, ErrInsufficient
+		}
+	}
+	return allocations, nil
+}
@@ -0,0 +1,55 @@
+package routing
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRoute(t *testing.T) {
+	warehouses := []Warehouse{
+		{ID: 1, Province: "上海市", Priority: 0},
+		{ID: 2, Province: "广东省", Priority: 10},
+		{ID: 3, Province: "北京市", Priority: 5},
+	}
+	stocks := Stocks{
+		1: {100: 5, 101: 1},
+		2: {100: 5, 101: 5},
+		3: {100: 2},
+	}
+	items := []Item{{Goods: 100, Num: 3}, {Goods: 101, Num: 1}}
+
+	tests := []struct {
+		name     string
+		router   Router
+		province string
+		items    []Item
+		want     []Allocation
+		wantErr  error
+	}{
+		{"same province", Router{}, "上海市", items,
+			[]Allocation{{1, 100, 3}, {1, 101, 1}}, nil},
+		{"no warehouse in province", Router{}, "浙江省", items,
+			[]Allocation{{2, 100, 3}, {2, 101, 1}}, nil},
+		{"priority ignores province", Router{Strategy: Priority}, "上海市", items,
+			[]Allocation{{2, 100, 3}, {2, 101, 1}}, nil},
+		{"same province cannot fill", Router{}, "北京市", items,
+			[]Allocation{{2, 100, 3}, {2, 101, 1}}, nil},
+		{"split", Router{AllowSplit: true}, "北京市", []Item{{Goods: 100, Num: 10}},
+			[]Allocation{{3, 100, 2}, {2, 100, 5}, {1, 100, 3}}, nil},
+		{"no split", Router{}, "北京市", []Item{{Goods: 100, Num: 10}}, nil, ErrInsufficient},
+		{"merge items", Router{}, "上海市", []Item{{Goods: 100, Num: 3}, {Goods: 100, Num: 2}},
+			[]Allocation{{1, 100, 5}}, nil},
+		{"insufficient", Router{AllowSplit: true}, "", []Item{{Goods: 101, Num: 7}}, nil, ErrInsufficient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.router.Route(tt.province, tt.items, warehouses, stocks)
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Package autocomplete 已发货的订单超过一定天数之后自动确认收货
+// 多个order_srv实例同时运行的时候， 只有拿到租约的实例会执行， 租约过期之前要续约， 续约失败说明租约已经被其他实例拿走了
+package autocomplete
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDays      = 10
+	defaultBatchSize = 100
+	defaultInterval  = 10 * time.Minute
+)
+
+// Lease 多个实例之间互斥的租约
+type Lease interface {
+	// Acquire 尝试获取租约， 已经被其他实例持有的时候返回false， 不会等待
+	Acquire(ctx context.Context) (bool, error)
+	// Extend 延长租约的过期时间， 返回false说明租约已经过期
+	Extend(ctx context.Context) (bool, error)
+	Release(ctx context.Context) error
+}
+
+// CompleteFunc 把发货时间早于before的订单确认收货， 返回完成的订单数量
+type CompleteFunc func(ctx context.Context, before time.Time, limit int) (int, error)
+
+// Job 自动确认收货的定时任务
+type Job struct {
+	lease    Lease
+	complete CompleteFunc
+
+	Days      int           // 发货之后多少天自动确认收货
+	BatchSize int           // 每批处理的订单数量， 每批之间续约一次
+	Interval  time.Duration // 执行的间隔
+
+	now func() time.Time
+}
+
+func NewJob(lease Lease, complete CompleteFunc) *Job {
+	return &Job{
+		lease:     lease,
+		complete:  complete,
+		Days:      defaultDays,
+		BatchSize: defaultBatchSize,
+		Interval:  defaultInterval,
+		now:       time.Now,
+	}
+}
+
+// Run 定时执行直到ctx被取消
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := j.RunOnce(ctx); err != nil {
+			zap.S().Errorf("自动确认收货失败: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 拿到租约之后分批确认收货， 返回完成的订单数量， 没有拿到租约的时候直接返回
+func (j *Job) RunOnce(ctx context.Context) (int, error) {
+	ok, err := j.lease.Acquire(ctx)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer func() {
+		// ctx已经取消的时候也要释放， 其他实例不用等到租约过期
+		if err := j.lease.Release(context.Background()); err != nil {
+			zap.S().Warnf("释放自动确认收货的租约失败: %s", err.Error())
+		}
+	}()
+
+	before := j.now().AddDate(0, 0, -j.Days)
+	total := 0
+	for {
+		n, err := j.complete(ctx, before, j.BatchSize)
+		total += n
+		if err != nil || n < j.BatchSize || ctx.Err() != nil {
+			return total, err
+		}
+		// 订单比较多的时候， 处理完一批续约一次， 避免租约过期之后其他实例同时执行
+		if ok, err := j.lease.Extend(ctx); err != nil || !ok {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package autocomplete
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// memoryLease 内存中的租约， 多个Job共用一个的时候模拟多个实例
+type memoryLease struct {
+	held      bool
+	extendErr bool
+	acquired  int
+	released  int
+}
+
+func (l *memoryLease) Acquire(ctx context.Context) (bool, error) {
+	if l.held {
+		return false, nil
+	}
+	l.held = true
+	l.acquired++
+	return true, nil
+}
+
+func (l *memoryLease) Extend(ctx context.Context) (bool, error) {
+	return l.held && !l.extendErr, nil
+}
+
+func (l *memoryLease) Release(ctx context.Context) error {
+	l.held = false
+	l.released++
+	return nil
+}
+
+// fakeOrders 待确认收货的订单数量
+type fakeOrders struct {
+	remain  int
+	calls   int
+	befores []time.Time
+}
+
+func (o *fakeOrders) complete(ctx context.Context, before time.Time, limit int) (int, error) {
+	o.calls++
+	o.befores = append(o.befores, before)
+	n := limit
+	if o.remain < n {
+		n = o.remain
+	}
+	o.remain -= n
+	return n, nil
+}
+
+func newTestJob(lease Lease, orders *fakeOrders, now time.Time) *Job {
+	job := NewJob(lease, orders.complete)
+	job.Days = 7
+	job.BatchSize = 2
+	job.now = func() time.Time { return now }
+	return job
+}
+
+func TestRunOnceBatches(t *testing.T) {
+	now := time.Date(2021, 5, 20, 10, 0, 0, 0, time.Local)
+	lease := &memoryLease{}
+	orders := &fakeOrders{remain: 5}
+	job := newTestJob(lease, orders, now)
+
+	n, err := job.RunOnce(context.Background())
+	if err != nil || n != 5 {
+		t.Fatalf("n = %d, err = %v", n, err)
+	}
+	// 2 + 2 + 1， 最后一批不足BatchSize的时候结束
+	if orders.calls != 3 {
+		t.Errorf("calls = %d, want 3", orders.calls)
+	}
+	if want := now.AddDate(0, 0, -7); !orders.befores[0].Equal(want) {
+		t.Errorf("before = %s, want %s", orders.befores[0], want)
+	}
+	if lease.held || lease.released != 1 {
+		t.Errorf("lease not released: %+v", lease)
+	}
+}
+
+func TestRunOnceLeaseHeld(t *testing.T) {
+	lease := &memoryLease{held: true}
+	orders := &fakeOrders{remain: 5}
+	job := newTestJob(lease, orders, time.Now())
+
+	// 其他实例正在执行， 这一轮跳过
+	if n, err := job.RunOnce(context.Background()); err != nil || n != 0 {
+		t.Fatalf("n = %d, err = %v", n, err)
+	}
+	if orders.calls != 0 || !lease.held {
+		t.Errorf("calls = %d, lease = %+v", orders.calls, lease)
+	}
+}
+
+func TestRunOnceLeaseLost(t *testing.T) {
+	lease := &memoryLease{extendErr: true}
+	orders := &fakeOrders{remain: 5}
+	job := newTestJob(lease, orders, time.Now())
+
+	// 续约失败之后不再处理下一批， 剩下的订单留给拿到租约的实例
+	n, err := job.RunOnce(context.Background())
+	if err != nil || n != 2 || orders.remain != 3 {
+		t.Fatalf("n = %d, err = %v, remain = %d", n, err, orders.remain)
+	}
+}
@@ -0,0 +1,51 @@
+package autocomplete
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redsync/redsync/v4"
+)
+
+// RedsyncLease 使用redsync实现的租约
+type RedsyncLease struct {
+	mutex *redsync.Mutex
+}
+
+// NewRedsyncLease ttl是租约的有效期， 要比处理一批订单的时间长
+func NewRedsyncLease(rs *redsync.Redsync, name string, ttl time.Duration) *RedsyncLease {
+	// 只尝试一次， 其他实例正在执行的时候这一轮直接跳过
+	return &RedsyncLease{mutex: rs.NewMutex(name, redsync.WithExpiry(ttl), redsync.WithTries(1))}
+}
+
+func (l *RedsyncLease) Acquire(ctx context.Context) (bool, error) {
+	err := l.mutex.LockContext(ctx)
+	if err == redsync.ErrFailed {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *RedsyncLease) Extend(ctx context.Context) (bool, error) {
+	return l.mutex.ExtendContext(ctx)
+}
+
+func (l *RedsyncLease) Release(ctx context.Context) error {
+	_, err := l.mutex.UnlockContext(ctx)
+	return err
+}
+
+// LocalLease 单实例部署的时候使用， 总是可以拿到租约
+type LocalLease struct{}
+
+func (LocalLease) Acquire(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (LocalLease) Extend(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (LocalLease) Release(ctx context.Context) error {
+	return nil
+}
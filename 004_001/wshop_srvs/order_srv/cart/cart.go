@@ -0,0 +1,93 @@
+package cart
+
+import (
+	"context"
+	"errors"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+var ErrNotFound = errors.New("购物车记录不存在")
+
+// Owner 购物车的所有者， 登录用户使用用户id， 游客使用order-web下发的设备token
+type Owner struct {
+	User   int32
+	Device string
+}
+
+func UserOwner(user int32) Owner {
+	return Owner{User: user}
+}
+
+func GuestOwner(device string) Owner {
+	return Owner{Device: device}
+}
+
+func (o Owner) Guest() bool {
+	return o.User == 0
+}
+
+// Item 购物车中的一条记录， 游客的购物车没有持久化到数据库， ID为0
+type Item struct {
+	ID      int32       `json:"id"`
+	Goods   int32       `json:"goods"`
+	Nums    int32       `json:"nums"`
+	Checked bool        `json:"checked"`
+	Price   money.Money `json:"price"` // 加入购物车时的价格， 单位为分
+}
+
+// Store 购物车的存储， 同一个所有者的购物车中每件商品只有一条记录
+type Store interface {
+	List(ctx context.Context, owner Owner) ([]Item, error)
+	// Get 记录不存在的时候返回ErrNotFound
+	Get(ctx context.Context, owner Owner, goods int32) (Item, error)
+	// Save 按照商品新建或者覆盖购物车记录
+	Save(ctx context.Context, owner Owner, item Item) (Item, error)
+	// Delete 记录不存在的时候返回ErrNotFound
+	Delete(ctx context.Context, owner Owner, goods int32) error
+	// Invalidate 购物车在Store之外被修改了(比如下单删除了购物车记录)， 丢弃缓存
+	Invalidate(ctx context.Context, owner Owner) error
+}
+
+// Limit 返回商品最多能加入购物车的数量
+type Limit func(ctx context.Context, goods int32) (int32, error)
+
+// Merge 将游客的购物车合并到用户的购物车， 合并之后的数量不超过limit， 用户原本的数量超过了limit的不做修改
+// 每合并一件商品就从游客的购物车中删除， 中途失败之后重试不会重复合并
+func Merge(ctx context.Context, s Store, guest, user Owner, limit Limit) ([]Item, error) {
+	items, err := s.List(ctx, guest)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		max, err := limit(ctx, item.Goods)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := s.Get(ctx, user, item.Goods)
+		if err == ErrNotFound {
+			merged = Item{Goods: item.Goods, Price: item.Price}
+		} else if err != nil {
+			return nil, err
+		}
+
+		nums := merged.Nums + item.Nums
+		if nums > max {
+			nums = max
+		}
+		// 没有库存或者用户的购物车中已经足够了的商品直接丢弃
+		if nums > merged.Nums {
+			merged.Nums = nums
+			merged.Checked = merged.Checked || item.Checked
+			if _, err = s.Save(ctx, user, merged); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = s.Delete(ctx, guest, item.Goods); err != nil && err != ErrNotFound {
+			return nil, err
+		}
+	}
+	return s.List(ctx, user)
+}
@@ -0,0 +1,150 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+)
+
+type memoryStore struct {
+	carts  map[Owner]map[int32]Item
+	nextId int32
+	failOn int32 // 保存这件商品的时候失败
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{carts: map[Owner]map[int32]Item{}}
+}
+
+func (s *memoryStore) List(ctx context.Context, owner Owner) ([]Item, error) {
+	items := make([]Item, 0)
+	for _, item := range s.carts[owner] {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Goods < items[j].Goods })
+	return items, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, owner Owner, goods int32) (Item, error) {
+	item, ok := s.carts[owner][goods]
+	if !ok {
+		return Item{}, ErrNotFound
+	}
+	return item, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, owner Owner, item Item) (Item, error) {
+	if s.failOn == item.Goods {
+		return Item{}, errors.New("save failed")
+	}
+	if s.carts[owner] == nil {
+		s.carts[owner] = map[int32]Item{}
+	}
+	if old, ok := s.carts[owner][item.Goods]; ok {
+		item.ID = old.ID
+	} else if !owner.Guest() {
+		s.nextId++
+		item.ID = s.nextId
+	}
+	s.carts[owner][item.Goods] = item
+	return item, nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, owner Owner, goods int32) error {
+	if _, ok := s.carts[owner][goods]; !ok {
+		return ErrNotFound
+	}
+	delete(s.carts[owner], goods)
+	return nil
+}
+
+func (s *memoryStore) Invalidate(ctx context.Context, owner Owner) error {
+	return nil
+}
+
+func stocks(m map[int32]int32) Limit {
+	return func(ctx context.Context, goods int32) (int32, error) {
+		return m[goods], nil
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+	s := newMemoryStore()
+	guest, user := GuestOwner("device"), UserOwner(1)
+
+	_, _ = s.Save(ctx, user, Item{Goods: 1, Nums: 2})
+	_, _ = s.Save(ctx, user, Item{Goods: 2, Nums: 8})
+	_, _ = s.Save(ctx, guest, Item{Goods: 1, Nums: 3, Checked: true})
+	_, _ = s.Save(ctx, guest, Item{Goods: 2, Nums: 1})
+	_, _ = s.Save(ctx, guest, Item{Goods: 3, Nums: 10})
+	_, _ = s.Save(ctx, guest, Item{Goods: 4, Nums: 1})
+
+	items, err := Merge(ctx, s, guest, user, stocks(map[int32]int32{1: 10, 2: 5, 3: 4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Item{
+		{ID: 1, Goods: 1, Nums: 5, Checked: true}, // 合并数量和选中状态
+		{ID: 2, Goods: 2, Nums: 8},                // 用户原本的数量超过了库存， 不修改
+		{ID: 3, Goods: 3, Nums: 4},                // 合并之后的数量不超过库存
+	}
+	if len(items) != len(want) {
+		t.Fatalf("items = %+v, want %+v", items, want)
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Errorf("items[%d] = %+v, want %+v", i, items[i], want[i])
+		}
+	}
+
+	// 没有库存的商品被丢弃， 游客的购物车被清空
+	if guestItems, _ := s.List(ctx, guest); len(guestItems) != 0 {
+		t.Errorf("guest cart = %+v, want empty", guestItems)
+	}
+}
+
+func TestMergeRetry(t *testing.T) {
+	ctx := context.Background()
+	s := newMemoryStore()
+	guest, user := GuestOwner("device"), UserOwner(1)
+	limit := stocks(map[int32]int32{1: 10, 2: 10})
+
+	_, _ = s.Save(ctx, guest, Item{Goods: 1, Nums: 3})
+	_, _ = s.Save(ctx, guest, Item{Goods: 2, Nums: 3})
+
+	s.failOn = 2
+	if _, err := Merge(ctx, s, guest, user, limit); err == nil {
+		t.Fatal("merge should fail")
+	}
+
+	s.failOn = 0
+	items, err := Merge(ctx, s, guest, user, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 第一次已经合并的商品重试的时候不会重复合并
+	if len(items) != 2 || items[0].Nums != 3 || items[1].Nums != 3 {
+		t.Errorf("items = %+v", items)
+	}
+}
+
+func TestMergeLimitError(t *testing.T) {
+	ctx := context.Background()
+	s := newMemoryStore()
+	guest, user := GuestOwner("device"), UserOwner(1)
+	_, _ = s.Save(ctx, guest, Item{Goods: 1, Nums: 3})
+
+	limit := func(ctx context.Context, goods int32) (int32, error) {
+		return 0, errors.New("inventory unavailable")
+	}
+	if _, err := Merge(ctx, s, guest, user, limit); err == nil {
+		t.Fatal("merge should fail")
+	}
+	// 查询库存失败的时候游客的购物车保留
+	if item, err := s.Get(ctx, guest, 1); err != nil || item.Nums != 3 {
+		t.Errorf("guest item = %+v, %v", item, err)
+	}
+}
@@ -0,0 +1,94 @@
+package cart
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormStore 购物车保存在shoppingcart表中， 游客的记录user为0， 使用device区分
+type GormStore struct {
+	DB *gorm.DB
+}
+
+func (s *GormStore) owned(ctx context.Context, owner Owner) *gorm.DB {
+	db := s.DB.WithContext(ctx).Model(&model.ShoppingCart{})
+	if owner.Guest() {
+		return db.Where("user = 0 and device = ?", owner.Device)
+	}
+	return db.Where("user = ?", owner.User)
+}
+
+func (s *GormStore) List(ctx context.Context, owner Owner) ([]Item, error) {
+	var shopCarts []model.ShoppingCart
+	if result := s.owned(ctx, owner).Find(&shopCarts); result.Error != nil {
+		return nil, result.Error
+	}
+
+	items := make([]Item, 0, len(shopCarts))
+	for _, shopCart := range shopCarts {
+		items = append(items, toItem(shopCart))
+	}
+	return items, nil
+}
+
+func (s *GormStore) Get(ctx context.Context, owner Owner, goods int32) (Item, error) {
+	var shopCart model.ShoppingCart
+	result := s.owned(ctx, owner).Where("goods = ?", goods).Limit(1).Find(&shopCart)
+	if result.Error != nil {
+		return Item{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return Item{}, ErrNotFound
+	}
+	return toItem(shopCart), nil
+}
+
+func (s *GormStore) Save(ctx context.Context, owner Owner, item Item) (Item, error) {
+	var shopCart model.ShoppingCart
+	result := s.owned(ctx, owner).Where("goods = ?", item.Goods).Limit(1).Find(&shopCart)
+	if result.Error != nil {
+		return Item{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		shopCart.User = owner.User
+		shopCart.Device = owner.Device
+		shopCart.Goods = item.Goods
+	}
+	shopCart.Nums = item.Nums
+	shopCart.Checked = item.Checked
+	shopCart.Price = item.Price
+
+	if result = s.DB.WithContext(ctx).Save(&shopCart); result.Error != nil {
+		return Item{}, result.Error
+	}
+	return toItem(shopCart), nil
+}
+
+func (s *GormStore) Delete(ctx context.Context, owner Owner, goods int32) error {
+	result := s.owned(ctx, owner).Where("goods = ?", goods).Delete(&model.ShoppingCart{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Invalidate 数据库中的记录就是最新的， 不需要处理
+func (s *GormStore) Invalidate(ctx context.Context, owner Owner) error {
+	return nil
+}
+
+func toItem(shopCart model.ShoppingCart) Item {
+	return Item{
+		ID:      shopCart.ID,
+		Goods:   shopCart.Goods,
+		Nums:    shopCart.Nums,
+		Checked: shopCart.Checked,
+		Price:   shopCart.Price,
+	}
+}
@@ -0,0 +1,217 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// 用户购物车的hash中表示已经从数据库加载过的字段， 空的购物车也会缓存下来
+const loadedField = "_loaded"
+
+// 缓存为空并且版本号没有变化的时候才写入， 版本号变化说明加载期间购物车被修改了， 加载的数据可能是旧的
+var loadScript = redis.NewScript(`
+local v = redis.call('get', KEYS[2]) or ''
+if v ~= ARGV[1] or redis.call('exists', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('hset', KEYS[1], unpack(ARGV, 3))
+redis.call('pexpire', KEYS[1], ARGV[2])
+return 1
+`)
+
+// 数据库写入之后更新缓存， 先增加版本号让正在进行的加载失效， 缓存没有加载的时候不需要写入
+var writeScript = redis.NewScript(`
+redis.call('incr', KEYS[2])
+redis.call('pexpire', KEYS[2], ARGV[1])
+if ARGV[2] == 'drop' then
+	redis.call('del', KEYS[1])
+	return 1
+end
+if redis.call('hexists', KEYS[1], '` + loadedField + `') == 0 then
+	return 0
+end
+if ARGV[2] == 'set' then
+	redis.call('hset', KEYS[1], ARGV[3], ARGV[4])
+else
+	redis.call('hdel', KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// RedisStore 购物车保存在redis的hash中， 每件商品是hash中的一个字段
+// 游客的购物车只保存在redis中， 一段时间不操作之后过期
+// 登录用户的购物车同步写入DB(下单的时候从数据库中读取购物车)， redis只作为缓存， 读取的时候不存在从DB加载
+type RedisStore struct {
+	Client *redis.Client
+	DB     Store
+
+	GuestTTL time.Duration // 游客购物车的过期时间
+	UserTTL  time.Duration // 用户购物车缓存的过期时间
+}
+
+func NewRedisStore(client *redis.Client, db Store) *RedisStore {
+	return &RedisStore{
+		Client:   client,
+		DB:       db,
+		GuestTTL: 30 * 24 * time.Hour,
+		UserTTL:  time.Hour,
+	}
+}
+
+func (s *RedisStore) key(owner Owner) string {
+	if owner.Guest() {
+		return fmt.Sprintf("cart:guest:%s", owner.Device)
+	}
+	return fmt.Sprintf("cart:user:%d", owner.User)
+}
+
+func (s *RedisStore) versionKey(owner Owner) string {
+	return fmt.Sprintf("cart:user:%d:version", owner.User)
+}
+
+func (s *RedisStore) List(ctx context.Context, owner Owner) ([]Item, error) {
+	fields, err := s.Client.HGetAll(ctx, s.key(owner)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 && !owner.Guest() {
+		return s.load(ctx, owner)
+	}
+
+	items := make([]Item, 0, len(fields))
+	for field, value := range fields {
+		if field == loadedField {
+			continue
+		}
+		var item Item
+		if err := json.Unmarshal([]byte(value), &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].ID != items[j].ID {
+			return items[i].ID < items[j].ID
+		}
+		return items[i].Goods < items[j].Goods
+	})
+	return items, nil
+}
+
+// load 从数据库加载用户的购物车并写入缓存
+func (s *RedisStore) load(ctx context.Context, owner Owner) ([]Item, error) {
+	version, err := s.Client.Get(ctx, s.versionKey(owner)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	items, err := s.DB.List(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{version, s.UserTTL.Milliseconds(), loadedField, 1}
+	for _, item := range items {
+		value, _ := json.Marshal(item)
+		args = append(args, strconv.Itoa(int(item.Goods)), value)
+	}
+	if err := loadScript.Run(ctx, s.Client, []string{s.key(owner), s.versionKey(owner)}, args...).Err(); err != nil {
+		// 缓存写入失败不影响读取
+		zap.S().Errorf("缓存用户%d的购物车失败: %s", owner.User, err.Error())
+	}
+	return items, nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, owner Owner, goods int32) (Item, error) {
+	if owner.Guest() {
+		value, err := s.Client.HGet(ctx, s.key(owner), strconv.Itoa(int(goods))).Result()
+		if err == redis.Nil {
+			return Item{}, ErrNotFound
+		} else if err != nil {
+			return Item{}, err
+		}
+		var item Item
+		err = json.Unmarshal([]byte(value), &item)
+		return item, err
+	}
+
+	items, err := s.List(ctx, owner)
+	if err != nil {
+		return Item{}, err
+	}
+	for _, item := range items {
+		if item.Goods == goods {
+			return item, nil
+		}
+	}
+	return Item{}, ErrNotFound
+}
+
+func (s *RedisStore) Save(ctx context.Context, owner Owner, item Item) (Item, error) {
+	if owner.Guest() {
+		value, _ := json.Marshal(item)
+		_, err := s.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, s.key(owner), strconv.Itoa(int(item.Goods)), value)
+			pipe.Expire(ctx, s.key(owner), s.GuestTTL)
+			return nil
+		})
+		if err != nil {
+			return Item{}, err
+		}
+		return item, nil
+	}
+
+	saved, err := s.DB.Save(ctx, owner, item)
+	if err != nil {
+		return Item{}, err
+	}
+	value, _ := json.Marshal(saved)
+	s.write(ctx, owner, "set", strconv.Itoa(int(saved.Goods)), value)
+	return saved, nil
+}
+
+func (s *RedisStore) Delete(ctx context.Context, owner Owner, goods int32) error {
+	if owner.Guest() {
+		n, err := s.Client.HDel(ctx, s.key(owner), strconv.Itoa(int(goods))).Result()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrNotFound
+		}
+		return nil
+	}
+
+	if err := s.DB.Delete(ctx, owner, goods); err != nil {
+		return err
+	}
+	s.write(ctx, owner, "del", strconv.Itoa(int(goods)), "")
+	return nil
+}
+
+// Invalidate 游客的购物车只在redis中， 不能丢弃
+func (s *RedisStore) Invalidate(ctx context.Context, owner Owner) error {
+	if owner.Guest() {
+		return nil
+	}
+	return s.write(ctx, owner, "drop", "", "")
+}
+
+// write 数据库写入成功之后同步修改缓存， 缓存修改失败的时候删除缓存， 下次读取重新加载
+func (s *RedisStore) write(ctx context.Context, owner Owner, op string, field string, value interface{}) error {
+	keys := []string{s.key(owner), s.versionKey(owner)}
+	err := writeScript.Run(ctx, s.Client, keys, s.UserTTL.Milliseconds(), op, field, value).Err()
+	if err != nil {
+		zap.S().Errorf("更新用户%d的购物车缓存失败: %s", owner.User, err.Error())
+		if delErr := s.Client.Del(ctx, s.key(owner)).Err(); delErr != nil {
+			zap.S().Errorf("删除用户%d的购物车缓存失败: %s", owner.User, delErr.Error())
+		}
+	}
+	return err
+}
@@ -6,6 +6,88 @@ type GoodsSrvConfig struct {
 type InventorySrvConfig struct {
 	Name string `mapstructure:"name" json:"name"`
 }
+type PromotionSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
+type AlipayConfig struct {
+	AppID        string `mapstructure:"app_id" json:"app_id"`
+	PrivateKey   string `mapstructure:"private_key" json:"private_key"`
+	AliPublicKey string `mapstructure:"ali_public_key" json:"ali_public_key"`
+	IsProduction bool   `mapstructure:"is_production" json:"is_production"`
+}
+
+type WechatPayConfig struct {
+	AppID        string `mapstructure:"app_id" json:"app_id"` // 收款的应用， 校验支付通知
+	MchID        string `mapstructure:"mch_id" json:"mch_id"`
+	SerialNo     string `mapstructure:"serial_no" json:"serial_no"`         // 商户API证书的序列号
+	PrivateKey   string `mapstructure:"private_key" json:"private_key"`     // 商户API证书的私钥
+	PlatformCert string `mapstructure:"platform_cert" json:"platform_cert"` // 微信支付平台证书， 验证应答的签名
+}
+
+type PaymentConfig struct {
+	Mock          bool            `mapstructure:"mock" json:"mock"`             // 开发环境开启模拟支付， 和order-web的mock_pay一起配置， 不要在线上环境开启
+	AliPayInfo    AlipayConfig    `mapstructure:"alipay" json:"alipay"`         // 没有配置应用的时候不支持支付宝支付的订单
+	WechatPayInfo WechatPayConfig `mapstructure:"wechat_pay" json:"wechat_pay"` // 没有配置商户号的时候不支持微信支付的订单
+}
+
+type LogisticsConfig struct {
+	Adapter  string   `mapstructure:"adapter" json:"adapter"`   // mock(模拟轨迹), file(从本地文件读取轨迹)
+	FileDir  string   `mapstructure:"file_dir" json:"file_dir"` // file适配器读取轨迹的目录
+	Carriers []string `mapstructure:"carriers" json:"carriers"` // 支持的快递公司编码
+}
+
+type MQConfig struct {
+	Adapter string `mapstructure:"adapter" json:"adapter"` // rocketmq(默认), memory(进程内的实现， 只能在单个进程中调试)
+	Host    string `mapstructure:"host" json:"host"`       // rocketmq name server的地址
+	Port    int    `mapstructure:"port" json:"port"`
+}
+
+type WatchConfig struct {
+	Interval int `mapstructure:"interval" json:"interval"` // 读取订单状态流水的间隔， 单位毫秒
+}
+
+type OutboxConfig struct {
+	BatchSize   int    `mapstructure:"batch_size" json:"batch_size"`     // 每次发送的消息数量
+	Interval    int    `mapstructure:"interval" json:"interval"`         // 轮询的间隔， 单位毫秒
+	MetricsAddr string `mapstructure:"metrics_addr" json:"metrics_addr"` // 监控指标的地址， 配置之后可以通过/debug/vars查看outbox的积压
+}
+
+type RedisConfig struct {
+	Host string `mapstructure:"host" json:"host"`
+	Port int    `mapstructure:"port" json:"port"`
+}
+
+type CartConfig struct {
+	Adapter  string      `mapstructure:"adapter" json:"adapter"`     // gorm(默认， 游客的购物车也保存在数据库), redis(redis缓存， 用户的购物车同步写入数据库)
+	GuestTTL int         `mapstructure:"guest_ttl" json:"guest_ttl"` // 游客购物车的过期时间， 单位小时
+	UserTTL  int         `mapstructure:"user_ttl" json:"user_ttl"`   // 用户购物车缓存的过期时间， 单位分钟
+	Redis    RedisConfig `mapstructure:"redis" json:"redis"`
+}
+
+type AutoCompleteConfig struct {
+	Days      int         `mapstructure:"days" json:"days"`             // 发货之后多少天自动确认收货， 默认10天
+	Interval  int         `mapstructure:"interval" json:"interval"`     // 执行的间隔， 单位分钟
+	BatchSize int         `mapstructure:"batch_size" json:"batch_size"` // 每批处理的订单数量
+	LeaseTTL  int         `mapstructure:"lease_ttl" json:"lease_ttl"`   // 多个实例之间互斥的租约有效期， 单位秒
+	Redis     RedisConfig `mapstructure:"redis" json:"redis"`
+}
+
+type CategoryPayTimeoutConfig struct {
+	CategoryId int32 `mapstructure:"category_id" json:"category_id"`
+	Timeout    int   `mapstructure:"timeout" json:"timeout"` // 单位分钟
+}
+
+type PayTimeoutConfig struct {
+	Default    int                        `mapstructure:"default" json:"default"`       // 下单之后多少分钟没有支付自动关闭， 默认30分钟
+	Categories []CategoryPayTimeoutConfig `mapstructure:"categories" json:"categories"` // 秒杀等分类的商品可以配置更短的时间， 订单中有多个分类的商品时使用最短的
+}
+
+type OrderSnConfig struct {
+	Mode     string `mapstructure:"mode" json:"mode"`           // snowflake(默认， 数据库号段作为备用), segment(只使用数据库号段)
+	WorkerId *int64 `mapstructure:"worker_id" json:"worker_id"` // snowflake的worker id， 不配置的时候从consul分配
+	Step     int64  `mapstructure:"step" json:"step"`           // 号段模式每次申请的号段长度
+}
 
 type MysqlConfig struct {
 	Host     string `mapstructure:"host" json:"host"`
@@ -31,6 +113,29 @@ type ServerConfig struct {
 	GoodsSrvInfo GoodsSrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
 	// 库存微服务的配置
 	InventorySrvInfo InventorySrvConfig `mapstructure:"inventory_srv" json:"inventory_srv"`
+	// 营销微服务的配置， 下单使用优惠券
+	PromotionSrvInfo PromotionSrvConfig `mapstructure:"promotion_srv" json:"promotion_srv"`
+
+	// 支付渠道的配置， 售后退款的时候使用
+	PaymentInfo PaymentConfig `mapstructure:"payment" json:"payment"`
+	// 物流的配置
+	LogisticsInfo LogisticsConfig `mapstructure:"logistics" json:"logistics"`
+	// 订单号生成的配置
+	OrderSnInfo OrderSnConfig `mapstructure:"order_sn" json:"order_sn"`
+	// 消息队列的配置
+	MQInfo MQConfig `mapstructure:"mq" json:"mq"`
+	// 本地消息表的配置
+	OutboxInfo OutboxConfig `mapstructure:"outbox" json:"outbox"`
+	// 购物车存储的配置
+	CartInfo CartConfig `mapstructure:"cart" json:"cart"`
+	// 订单支付时间的配置， 超过之后订单自动关闭
+	PayTimeoutInfo PayTimeoutConfig `mapstructure:"pay_timeout" json:"pay_timeout"`
+	// 自动确认收货的配置
+	AutoCompleteInfo AutoCompleteConfig `mapstructure:"auto_complete" json:"auto_complete"`
+	// 订单状态推送的配置
+	WatchInfo WatchConfig `mapstructure:"watch" json:"watch"`
+	// 下单的方式: saga(默认), message(事务消息), tcc
+	OrderFlow string `mapstructure:"order_flow" json:"order_flow"`
 }
 
 type NacosConfig struct {
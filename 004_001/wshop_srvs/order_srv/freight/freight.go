@@ -0,0 +1,90 @@
+// Package freight 按照运费模板计算订单的运费
+// 收货地址先匹配城市， 再匹配省份， 都没有配置的时候使用模板的默认地区
+// 包邮的商品不计入件数和重量， 商品金额达到模板的包邮门槛的时候整单包邮
+package freight
+
+import (
+	"errors"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+var ErrNotDeliverable = errors.New("收货地址不在配送范围内")
+
+// Item 订单中的一种商品
+type Item struct {
+	Nums     int32
+	Weight   int32 // 单件的重量， 单位为克
+	ShipFree bool
+}
+
+// MatchRule 按照城市、省份、默认地区的顺序查找收货地址使用的规则
+func MatchRule(rules []model.FreightRule, province, city string) *model.FreightRule {
+	var provinceRule, defaultRule *model.FreightRule
+	for i := range rules {
+		rule := &rules[i]
+		switch {
+		case rule.Province == "":
+			defaultRule = rule
+		case rule.Province != province:
+		case rule.City == "":
+			provinceRule = rule
+		case rule.City == city:
+			return rule
+		}
+	}
+	if provinceRule != nil {
+		return provinceRule
+	}
+	return defaultRule
+}
+
+// Calculate 计算运费， amount是订单的商品金额， 用来判断是否达到包邮门槛
+// 模板为空表示没有配置运费模板， 不收取运费
+func Calculate(t *model.FreightTemplate, province, city string, amount money.Money, items []Item) (money.Money, error) {
+	if t == nil {
+		return 0, nil
+	}
+
+	var units int32
+	for _, item := range items {
+		if item.ShipFree {
+			continue
+		}
+		if t.ChargeType == model.FREIGHT_BY_WEIGHT {
+			units += item.Weight * item.Nums
+		} else {
+			units += item.Nums
+		}
+	}
+	// 所有的商品都包邮
+	if units == 0 && allShipFree(items) {
+		return 0, nil
+	}
+
+	rule := MatchRule(t.Rules, province, city)
+	if rule == nil {
+		return 0, ErrNotDeliverable
+	}
+	if t.FreeThreshold > 0 && amount >= t.FreeThreshold {
+		return 0, nil
+	}
+
+	fee := rule.FirstFee
+	if rule.AdditionalUnit > 0 && units > rule.FirstUnit {
+		// 不足一个续件单位的部分按一个单位计算
+		times := (units - rule.FirstUnit + rule.AdditionalUnit - 1) / rule.AdditionalUnit
+		fee = fee.Add(rule.AdditionalFee.Mul(times))
+	}
+	return fee, nil
+}
+
+func allShipFree(items []Item) bool {
+	for _, item := range items {
+		if !item.ShipFree {
+			return false
+		}
+	}
+	return true
+}
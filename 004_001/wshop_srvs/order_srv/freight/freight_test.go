@@ -0,0 +1,99 @@
+package freight
+
+import (
+	"testing"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+func newTemplate(chargeType int32, threshold money.Money) *model.FreightTemplate {
+	return &model.FreightTemplate{
+		ChargeType:    chargeType,
+		FreeThreshold: threshold,
+		Rules: []model.FreightRule{
+			{FirstUnit: 1, FirstFee: 1000, AdditionalUnit: 1, AdditionalFee: 500},
+			{Province: "浙江", FirstUnit: 1, FirstFee: 600, AdditionalUnit: 2, AdditionalFee: 200},
+			{Province: "浙江", City: "杭州", FirstUnit: 1, FirstFee: 0},
+			{Province: "新疆", City: "", FirstUnit: 1000, FirstFee: 2000, AdditionalUnit: 500, AdditionalFee: 800},
+		},
+	}
+}
+
+func TestMatchRule(t *testing.T) {
+	rules := newTemplate(model.FREIGHT_BY_PIECE, 0).Rules
+	cases := []struct {
+		province, city string
+		want           money.Money
+	}{
+		{"浙江", "杭州", 0},
+		{"浙江", "宁波", 600},
+		{"上海", "上海", 1000},
+	}
+	for _, c := range cases {
+		rule := MatchRule(rules, c.province, c.city)
+		if rule == nil || rule.FirstFee != c.want {
+			t.Errorf("%s%s: rule = %+v", c.province, c.city, rule)
+		}
+	}
+
+	if rule := MatchRule(rules[1:], "上海", "上海"); rule != nil {
+		t.Errorf("no default rule: rule = %+v", rule)
+	}
+}
+
+func TestCalculateByPiece(t *testing.T) {
+	tpl := newTemplate(model.FREIGHT_BY_PIECE, 0)
+	items := []Item{{Nums: 2}, {Nums: 2}, {Nums: 5, ShipFree: true}}
+
+	cases := []struct {
+		province, city string
+		want           money.Money
+	}{
+		{"上海", "上海", 1000 + 3*500},
+		{"浙江", "宁波", 600 + 2*200}, // 续3件， 每2件一个单位， 不足一个单位按一个计算
+		{"浙江", "杭州", 0},
+	}
+	for _, c := range cases {
+		fee, err := Calculate(tpl, c.province, c.city, 10000, items)
+		if err != nil || fee != c.want {
+			t.Errorf("%s%s: fee = %d, err = %v, want %d", c.province, c.city, fee, err, c.want)
+		}
+	}
+}
+
+func TestCalculateByWeight(t *testing.T) {
+	tpl := newTemplate(model.FREIGHT_BY_WEIGHT, 0)
+	// 2 * 600g + 100g = 1300g， 首重1000g， 续重300g按一个500g计算
+	items := []Item{{Nums: 2, Weight: 600}, {Nums: 1, Weight: 100}}
+	fee, err := Calculate(tpl, "新疆", "乌鲁木齐", 10000, items)
+	if err != nil || fee != 2000+800 {
+		t.Errorf("fee = %d, err = %v", fee, err)
+	}
+}
+
+func TestCalculateFree(t *testing.T) {
+	tpl := newTemplate(model.FREIGHT_BY_PIECE, 9900)
+	items := []Item{{Nums: 1}}
+
+	if fee, _ := Calculate(tpl, "上海", "上海", 9900, items); fee != 0 {
+		t.Errorf("reach threshold: fee = %d", fee)
+	}
+	if fee, _ := Calculate(tpl, "上海", "上海", 9899, items); fee != 1000 {
+		t.Errorf("below threshold: fee = %d", fee)
+	}
+	if fee, _ := Calculate(tpl, "上海", "上海", 100, []Item{{Nums: 3, ShipFree: true}}); fee != 0 {
+		t.Errorf("ship free goods: fee = %d", fee)
+	}
+	if fee, _ := Calculate(nil, "上海", "上海", 100, items); fee != 0 {
+		t.Errorf("no template: fee = %d", fee)
+	}
+}
+
+func TestCalculateNotDeliverable(t *testing.T) {
+	tpl := newTemplate(model.FREIGHT_BY_PIECE, 0)
+	tpl.Rules = tpl.Rules[1:]
+	if _, err := Calculate(tpl, "上海", "上海", 100, []Item{{Nums: 1}}); err != ErrNotDeliverable {
+		t.Errorf("err = %v, want %v", err, ErrNotDeliverable)
+	}
+}
@@ -2,8 +2,16 @@ package global
 
 import (
 	"gorm.io/gorm"
+	"wshop_srvs/order_srv/cart"
 	"wshop_srvs/order_srv/config"
+	"wshop_srvs/order_srv/idempotency"
+	"wshop_srvs/order_srv/mq"
+	"wshop_srvs/order_srv/ordersn"
+	"wshop_srvs/order_srv/outbox"
 	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/saga"
+	"wshop_srvs/order_srv/tcc"
+	"wshop_srvs/order_srv/watch"
 )
 
 var (
@@ -13,6 +21,23 @@ var (
 
 	GoodsSrvClient     proto.GoodsClient
 	InventorySrvClient proto.InventoryClient
+	PromotionSrvClient proto.PromotionClient
+
+	OrderSnGenerator ordersn.Generator
+
+	CartStore cart.Store
+
+	MQBroker   mq.Broker
+	MQProducer mq.DelayProducer
+
+	OutboxRelay *outbox.Relay
+
+	TccCoordinator   *tcc.Coordinator
+	SagaOrchestrator *saga.Orchestrator
+
+	IdempotencyGuard *idempotency.Guard
+
+	OrderWatcher *watch.Hub
 )
 
 // func init() {
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/freight"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+func freightTemplateToResponse(t model.FreightTemplate) *proto.FreightTemplateInfoResponse {
+	rsp := &proto.FreightTemplateInfoResponse{
+		Id:                 t.ID,
+		Name:               t.Name,
+		ChargeType:         t.ChargeType,
+		FreeThresholdCents: t.FreeThreshold.Cents(),
+		IsDefault:          t.IsDefault,
+	}
+	for _, rule := range t.Rules {
+		rsp.Rules = append(rsp.Rules, &proto.FreightRuleInfo{
+			Id:                 rule.ID,
+			Province:           rule.Province,
+			City:               rule.City,
+			FirstUnit:          rule.FirstUnit,
+			FirstFeeCents:      rule.FirstFee.Cents(),
+			AdditionalUnit:     rule.AdditionalUnit,
+			AdditionalFeeCents: rule.AdditionalFee.Cents(),
+		})
+	}
+	return rsp
+}
+
+// freightRules 校验请求中的地区规则， 同一个地区只能配置一次
+func freightRules(req *proto.FreightTemplateRequest) ([]model.FreightRule, error) {
+	if req.ChargeType != model.FREIGHT_BY_PIECE && req.ChargeType != model.FREIGHT_BY_WEIGHT {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的计费方式")
+	}
+	if req.FreeThresholdCents < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "包邮门槛不能小于0")
+	}
+	if len(req.Rules) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "至少需要一条地区规则")
+	}
+
+	var rules []model.FreightRule
+	regions := make(map[string]bool)
+	for _, rule := range req.Rules {
+		if rule.Province == "" && rule.City != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "城市规则需要指定省份")
+		}
+		if rule.FirstUnit < 0 || rule.AdditionalUnit < 0 || rule.FirstFeeCents < 0 || rule.AdditionalFeeCents < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "运费和计费单位不能小于0")
+		}
+		region := rule.Province + "/" + rule.City
+		if regions[region] {
+			return nil, status.Errorf(codes.InvalidArgument, "地区规则重复: %s%s", rule.Province, rule.City)
+		}
+		regions[region] = true
+
+		rules = append(rules, model.FreightRule{
+			Province:       rule.Province,
+			City:           rule.City,
+			FirstUnit:      rule.FirstUnit,
+			FirstFee:       money.FromCents(rule.FirstFeeCents),
+			AdditionalUnit: rule.AdditionalUnit,
+			AdditionalFee:  money.FromCents(rule.AdditionalFeeCents),
+		})
+	}
+	return rules, nil
+}
+
+// saveFreightTemplate 保存模板和地区规则， 设置成默认模板的时候取消其他模板的默认
+func saveFreightTemplate(tx *gorm.DB, t *model.FreightTemplate, rules []model.FreightRule) error {
+	if t.IsDefault {
+		if result := tx.Model(&model.FreightTemplate{}).Where("id <> ? and is_default = ?", t.ID, true).Update("is_default", false); result.Error != nil {
+			return result.Error
+		}
+	}
+	if result := tx.Omit("Rules").Save(t); result.Error != nil {
+		return result.Error
+	}
+	if result := tx.Unscoped().Where(&model.FreightRule{Template: t.ID}).Delete(&model.FreightRule{}); result.Error != nil {
+		return result.Error
+	}
+	for i := range rules {
+		rules[i].Template = t.ID
+	}
+	if result := tx.Create(&rules); result.Error != nil {
+		return result.Error
+	}
+	t.Rules = rules
+	return nil
+}
+
+func (*OrderServer) FreightTemplateList(ctx context.Context, req *emptypb.Empty) (*proto.FreightTemplateListResponse, error) {
+	var templates []model.FreightTemplate
+	if result := global.DB.Preload("Rules").Order("id").Find(&templates); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询运费模板失败")
+	}
+
+	rsp := proto.FreightTemplateListResponse{Total: int32(len(templates))}
+	for _, t := range templates {
+		rsp.Data = append(rsp.Data, freightTemplateToResponse(t))
+	}
+	return &rsp, nil
+}
+
+func (*OrderServer) CreateFreightTemplate(ctx context.Context, req *proto.FreightTemplateRequest) (*proto.FreightTemplateInfoResponse, error) {
+	rules, err := freightRules(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t := model.FreightTemplate{
+		Name:          req.Name,
+		ChargeType:    req.ChargeType,
+		FreeThreshold: money.FromCents(req.FreeThresholdCents),
+		IsDefault:     req.IsDefault,
+	}
+	tx := global.DB.Begin()
+	if err := saveFreightTemplate(tx, &t, rules); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存运费模板失败")
+	}
+	tx.Commit()
+	return freightTemplateToResponse(t), nil
+}
+
+func (*OrderServer) UpdateFreightTemplate(ctx context.Context, req *proto.FreightTemplateRequest) (*emptypb.Empty, error) {
+	rules, err := freightRules(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var t model.FreightTemplate
+	if result := global.DB.First(&t, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "运费模板不存在")
+	}
+	t.Name = req.Name
+	t.ChargeType = req.ChargeType
+	t.FreeThreshold = money.FromCents(req.FreeThresholdCents)
+	t.IsDefault = req.IsDefault
+
+	tx := global.DB.Begin()
+	if err := saveFreightTemplate(tx, &t, rules); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存运费模板失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
+
+func (*OrderServer) DeleteFreightTemplate(ctx context.Context, req *proto.FreightTemplateRequest) (*emptypb.Empty, error) {
+	tx := global.DB.Begin()
+	if result := tx.Delete(&model.FreightTemplate{}, req.Id); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "运费模板不存在")
+	}
+	if result := tx.Where(&model.FreightRule{Template: req.Id}).Delete(&model.FreightRule{}); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "删除运费模板失败")
+	}
+	tx.Commit()
+	return &emptypb.Empty{}, nil
+}
+
+// defaultFreightTemplate 下单使用的默认运费模板， 没有配置的时候返回nil， 不收取运费
+func defaultFreightTemplate(ctx context.Context) (*model.FreightTemplate, error) {
+	var templates []model.FreightTemplate
+	if result := global.DB.WithContext(ctx).Preload("Rules").Where("is_default = ?", true).Limit(1).Find(&templates); result.Error != nil {
+		return nil, result.Error
+	}
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	return &templates[0], nil
+}
+
+// orderFreight 按照运费模板计算订单的运费， 包邮的商品不计入件数和重量
+// goodsNums是每个商品购买的数量， amount是商品金额， 用来判断是否满足包邮门槛
+func orderFreight(t *model.FreightTemplate, province, city string, amount money.Money, goods []*proto.GoodsInfoResponse, goodsNums map[int32]int32) (money.Money, error) {
+	var items []freight.Item
+	for _, good := range goods {
+		items = append(items, freight.Item{
+			Nums:     goodsNums[good.Id],
+			Weight:   good.Weight,
+			ShipFree: good.ShipFree,
+		})
+	}
+	fee, err := freight.Calculate(t, province, city, amount, items)
+	if err == freight.ErrNotDeliverable {
+		return 0, status.Errorf(codes.FailedPrecondition, "收货地址不在配送范围内")
+	}
+	return fee, err
+}
@@ -4,119 +4,192 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2"
-	"github.com/apache/rocketmq-client-go/v2/consumer"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
-	"github.com/apache/rocketmq-client-go/v2/producer"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
-	"math/rand"
+	"gorm.io/gorm"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"wshop_srvs/order_srv/cart"
 	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/idempotency"
 	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/mq"
+	"wshop_srvs/order_srv/outbox"
 	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/tcc"
+	"wshop_srvs/order_srv/utils/money"
 )
 
 type OrderServer struct {
 	proto.UnimplementedOrderServer
 }
 
-func GenerateOrderSn(userId int32) string {
-	// 订单号的生成规则
-	/*
-		年月日时分秒+用户id+2位随机数
-	*/
-	now := time.Now()
-	rand.Seed(time.Now().UnixNano())
-	orderSn := fmt.Sprintf("%d%d%d%d%d%d%d%d",
-		now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Nanosecond(),
-		userId, rand.Intn(90)+10,
-	)
-	return orderSn
+// cartOwner 登录用户使用用户id， 没有登录的时候使用游客的设备token
+func cartOwner(userId int32, deviceToken string) (cart.Owner, error) {
+	if userId > 0 {
+		return cart.UserOwner(userId), nil
+	}
+	if deviceToken == "" {
+		return cart.Owner{}, status.Errorf(codes.InvalidArgument, "用户和设备token不能都为空")
+	}
+	return cart.GuestOwner(deviceToken), nil
+}
+
+func cartItemList(owner cart.Owner, items []cart.Item) *proto.CartItemListResponse {
+	rsp := proto.CartItemListResponse{Total: int32(len(items))}
+	for _, item := range items {
+		rsp.Data = append(rsp.Data, &proto.ShopCartInfoResponse{
+			Id:      item.ID,
+			UserId:  owner.User,
+			GoodsId: item.Goods,
+			Nums:    item.Nums,
+			Checked: item.Checked,
+		})
+	}
+	return &rsp
 }
 
 func (*OrderServer) CartItemList(ctx context.Context, req *proto.UserInfo) (*proto.CartItemListResponse, error) {
 	// 获取用户的购物车列表
-	var shopCarts []model.ShoppingCart
-	var rsp proto.CartItemListResponse
-
-	if result := global.DB.Where(&model.ShoppingCart{User: req.Id}).Find(&shopCarts); result.Error != nil {
-		return nil, result.Error
-	} else {
-		rsp.Total = int32(result.RowsAffected)
+	owner, err := cartOwner(req.Id, req.DeviceToken)
+	if err != nil {
+		return nil, err
 	}
-
-	for _, shopCart := range shopCarts {
-		rsp.Data = append(rsp.Data, &proto.ShopCartInfoResponse{
-			Id:      shopCart.ID,
-			UserId:  shopCart.User,
-			GoodsId: shopCart.Goods,
-			Nums:    shopCart.Nums,
-			Checked: shopCart.Checked,
-		})
+	items, err := global.CartStore.List(ctx, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
 	}
-	return &rsp, nil
+	return cartItemList(owner, items), nil
 }
 
 func (*OrderServer) CreateCartItem(ctx context.Context, req *proto.CartItemRequest) (*proto.ShopCartInfoResponse, error) {
 	// 将商品添加到购物车 1. 购物车中原本没有这件商品 - 新建一个记录 2. 这个商品之前添加到了购物车- 合并
-	var shopCart model.ShoppingCart
+	owner, err := cartOwner(req.UserId, req.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
 
-	if result := global.DB.Where(&model.ShoppingCart{Goods: req.GoodsId, User: req.UserId}).First(&shopCart); result.RowsAffected == 1 {
+	item, err := global.CartStore.Get(ctx, owner, req.GoodsId)
+	if err == nil {
 		// 如果记录已经存在，则合并购物车记录, 更新操作
-		shopCart.Nums += req.Nums
-	} else {
+		item.Nums += req.Nums
+	} else if err == cart.ErrNotFound {
 		// 插入操作
-		shopCart.User = req.UserId
-		shopCart.Goods = req.GoodsId
-		shopCart.Nums = req.Nums
-		shopCart.Checked = false
+		item = cart.Item{Goods: req.GoodsId, Nums: req.Nums}
+	} else {
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	// 记录用户最近一次加入购物车时看到的价格， 结算的时候提示价格变化
+	if price := money.FromProto(req.GoodsPriceCents, req.GoodsPrice); price > 0 {
+		item.Price = price
 	}
 
-	global.DB.Save(&shopCart)
-	return &proto.ShopCartInfoResponse{Id: shopCart.ID}, nil
+	if item, err = global.CartStore.Save(ctx, owner, item); err != nil {
+		return nil, status.Errorf(codes.Internal, "保存购物车失败")
+	}
+	return &proto.ShopCartInfoResponse{Id: item.ID}, nil
 }
 
 func (*OrderServer) UpdateCartItem(ctx context.Context, req *proto.CartItemRequest) (*emptypb.Empty, error) {
 	// 更新购物车记录，更新数量和选中状态
-	var shopCart model.ShoppingCart
+	owner, err := cartOwner(req.UserId, req.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
 
-	if result := global.DB.Where("goods=? and user=?", req.GoodsId, req.UserId).First(&shopCart); result.RowsAffected == 0 {
+	item, err := global.CartStore.Get(ctx, owner, req.GoodsId)
+	if err == cart.ErrNotFound {
 		return nil, status.Errorf(codes.NotFound, "购物车记录不存在")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
 	}
 
-	shopCart.Checked = req.Checked
+	item.Checked = req.Checked
 	if req.Nums > 0 {
-		shopCart.Nums = req.Nums
+		item.Nums = req.Nums
+	}
+	if _, err = global.CartStore.Save(ctx, owner, item); err != nil {
+		return nil, status.Errorf(codes.Internal, "保存购物车失败")
 	}
-	global.DB.Save(&shopCart)
 
 	return &emptypb.Empty{}, nil
 }
 
 func (*OrderServer) DeleteCartItem(ctx context.Context, req *proto.CartItemRequest) (*emptypb.Empty, error) {
-	if result := global.DB.Where("goods=? and user=?", req.GoodsId, req.UserId).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
+	owner, err := cartOwner(req.UserId, req.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = global.CartStore.Delete(ctx, owner, req.GoodsId); err == cart.ErrNotFound {
 		return nil, status.Errorf(codes.NotFound, "购物车记录不存在")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "删除购物车记录失败")
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// MergeCart 登录或者注册之后将游客的购物车合并到用户的购物车， 每件商品合并之后的数量不超过库存
+func (*OrderServer) MergeCart(ctx context.Context, req *proto.MergeCartRequest) (*proto.CartItemListResponse, error) {
+	if req.UserId <= 0 || req.DeviceToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "用户和设备token不能为空")
+	}
+
+	user := cart.UserOwner(req.UserId)
+	items, err := cart.Merge(ctx, global.CartStore, cart.GuestOwner(req.DeviceToken), user, stockLimit)
+	if err != nil {
+		zap.S().Errorf("合并用户%d的购物车失败: %s", req.UserId, err.Error())
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "合并购物车失败")
+	}
+	return cartItemList(user, items), nil
+}
+
+// stockLimit 加入购物车的数量不能超过可售库存(库存减去冻结)， 和结算预览使用同一个口径
+// 没有库存信息的商品不能加入购物车
+func stockLimit(ctx context.Context, goods int32) (int32, error) {
+	invs, err := global.InventorySrvClient.BatchInvDetail(ctx, &proto.BatchInvRequest{GoodsIds: []int32{goods}})
+	if err != nil {
+		return 0, err
+	}
+	for _, inv := range invs.Data {
+		if inv.GoodsId == goods {
+			return inv.Num, nil
+		}
+	}
+	return 0, nil
+}
+
 func (*OrderServer) OrderList(ctx context.Context, req *proto.OrderFilterRequest) (*proto.OrderListResponse, error) {
 	var orders []model.OrderInfo
 	var rsp proto.OrderListResponse
 
+	filter, err := orderFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var total int64
-	global.DB.Where(&model.OrderInfo{User: req.UserId}).Count(&total)
+	if result := global.DB.Model(&model.OrderInfo{}).Scopes(filter).Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
 	rsp.Total = int32(total)
 
-	// 分页
-	global.DB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Where(&model.OrderInfo{User: req.UserId}).Find(&orders)
+	// 分页， 下单时间相同的时候再按id排序， 翻页的时候顺序是稳定的
+	if result := global.DB.Scopes(filter, Paginate(int(req.Pages), int(req.PagePerNums))).Order("add_time desc, id desc").Find(&orders); result.Error != nil {
+		return nil, result.Error
+	}
 	for _, order := range orders {
+		var payTime string
+		if order.PayTime != nil {
+			payTime = order.PayTime.Format("2006-01-02 15:04:05")
+		}
 		rsp.Data = append(rsp.Data, &proto.OrderInfoResponse{
 			Id:      order.ID,
 			UserId:  order.User,
@@ -124,11 +197,19 @@ func (*OrderServer) OrderList(ctx context.Context, req *proto.OrderFilterRequest
 			PayType: order.PayType,
 			Status:  order.Status,
 			Post:    order.Post,
-			Total:   order.OrderMount,
+			Total:   order.OrderMount.Yuan(),
 			Address: order.Address,
 			Name:    order.SignerName,
 			Mobile:  order.SingerMobile,
 			AddTime: order.CreatedAt.Format("2006-01-02 15:04:05"),
+			PayTime: payTime,
+
+			TotalCents:    order.OrderMount.Cents(),
+			DiscountCents: order.DiscountAmount.Cents(),
+			FreightCents:  order.Freight.Cents(),
+			Province:      order.Province,
+			City:          order.City,
+			ExpireAt:      formatExpireAt(&order),
 		})
 	}
 	return &rsp, nil
@@ -151,10 +232,20 @@ func (*OrderServer) OrderDetail(ctx context.Context, req *proto.OrderRequest) (*
 	orderInfo.PayType = order.PayType
 	orderInfo.Status = order.Status
 	orderInfo.Post = order.Post
-	orderInfo.Total = order.OrderMount
+	orderInfo.Total = order.OrderMount.Yuan()
+	orderInfo.TotalCents = order.OrderMount.Cents()
+	orderInfo.DiscountCents = order.DiscountAmount.Cents()
+	orderInfo.FreightCents = order.Freight.Cents()
+	orderInfo.Province = order.Province
+	orderInfo.City = order.City
+	orderInfo.ExpireAt = formatExpireAt(&order)
 	orderInfo.Address = order.Address
 	orderInfo.Name = order.SignerName
 	orderInfo.Mobile = order.SingerMobile
+	orderInfo.AddTime = order.CreatedAt.Format("2006-01-02 15:04:05")
+	if order.PayTime != nil {
+		orderInfo.PayTime = order.PayTime.Format("2006-01-02 15:04:05")
+	}
 
 	rsp.OrderInfo = &orderInfo
 
@@ -165,66 +256,123 @@ func (*OrderServer) OrderDetail(ctx context.Context, req *proto.OrderRequest) (*
 
 	for _, orderGood := range orderGoods {
 		rsp.Goods = append(rsp.Goods, &proto.OrderItemResponse{
+			Id:         orderGood.ID,
+			OrderId:    orderGood.Order,
 			GoodsId:    orderGood.Goods,
 			GoodsName:  orderGood.GoodsName,
-			GoodsPrice: orderGood.GoodsPrice,
+			GoodsPrice: orderGood.GoodsPrice.Yuan(),
 			GoodsImage: orderGood.GoodsImage,
 			Nums:       orderGood.Nums,
+
+			GoodsPriceCents: orderGood.GoodsPrice.Cents(),
+		})
+	}
+
+	var histories []model.OrderStatusHistory
+	if result := global.DB.Where(&model.OrderStatusHistory{Order: order.ID}).Order("id").Find(&histories); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, history := range histories {
+		rsp.History = append(rsp.History, &proto.OrderStatusHistoryResponse{
+			Id:         history.ID,
+			OrderId:    history.Order,
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			Remark:     history.Remark,
+			AddTime:    history.CreatedAt.Format("2006-01-02 15:04:05"),
 		})
 	}
 
 	return &rsp, nil
 }
 
+// OrderGoodsDetail 查询用户购买过的订单商品和订单的状态， 用户评价商品的时候校验是否购买过
+func (*OrderServer) OrderGoodsDetail(ctx context.Context, req *proto.OrderGoodsRequest) (*proto.OrderGoodsResponse, error) {
+	var orderGoods model.OrderGoods
+	if result := global.DB.First(&orderGoods, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: orderGoods.Order}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+
+	return &proto.OrderGoodsResponse{
+		Id:        orderGoods.ID,
+		OrderId:   order.ID,
+		GoodsId:   orderGoods.Goods,
+		GoodsName: orderGoods.GoodsName,
+		Status:    order.Status,
+	}, nil
+}
+
 type OrderListener struct {
 	Code        codes.Code
 	Detail      string
 	ID          int32
-	OrderAmount float32
+	OrderAmount money.Money
+	Discount    money.Money
+	Freight     money.Money
+	ExpireAt    time.Time
+	CouponIds   []int32
 	Ctx         context.Context
+
+	// 不为空的时候使用TCC扣减库存， 否则使用库存服务的Sell， 由事务消息保证一致
+	Tcc            *tcc.Coordinator
+	tccTransaction *model.TccTransaction
 }
 
-func (o *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primitive.LocalTransactionState {
+func (o *OrderListener) ExecuteLocalTransaction(msg *mq.Message) mq.LocalTransactionState {
 	var orderInfo model.OrderInfo
 	_ = json.Unmarshal(msg.Body, &orderInfo)
 	parentSpan := opentracing.SpanFromContext(o.Ctx)
 
 	var goodsIds []int32
-	var shopCarts []model.ShoppingCart
 	goodsNumsMap := make(map[int32]int32)
 	shopCartSpan := opentracing.GlobalTracer().StartSpan("select_shopcart", opentracing.ChildOf(parentSpan.Context()))
-	if result := global.DB.Where(&model.ShoppingCart{User: orderInfo.User, Checked: true}).Find(&shopCarts); result.RowsAffected == 0 {
+	// 和结算预览一样通过CartStore读取购物车， 使用redis缓存的时候也能读到最新的数据
+	items, err := global.CartStore.List(context.Background(), cart.UserOwner(orderInfo.User))
+	if err != nil {
+		o.Code = codes.Internal
+		o.Detail = "查询购物车失败"
+		return mq.RollbackMessageState
+	}
+	for _, item := range items {
+		if item.Checked {
+			goodsIds = append(goodsIds, item.Goods)
+			goodsNumsMap[item.Goods] = item.Nums
+		}
+	}
+	if len(goodsIds) == 0 {
 		o.Code = codes.InvalidArgument
 		o.Detail = "没有选中结算的商品"
-		return primitive.RollbackMessageState
+		return mq.RollbackMessageState
 	}
 	shopCartSpan.Finish()
 
-	for _, shopCart := range shopCarts {
-		goodsIds = append(goodsIds, shopCart.Goods)
-		goodsNumsMap[shopCart.Goods] = shopCart.Nums
-	}
-
 	// 跨服务调用商品微服务
 	queryGoodsSpan := opentracing.GlobalTracer().StartSpan("query_goods", opentracing.ChildOf(parentSpan.Context()))
 	goods, err := global.GoodsSrvClient.BatchGetGoods(context.Background(), &proto.BatchGoodsIdInfo{Id: goodsIds})
 	if err != nil {
 		o.Code = codes.Internal
 		o.Detail = "批量查询商品信息失败"
-		return primitive.RollbackMessageState
+		return mq.RollbackMessageState
 	}
 	queryGoodsSpan.Finish()
 
-	var orderAmount float32
+	// 金额都以分为单位计算， 不使用float
+	var orderAmount money.Money
 	var orderGoods []*model.OrderGoods
 	var goodsInvInfo []*proto.GoodsInvInfo
+	var discountGoods []*proto.DiscountGoodsItem
 	for _, good := range goods.Data {
-		orderAmount += good.ShopPrice * float32(goodsNumsMap[good.Id])
+		price := money.FromProto(good.ShopPriceCents, good.ShopPrice)
+		orderAmount = orderAmount.Add(price.Mul(goodsNumsMap[good.Id]))
 		orderGoods = append(orderGoods, &model.OrderGoods{
 			Goods:      good.Id,
 			GoodsName:  good.Name,
 			GoodsImage: good.GoodsFrontImage,
-			GoodsPrice: good.ShopPrice,
+			GoodsPrice: price,
 			Nums:       goodsNumsMap[good.Id],
 		})
 
@@ -232,6 +380,54 @@ func (o *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primitiv
 			GoodsId: good.Id,
 			Num:     goodsNumsMap[good.Id],
 		})
+
+		discountGoods = append(discountGoods, &proto.DiscountGoodsItem{
+			GoodsId:    good.Id,
+			CategoryId: good.CategoryId,
+			BrandId:    good.Brand.GetId(),
+			PriceCents: price.Cents(),
+			Nums:       goodsNumsMap[good.Id],
+		})
+	}
+
+	// 运费按照商品金额判断是否包邮， 不受优惠券影响
+	freightTemplate, err := defaultFreightTemplate(context.Background())
+	if err != nil {
+		o.Code = codes.Internal
+		o.Detail = "查询运费模板失败"
+		return mq.RollbackMessageState
+	}
+	freight, err := orderFreight(freightTemplate, orderInfo.Province, orderInfo.City, orderAmount, goods.Data, goodsNumsMap)
+	if err != nil {
+		o.Code = status.Code(err)
+		o.Detail = status.Convert(err).Message()
+		return mq.RollbackMessageState
+	}
+
+	// 使用了优惠券的时候先锁定优惠券， 后面的步骤失败了要释放掉
+	var discount money.Money
+	if len(o.CouponIds) > 0 {
+		rsp, err := global.PromotionSrvClient.LockCoupons(context.Background(), &proto.LockCouponsRequest{
+			UserId:    orderInfo.User,
+			OrderSn:   orderInfo.OrderSn,
+			Goods:     discountGoods,
+			CouponIds: o.CouponIds,
+		})
+		if err != nil {
+			o.Code = status.Code(err)
+			o.Detail = status.Convert(err).Message()
+			return mq.RollbackMessageState
+		}
+		discount = money.FromCents(rsp.DiscountCents)
+
+		defer func() {
+			if o.Code == codes.OK {
+				return
+			}
+			if _, err := global.PromotionSrvClient.ReleaseCoupons(context.Background(), &proto.CouponOrderRequest{OrderSn: orderInfo.OrderSn}); err != nil {
+				zap.S().Errorf("订单%s创建失败， 释放优惠券失败: %s", orderInfo.OrderSn, err.Error())
+			}
+		}()
 	}
 
 	// 跨服务调用库存微服务进行库存扣减
@@ -243,28 +439,45 @@ func (o *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primitiv
 		如果所有的微服务都正常，那么你得调用所有的微服务的confirm
 	*/
 	queryInvSpan := opentracing.GlobalTracer().StartSpan("query_inv", opentracing.ChildOf(parentSpan.Context()))
-	if _, err = global.InventorySrvClient.Sell(context.Background(), &proto.SellInfo{OrderSn: orderInfo.OrderSn, GoodsInfo: goodsInvInfo}); err != nil {
+	if err = o.deductStock(&proto.SellInfo{OrderSn: orderInfo.OrderSn, GoodsInfo: goodsInvInfo, Province: orderInfo.Province}); err != nil {
 		// 如果是因为网络问题， 这种如何避免误判， 大家自己改写一下sell的返回逻辑
 		o.Code = codes.ResourceExhausted
 		o.Detail = "扣减库存失败"
-		return primitive.RollbackMessageState
+		return mq.RollbackMessageState
 	}
 	queryInvSpan.Finish()
+	defer o.finishStock()
 
 	// 生成订单表
 	// 20210308xxxx
 	tx := global.DB.Begin()
-	orderInfo.OrderMount = orderAmount
+	orderInfo.DiscountAmount = discount
+	orderInfo.Freight = freight
+	orderInfo.OrderMount = orderAmount.Sub(discount).Add(freight)
+	orderInfo.Status = proto.OrderStatusCode_WAIT_BUYER_PAY.String()
+	now := time.Now()
+	expireAt := now.Add(payTimeout(global.ServerConfig.PayTimeoutInfo, goods.Data))
+	orderInfo.ExpireAt = &expireAt
 	saveOrderSpan := opentracing.GlobalTracer().StartSpan("save_order", opentracing.ChildOf(parentSpan.Context()))
 	if result := tx.Save(&orderInfo); result.RowsAffected == 0 {
 		tx.Rollback()
 		o.Code = codes.Internal
 		o.Detail = "创建订单失败"
-		return primitive.CommitMessageState
+		return mq.CommitMessageState
+	}
+	// 创建订单也是一次状态变更, 从空状态变为交易创建
+	if err := recordOrderStatusHistory(tx, &orderInfo, "", proto.OrderStatusCode_WAIT_BUYER_PAY, "创建订单"); err != nil {
+		tx.Rollback()
+		o.Code = codes.Internal
+		o.Detail = "保存订单状态流水失败"
+		return mq.CommitMessageState
 	}
 	saveOrderSpan.Finish()
 
-	o.OrderAmount = orderAmount
+	o.OrderAmount = orderInfo.OrderMount
+	o.Discount = discount
+	o.Freight = freight
+	o.ExpireAt = expireAt
 	o.ID = orderInfo.ID
 	for _, orderGood := range orderGoods {
 		orderGood.Order = orderInfo.ID
@@ -276,62 +489,119 @@ func (o *OrderListener) ExecuteLocalTransaction(msg *primitive.Message) primitiv
 		tx.Rollback()
 		o.Code = codes.Internal
 		o.Detail = "批量插入订单商品失败"
-		return primitive.CommitMessageState
+		return mq.CommitMessageState
 	}
 	saveOrderGoodsSpan.Finish()
 
-	deleteShopCartSpan := opentracing.GlobalTracer().StartSpan("delete_shopcart", opentracing.ChildOf(parentSpan.Context()))
-	if result := tx.Where(&model.ShoppingCart{User: orderInfo.User, Checked: true}).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
+	// 超时关闭的延时消息和订单在同一个事务中写入本地消息表， 提交之后由relay发送
+	if err = scheduleOrderTimeout(tx, &orderInfo, now); err != nil {
+		zap.S().Errorf("保存延时消息失败: %v\n", err)
 		tx.Rollback()
 		o.Code = codes.Internal
-		o.Detail = "删除购物车记录失败"
-		return primitive.CommitMessageState
+		o.Detail = "保存延时消息失败"
+		return mq.CommitMessageState
 	}
-	deleteShopCartSpan.Finish()
 
-	// 发送延时消息
-	p, err := rocketmq.NewProducer(producer.WithNameServer([]string{"192.168.0.249:9876"}))
-	if err != nil {
-		panic("生成producer失败")
+	// 提交事务
+	tx.Commit()
+	global.OutboxRelay.Notify()
+
+	// 订单创建成功之后通过CartStore删除下单的商品， 删除失败不影响订单， 用户可以自己从购物车中删除
+	deleteShopCartSpan := opentracing.GlobalTracer().StartSpan("delete_shopcart", opentracing.ChildOf(parentSpan.Context()))
+	for _, goodsId := range goodsIds {
+		if err := global.CartStore.Delete(context.Background(), cart.UserOwner(orderInfo.User), goodsId); err != nil && err != cart.ErrNotFound {
+			zap.S().Errorf("订单%s删除购物车记录失败: %s", orderInfo.OrderSn, err.Error())
+		}
 	}
+	deleteShopCartSpan.Finish()
+	o.Code = codes.OK
+	return mq.RollbackMessageState
+}
 
-	// 不要在一个进程中使用多个producer， 但是不要随便调用shutdown因为会影响其他的producer
-	if err = p.Start(); err != nil {
-		panic("启动producer失败")
+// deductStock 扣减库存， TCC模式下只是冻结库存， 订单保存之后由finishStock确认或者取消
+func (o *OrderListener) deductStock(sellInfo *proto.SellInfo) error {
+	if o.Tcc == nil {
+		_, err := global.InventorySrvClient.Sell(context.Background(), sellInfo)
+		return err
 	}
 
-	msg = primitive.NewMessage("order_timeout", msg.Body)
-	msg.WithDelayTimeLevel(3)
-	_, err = p.SendSync(context.Background(), msg)
+	payload, _ := json.Marshal(sellInfo)
+	t, err := o.Tcc.Try(context.Background(), sellInfo.OrderSn, payload)
 	if err != nil {
-		zap.S().Errorf("发送延时消息失败: %v\n", err)
-		tx.Rollback()
-		o.Code = codes.Internal
-		o.Detail = "发送延时消息失败"
-		return primitive.CommitMessageState
+		return err
 	}
+	o.tccTransaction = t
+	return nil
+}
 
-	// if err = p.Shutdown(); err != nil {panic("关闭producer失败")}
+// finishStock 订单创建成功的时候确认冻结的库存， 失败的时候取消， 没有完成的由协调者稍后重试
+func (o *OrderListener) finishStock() {
+	if o.tccTransaction == nil {
+		return
+	}
 
-	// 提交事务
-	tx.Commit()
-	o.Code = codes.OK
-	return primitive.RollbackMessageState
+	var err error
+	if o.Code == codes.OK {
+		err = o.Tcc.Confirm(context.Background(), o.tccTransaction)
+	} else {
+		err = o.Tcc.Cancel(context.Background(), o.tccTransaction)
+	}
+	if err != nil {
+		zap.S().Errorf("订单%s的库存事务没有完成， 稍后重试: %s", o.tccTransaction.Xid, err.Error())
+	}
 }
 
-func (o *OrderListener) CheckLocalTransaction(msg *primitive.MessageExt) primitive.LocalTransactionState {
+func (o *OrderListener) CheckLocalTransaction(msg *mq.Message) mq.LocalTransactionState {
 	var orderInfo model.OrderInfo
 	_ = json.Unmarshal(msg.Body, &orderInfo)
 
 	// 怎么检查之前的逻辑是否完成
 	if result := global.DB.Where(model.OrderInfo{OrderSn: orderInfo.OrderSn}).First(&orderInfo); result.RowsAffected == 0 {
-		return primitive.CommitMessageState // 你并不能说明这里就是库存已经扣减了
+		return mq.CommitMessageState // 你并不能说明这里就是库存已经扣减了
 	}
 
-	return primitive.RollbackMessageState
+	return mq.RollbackMessageState
 }
 
 func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*proto.OrderInfoResponse, error) {
+	if req.IdempotencyKey == "" {
+		return createOrder(ctx, req)
+	}
+	if len(req.IdempotencyKey) > 64 {
+		return nil, status.Errorf(codes.InvalidArgument, "幂等key不能超过64个字符")
+	}
+
+	// 相同的key只能用于相同的下单参数
+	params, _ := json.Marshal([]interface{}{req.Province, req.City, req.Address, req.Name, req.Mobile, req.Post, req.CouponIds, req.PayType})
+	result, err := global.IdempotencyGuard.Do(ctx, req.UserId, req.IdempotencyKey, idempotency.Fingerprint(params), func() (idempotency.Result, error) {
+		rsp, err := createOrder(ctx, req)
+		if err != nil {
+			return idempotency.Result{}, err
+		}
+		body, _ := json.Marshal(rsp)
+		return idempotency.Result{Order: rsp.Id, Response: body}, nil
+	})
+	switch {
+	case err == idempotency.ErrInProgress:
+		return nil, status.Error(codes.Aborted, err.Error())
+	case err == idempotency.ErrKeyReused:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		zap.S().Errorf("幂等下单失败: %s", err.Error())
+		return nil, status.Errorf(codes.Internal, "创建订单失败")
+	}
+
+	var rsp proto.OrderInfoResponse
+	if err := json.Unmarshal(result.Response, &rsp); err != nil {
+		return nil, status.Errorf(codes.Internal, "解析订单失败")
+	}
+	return &rsp, nil
+}
+
+func createOrder(ctx context.Context, req *proto.OrderRequest) (*proto.OrderInfoResponse, error) {
 	/*
 		新建订单
 			1. 从购物车中获取到选中的商品
@@ -339,55 +609,213 @@ func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*
 			3. 库存的扣减 - 访问库存服务 (跨微服务)
 			4. 订单的基本信息表 - 订单的商品信息表
 			5. 从购物车中删除已购买的记录
+		默认使用saga编排这些步骤， 失败的时候由saga执行补偿
 	*/
-	orderListener := OrderListener{Ctx: ctx}
-	p, err := rocketmq.NewTransactionProducer(
-		&orderListener,
-		producer.WithNameServer([]string{"192.168.0.249:9876"}),
-	)
-	if err != nil {
-		zap.S().Errorf("生成producer失败: %s", err.Error())
-		return nil, err
+	if req.PayType != "" && !supportPayType(global.ServerConfig.PaymentInfo, req.PayType) {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的支付方式: %s", req.PayType)
 	}
 
-	if err = p.Start(); err != nil {
-		zap.S().Errorf("启动producer失败: %s", err.Error())
-		return nil, err
+	// 订单号由全局的订单号生成器生成， 保证多实例下不重复
+	orderSn, err := global.OrderSnGenerator.Next()
+	if err != nil {
+		zap.S().Errorf("生成订单号失败: %s", err.Error())
+		return nil, status.Error(codes.Internal, "生成订单号失败")
 	}
 
 	order := model.OrderInfo{
-		OrderSn:      GenerateOrderSn(req.UserId),
+		OrderSn:      orderSn,
+		Province:     req.Province,
+		City:         req.City,
 		Address:      req.Address,
 		SignerName:   req.Name,
 		SingerMobile: req.Mobile,
 		Post:         req.Post,
+		PayType:      req.PayType,
 		User:         req.UserId,
 	}
+
+	switch global.ServerConfig.OrderFlow {
+	case "message", "tcc":
+	default:
+		return createOrderBySaga(ctx, order, req.CouponIds)
+	}
+
+	orderListener := OrderListener{Ctx: ctx, CouponIds: req.CouponIds}
+	if global.ServerConfig.OrderFlow == "tcc" {
+		orderListener.Tcc = global.TccCoordinator
+	}
 	// 应该在消息中具体指明一个订单的具体的商品的扣减情况
 	jsonString, _ := json.Marshal(order)
 
-	_, err = p.SendMessageInTransaction(context.Background(),
-		primitive.NewMessage("order_reback", jsonString))
-	if err != nil {
-		fmt.Printf("发送失败: %s\n", err)
-		return nil, status.Error(codes.Internal, "发送消息失败")
+	if orderListener.Tcc != nil {
+		// TCC模式下库存由协调者确认或者取消， 不需要事务消息
+		orderListener.ExecuteLocalTransaction(mq.NewMessage("order_reback", jsonString))
+	} else {
+		p, err := global.MQBroker.NewTransactionProducer(&orderListener)
+		if err != nil {
+			zap.S().Errorf("生成producer失败: %s", err.Error())
+			return nil, err
+		}
+
+		if err = p.Start(); err != nil {
+			zap.S().Errorf("启动producer失败: %s", err.Error())
+			return nil, err
+		}
+
+		_, err = p.SendMessageInTransaction(context.Background(), mq.NewMessage("order_reback", jsonString))
+		if err != nil {
+			fmt.Printf("发送失败: %s\n", err)
+			return nil, status.Error(codes.Internal, "发送消息失败")
+		}
 	}
 	if orderListener.Code != codes.OK {
 		return nil, status.Error(orderListener.Code, orderListener.Detail)
 	}
 
-	return &proto.OrderInfoResponse{Id: orderListener.ID, OrderSn: order.OrderSn, Total: orderListener.OrderAmount}, nil
+	return &proto.OrderInfoResponse{
+		Id:         orderListener.ID,
+		OrderSn:    order.OrderSn,
+		Total:      orderListener.OrderAmount.Yuan(),
+		TotalCents: orderListener.OrderAmount.Cents(),
+
+		DiscountCents: orderListener.Discount.Cents(),
+		FreightCents:  orderListener.Freight.Cents(),
+		ExpireAt:      orderListener.ExpireAt.Format("2006-01-02 15:04:05"),
+	}, nil
 }
 
 func (*OrderServer) UpdateOrderStatus(ctx context.Context, req *proto.OrderStatus) (*emptypb.Empty, error) {
-	// 先查询，再更新 实际上有两条sql执行， select 和 update语句
-	if result := global.DB.Model(&model.OrderInfo{}).Where("order_sn = ?", req.OrderSn).Update("status", req.Status); result.RowsAffected == 0 {
+	// 状态只能按照状态机流转， 比如已经关闭的订单不能再改为发货
+	// 支付成功和退款涉及金额， 只能通过PayOrder(校验收款应用、金额和交易号)和售后退款修改， 这里不处理
+	target, ok := proto.OrderStatusCode_value[req.Status]
+	if !ok || target == int32(proto.OrderStatusCode_STATUS_UNKNOWN) {
+		return nil, status.Errorf(codes.InvalidArgument, "订单状态不合法")
+	}
+	to := proto.OrderStatusCode(target)
+	if to == proto.OrderStatusCode_TRADE_SUCCESS || to == proto.OrderStatusCode_TRADE_REFUNDED {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单状态不能修改为%s", to)
+	}
+
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{OrderSn: req.OrderSn}).First(&order); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "订单不存在")
 	}
+	from := ParseOrderStatus(order.Status)
+	// 重复的请求直接返回成功
+	if from == to {
+		return &emptypb.Empty{}, nil
+	}
+	// 没有支付的订单直接变为交易结束相当于支付成功
+	if to == proto.OrderStatusCode_TRADE_FINISHED && (from == proto.OrderStatusCode_WAIT_BUYER_PAY || from == proto.OrderStatusCode_PAYING) {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单还没有支付")
+	}
+
+	tx := global.DB.Begin()
+	// 关闭订单和取消、超时关闭一样， 需要归还库存和优惠券
+	change := ChangeOrderStatus
+	if to == proto.OrderStatusCode_TRADE_CLOSED {
+		change = closeOrder
+	}
+	if err := change(tx, &order, to, "修改订单状态"); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	global.OutboxRelay.Notify()
+	global.OrderWatcher.Notify()
+	return &emptypb.Empty{}, nil
+}
+
+func (*OrderServer) CancelOrder(ctx context.Context, req *proto.OrderRequest) (*emptypb.Empty, error) {
+	// 用户只能取消自己的未支付订单， 取消后和超时关闭一样归还库存
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少用户信息")
+	}
+
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	// 重复取消直接返回成功
+	if ParseOrderStatus(order.Status) == proto.OrderStatusCode_TRADE_CLOSED {
+		return &emptypb.Empty{}, nil
+	}
+
+	tx := global.DB.Begin()
+	if err := closeOrder(tx, &order, proto.OrderStatusCode_TRADE_CLOSED, "用户取消订单"); err != nil {
+		tx.Rollback()
+		// 超时消息可能同时在关闭这个订单， 如果已经被关闭了也认为取消成功
+		if status.Code(err) == codes.Aborted {
+			global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: order.ID}}).First(&order)
+			if ParseOrderStatus(order.Status) == proto.OrderStatusCode_TRADE_CLOSED {
+				return &emptypb.Empty{}, nil
+			}
+		}
+		return nil, err
+	}
+	tx.Commit()
+	global.OutboxRelay.Notify()
+	global.OrderWatcher.Notify()
 	return &emptypb.Empty{}, nil
 }
 
-func OrderTimeout(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+// closeOrder 在事务中关闭订单， 取消、超时关闭和后台修改状态都通过这里关闭订单
+// 关闭未完成的支付， 并且写入归还库存和释放优惠券的消息， 事务提交之后由relay发送
+// to只能是TRADE_CLOSED， 参数和ChangeOrderStatus保持一致
+func closeOrder(tx *gorm.DB, order *model.OrderInfo, to proto.OrderStatusCode, remark string) error {
+	if err := ChangeOrderStatus(tx, order, to, remark); err != nil {
+		return err
+	}
+	if err := closePayments(tx, order.ID); err != nil {
+		return err
+	}
+	if err := sendReleaseCoupons(tx, order); err != nil {
+		zap.S().Errorf("保存优惠券释放消息失败: %s", err.Error())
+		return status.Errorf(codes.Internal, "关闭订单失败")
+	}
+	// 通过order_reback消息让库存服务归还库存
+	body, _ := json.Marshal(model.OrderInfo{OrderSn: order.OrderSn})
+	if err := sendOrderReback(tx, body); err != nil {
+		zap.S().Errorf("保存库存归还消息失败: %s", err.Error())
+		return status.Errorf(codes.Internal, "关闭订单失败")
+	}
+	return nil
+}
+
+// sendOrderReback 在关闭订单的事务中写入归还库存的消息， 库存服务的AutoReback会根据订单号归还库存
+// 事务提交之后才会发送， 订单关闭失败的时候不会误归还库存
+func sendOrderReback(tx *gorm.DB, body []byte) error {
+	return outbox.Add(tx, "order_reback", body, 0)
+}
+
+// sendReleaseCoupons 在关闭订单的事务中写入释放优惠券的消息， 没有使用优惠券的订单不需要调用营销服务
+// 事务提交之后才会释放， 订单关闭失败(比如刚好支付成功)的时候优惠券不会被误释放
+func sendReleaseCoupons(tx *gorm.DB, order *model.OrderInfo) error {
+	if order.DiscountAmount <= 0 {
+		return nil
+	}
+	body, _ := json.Marshal(model.OrderInfo{OrderSn: order.OrderSn})
+	return outbox.Add(tx, "order_coupon_release", body, 0)
+}
+
+// ReleaseCoupons 消费order_coupon_release消息， 调用营销服务归还订单锁定的优惠券
+// 营销服务的ReleaseCoupons是幂等的， 调用失败的时候稍后重试
+func ReleaseCoupons(ctx context.Context, msgs ...*mq.Message) (mq.ConsumeResult, error) {
+	for i := range msgs {
+		var orderInfo model.OrderInfo
+		if err := json.Unmarshal(msgs[i].Body, &orderInfo); err != nil || orderInfo.OrderSn == "" {
+			zap.S().Errorf("优惠券释放消息格式错误: %s", string(msgs[i].Body))
+			continue
+		}
+		if _, err := global.PromotionSrvClient.ReleaseCoupons(ctx, &proto.CouponOrderRequest{OrderSn: orderInfo.OrderSn}); err != nil {
+			zap.S().Errorf("释放订单%s的优惠券失败: %s", orderInfo.OrderSn, err.Error())
+			return mq.ConsumeRetryLater, nil
+		}
+	}
+	return mq.ConsumeSuccess, nil
+}
+
+func OrderTimeout(ctx context.Context, msgs ...*mq.Message) (mq.ConsumeResult, error) {
 
 	for i := range msgs {
 		var orderInfo model.OrderInfo
@@ -397,34 +825,34 @@ func OrderTimeout(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.
 		// 查询订单的支付状态，如果已支付什么都不做，如果未支付，归还库存
 		var order model.OrderInfo
 		if result := global.DB.Model(model.OrderInfo{}).Where(model.OrderInfo{OrderSn: orderInfo.OrderSn}).First(&order); result.RowsAffected == 0 {
-			return consumer.ConsumeSuccess, nil
+			continue
 		}
-		if order.Status != "TRADE_SUCCESS" {
-			tx := global.DB.Begin()
-			// 归还库存，我们可以模仿order中发送一个消息到 order_reback中去
-			// 修改订单的状态为已支付
-			order.Status = "TRADE_CLOSED"
-			tx.Save(&order)
-
-			p, err := rocketmq.NewProducer(producer.WithNameServer([]string{"192.168.0.249:9876"}))
-			if err != nil {
-				panic("生成producer失败")
-			}
-
-			if err = p.Start(); err != nil {
-				panic("启动producer失败")
-			}
-
-			_, err = p.SendSync(context.Background(), primitive.NewMessage("order_reback", msgs[i].Body))
-			if err != nil {
-				tx.Rollback()
-				fmt.Printf("发送失败: %s\n", err)
-				return consumer.ConsumeRetryLater, nil
+		// 已支付、已关闭的订单不能再被关闭
+		if !CanTransitOrderStatus(ParseOrderStatus(order.Status), proto.OrderStatusCode_TRADE_CLOSED) {
+			continue
+		}
+		// 支付时间比最长的延时等级还要长， 还没有到截止时间的时候按照剩余的时间再发送一次
+		if now := time.Now(); order.ExpireAt != nil && now.Before(*order.ExpireAt) {
+			if err := scheduleOrderTimeout(global.DB, &order, now); err != nil {
+				zap.S().Errorf("保存延时消息失败: %s", err.Error())
+				return mq.ConsumeRetryLater, nil
 			}
+			global.OutboxRelay.Notify()
+			continue
+		}
 
-			// if err = p.Shutdown(); err != nil {panic("关闭producer失败")}
-			return consumer.ConsumeSuccess, nil
+		tx := global.DB.Begin()
+		// 归还库存，我们可以模仿order中发送一个消息到 order_reback中去
+		// 修改订单的状态为超时关闭
+		if err := closeOrder(tx, &order, proto.OrderStatusCode_TRADE_CLOSED, "订单超时关闭"); err != nil {
+			tx.Rollback()
+			// 状态被并发修改了(比如刚好支付成功)， 稍后重新判断
+			zap.S().Infof("关闭超时订单失败: %s", err.Error())
+			return mq.ConsumeRetryLater, nil
 		}
+		tx.Commit()
+		global.OutboxRelay.Notify()
+		global.OrderWatcher.Notify()
 	}
-	return consumer.ConsumeSuccess, nil
+	return mq.ConsumeSuccess, nil
 }
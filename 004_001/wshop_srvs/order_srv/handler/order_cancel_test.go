@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/mq"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// fakePromotion 记录释放了优惠券的订单， err不为空的时候调用失败
+type fakePromotion struct {
+	proto.PromotionClient
+	err      error
+	released []string
+}
+
+func (f *fakePromotion) ReleaseCoupons(ctx context.Context, in *proto.CouponOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.released = append(f.released, in.OrderSn)
+	return &emptypb.Empty{}, nil
+}
+
+func outboxTopics(t *testing.T, db *gorm.DB) map[string]int {
+	t.Helper()
+	var messages []model.OutboxMessage
+	if err := db.Find(&messages).Error; err != nil {
+		t.Fatal(err)
+	}
+	topics := make(map[string]int)
+	for _, m := range messages {
+		topics[m.Topic]++
+	}
+	return topics
+}
+
+func TestCancelOrderReleaseCoupons(t *testing.T) {
+	db := setupDB(t)
+	promotion := &fakePromotion{}
+	global.PromotionSrvClient = promotion
+
+	withCoupon := createPayingOrder(t, db, "cancel-coupon", proto.OrderStatusCode_WAIT_BUYER_PAY)
+	db.Model(&withCoupon).Update("discount_amount", money.FromCents(500))
+	noCoupon := createPayingOrder(t, db, "cancel-plain", proto.OrderStatusCode_WAIT_BUYER_PAY)
+
+	for _, order := range []model.OrderInfo{withCoupon, noCoupon} {
+		if _, err := (&OrderServer{}).CancelOrder(context.Background(), &proto.OrderRequest{Id: order.ID, UserId: order.User}); err != nil {
+			t.Fatalf("取消订单%s失败: %v", order.OrderSn, err)
+		}
+	}
+	// 优惠券在事务提交之后通过消息释放， 取消订单的时候不直接调用营销服务
+	if len(promotion.released) != 0 {
+		t.Fatalf("取消订单的时候直接释放了优惠券: %v", promotion.released)
+	}
+	topics := outboxTopics(t, db)
+	if topics["order_coupon_release"] != 1 || topics["order_reback"] != 2 {
+		t.Fatalf("outbox topics = %v", topics)
+	}
+
+	// 订单已经关闭， 重复取消不会再次写入消息
+	if _, err := (&OrderServer{}).CancelOrder(context.Background(), &proto.OrderRequest{Id: withCoupon.ID, UserId: withCoupon.User}); err != nil {
+		t.Fatal(err)
+	}
+	if topics := outboxTopics(t, db); topics["order_coupon_release"] != 1 {
+		t.Fatalf("重复取消之后 outbox topics = %v", topics)
+	}
+}
+
+func TestReleaseCouponsConsumer(t *testing.T) {
+	setupDB(t)
+	promotion := &fakePromotion{err: errors.New("unavailable")}
+	global.PromotionSrvClient = promotion
+	msg := &mq.Message{Topic: "order_coupon_release", Body: []byte(`{"OrderSn":"release-1"}`)}
+
+	if result, _ := ReleaseCoupons(context.Background(), msg); result != mq.ConsumeRetryLater {
+		t.Fatalf("营销服务不可用的时候应该稍后重试, result = %v", result)
+	}
+
+	promotion.err = nil
+	if result, _ := ReleaseCoupons(context.Background(), msg); result != mq.ConsumeSuccess {
+		t.Fatalf("result = %v", result)
+	}
+	if len(promotion.released) != 1 || promotion.released[0] != "release-1" {
+		t.Fatalf("released = %v", promotion.released)
+	}
+
+	// 格式错误的消息重试也不会成功， 直接丢弃
+	if result, _ := ReleaseCoupons(context.Background(), &mq.Message{Body: []byte("{}")}); result != mq.ConsumeSuccess {
+		t.Fatalf("invalid message result = %v", result)
+	}
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/outbox"
+	"wshop_srvs/order_srv/proto"
+)
+
+// OrderCompletedEvent 订单完成的消息， 评价、积分、结算等下游服务订阅order_completed这个topic
+type OrderCompletedEvent struct {
+	OrderId    int32  `json:"order_id"`
+	OrderSn    string `json:"order_sn"`
+	UserId     int32  `json:"user_id"`
+	TotalCents int64  `json:"total_cents"`
+	FinishTime string `json:"finish_time"`
+	Auto       bool   `json:"auto"` // 超时自动确认收货
+}
+
+// completeOrder 在事务中把已发货的订单改为交易结束， 同一个事务中写入订单完成的消息
+func completeOrder(tx *gorm.DB, order *model.OrderInfo, auto bool) error {
+	remark := "用户确认收货"
+	if auto {
+		remark = "超时自动确认收货"
+	}
+	if err := ChangeOrderStatus(tx, order, proto.OrderStatusCode_TRADE_FINISHED, remark); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("finish_time", now); result.Error != nil {
+		return status.Errorf(codes.Internal, "保存确认收货时间失败")
+	}
+	order.FinishTime = &now
+
+	body, _ := json.Marshal(OrderCompletedEvent{
+		OrderId:    order.ID,
+		OrderSn:    order.OrderSn,
+		UserId:     order.User,
+		TotalCents: order.OrderMount.Cents(),
+		FinishTime: now.Format("2006-01-02 15:04:05"),
+		Auto:       auto,
+	})
+	if err := outbox.Add(tx, "order_completed", body, 0); err != nil {
+		zap.S().Errorf("保存订单完成消息失败: %s", err.Error())
+		return status.Errorf(codes.Internal, "保存订单完成消息失败")
+	}
+	return nil
+}
+
+func (*OrderServer) ConfirmReceipt(ctx context.Context, req *proto.OrderRequest) (*emptypb.Empty, error) {
+	// 用户只能确认自己的订单， 所有商品都发货之后才能确认收货
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少用户信息")
+	}
+
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	// 重复确认直接返回成功
+	if ParseOrderStatus(order.Status) == proto.OrderStatusCode_TRADE_FINISHED {
+		return &emptypb.Empty{}, nil
+	}
+	if ParseOrderStatus(order.Status) != proto.OrderStatusCode_TRADE_SHIPPED {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单还没有发货")
+	}
+
+	tx := global.DB.Begin()
+	if err := completeOrder(tx, &order, false); err != nil {
+		tx.Rollback()
+		// 自动确认收货的任务可能同时在完成这个订单
+		if status.Code(err) == codes.Aborted {
+			global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: order.ID}}).First(&order)
+			if ParseOrderStatus(order.Status) == proto.OrderStatusCode_TRADE_FINISHED {
+				return &emptypb.Empty{}, nil
+			}
+		}
+		return nil, err
+	}
+	tx.Commit()
+	global.OutboxRelay.Notify()
+	global.OrderWatcher.Notify()
+	return &emptypb.Empty{}, nil
+}
+
+// AutoCompleteOrders 把发货时间早于before的订单自动确认收货， 每次最多处理limit个订单
+// 返回完成的订单数量， 等于limit的时候说明可能还有没有处理的订单
+// 每个订单使用单独的事务， 已经被用户确认或者退款的订单会因为状态CAS失败而跳过
+func AutoCompleteOrders(ctx context.Context, before time.Time, limit int) (int, error) {
+	var orders []model.OrderInfo
+	result := global.DB.WithContext(ctx).Where("status = ? and ship_time < ?", proto.OrderStatusCode_TRADE_SHIPPED.String(), before).
+		Order("ship_time").Limit(limit).Find(&orders)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	completed := 0
+	for i := range orders {
+		tx := global.DB.Begin()
+		if err := completeOrder(tx, &orders[i], true); err != nil {
+			tx.Rollback()
+			if status.Code(err) != codes.Aborted {
+				zap.S().Errorf("订单%s自动确认收货失败: %s", orders[i].OrderSn, err.Error())
+			}
+			continue
+		}
+		tx.Commit()
+		completed++
+	}
+	if completed > 0 {
+		global.OutboxRelay.Notify()
+		global.OrderWatcher.Notify()
+		zap.S().Infof("自动确认收货%d个订单", completed)
+	}
+	return completed, nil
+}
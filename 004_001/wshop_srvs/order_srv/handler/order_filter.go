@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// orderFilter 把订单列表的过滤条件转换成scope， 统计总数和分页查询使用同一个scope， 保证总数和列表一致
+func orderFilter(req *proto.OrderFilterRequest) (func(db *gorm.DB) *gorm.DB, error) {
+	var statuses []string
+	for _, s := range req.Status {
+		code, ok := proto.OrderStatusCode_value[s]
+		if !ok || code == int32(proto.OrderStatusCode_STATUS_UNKNOWN) {
+			return nil, status.Errorf(codes.InvalidArgument, "订单状态%s不合法", s)
+		}
+		statuses = append(statuses, s)
+		// 历史数据中空的状态就是刚创建的订单
+		if proto.OrderStatusCode(code) == proto.OrderStatusCode_WAIT_BUYER_PAY {
+			statuses = append(statuses, "")
+		}
+	}
+	if req.MinAmountCents > 0 && req.MaxAmountCents > 0 && req.MinAmountCents > req.MaxAmountCents {
+		return nil, status.Errorf(codes.InvalidArgument, "订单金额范围不合法")
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where(&model.OrderInfo{User: req.UserId})
+		if len(statuses) > 0 {
+			db = db.Where("status in ?", statuses)
+		}
+		if req.CreatedStart > 0 {
+			db = db.Where("add_time >= ?", time.Unix(req.CreatedStart, 0))
+		}
+		if req.CreatedEnd > 0 {
+			db = db.Where("add_time < ?", time.Unix(req.CreatedEnd, 0))
+		}
+		if req.PaidStart > 0 {
+			db = db.Where("pay_time >= ?", time.Unix(req.PaidStart, 0))
+		}
+		if req.PaidEnd > 0 {
+			db = db.Where("pay_time < ?", time.Unix(req.PaidEnd, 0))
+		}
+		if req.OrderSn != "" {
+			db = db.Where("order_sn = ?", req.OrderSn)
+		}
+		if req.Mobile != "" {
+			db = db.Where("singer_mobile = ?", req.Mobile)
+		}
+		if req.MinAmountCents > 0 {
+			db = db.Where("order_mount >= ?", money.FromCents(req.MinAmountCents))
+		}
+		if req.MaxAmountCents > 0 {
+			db = db.Where("order_mount <= ?", money.FromCents(req.MaxAmountCents))
+		}
+		if req.GoodsName != "" {
+			// 前缀匹配可以用上goods_name的索引
+			db = db.Where("id in (?)", db.Session(&gorm.Session{NewDB: true}).Model(&model.OrderGoods{}).
+				Select("`order`").Where("goods_name like ? escape '!'", escapeLike(req.GoodsName)+"%"))
+		}
+		return db
+	}, nil
+}
+
+// escapeLike 转义like中的通配符， 用户输入的%和_按照普通字符匹配
+// 使用!作为转义字符并且在like中显式指定， 不依赖数据库对反斜杠的默认处理(NO_BACKSLASH_ESCAPES)
+func escapeLike(s string) string {
+	return strings.NewReplacer(`!`, `!!`, `%`, `!%`, `_`, `!_`).Replace(s)
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+)
+
+func TestEscapeLike(t *testing.T) {
+	cases := map[string]string{
+		"手机":     "手机",
+		"50%":    "50!%",
+		"a_b":    "a!_b",
+		"!":      "!!",
+		`a\b%_!`: `a\b!%!_!!`,
+	}
+	for in, want := range cases {
+		if got := escapeLike(in); got != want {
+			t.Errorf("escapeLike(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestOrderListFilter(t *testing.T) {
+	db := setupDB(t)
+	day := time.Date(2021, 3, 1, 0, 0, 0, 0, time.Local)
+	paid := day.Add(36 * time.Hour)
+	orders := []struct {
+		order model.OrderInfo
+		goods string
+	}{
+		{model.OrderInfo{OrderSn: "f1", User: 1, Status: "TRADE_SUCCESS", SingerMobile: "13800000001", OrderMount: 1000, PayTime: &paid}, "50%折扣的手机"},
+		{model.OrderInfo{OrderSn: "f2", User: 1, Status: "", SingerMobile: "13800000001", OrderMount: 5000}, "500元手机"},
+		{model.OrderInfo{OrderSn: "f3", User: 1, Status: "WAIT_BUYER_PAY", SingerMobile: "13800000002", OrderMount: 20000}, "a_b"},
+		{model.OrderInfo{OrderSn: "f4", User: 2, Status: "TRADE_CLOSED", SingerMobile: "13800000002", OrderMount: 3000}, "axb"},
+		{model.OrderInfo{OrderSn: "f5", User: 2, Status: "TRADE_SHIPPED", SingerMobile: "13800000003", OrderMount: 8000, PayTime: &paid}, "a!b"},
+	}
+	for i := range orders {
+		order := orders[i].order
+		order.CreatedAt = day.Add(time.Duration(i) * 24 * time.Hour)
+		if err := db.Create(&order).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(&model.OrderGoods{Order: order.ID, GoodsName: orders[i].goods, Nums: 1}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		name string
+		req  *proto.OrderFilterRequest
+		want []string
+	}{
+		{"all", &proto.OrderFilterRequest{}, []string{"f1", "f2", "f3", "f4", "f5"}},
+		{"user", &proto.OrderFilterRequest{UserId: 2}, []string{"f4", "f5"}},
+		// 空的状态也是待支付
+		{"wait pay", &proto.OrderFilterRequest{Status: []string{"WAIT_BUYER_PAY"}}, []string{"f2", "f3"}},
+		{"statuses", &proto.OrderFilterRequest{Status: []string{"TRADE_SUCCESS", "TRADE_SHIPPED"}}, []string{"f1", "f5"}},
+		{"created", &proto.OrderFilterRequest{CreatedStart: day.Add(24 * time.Hour).Unix(), CreatedEnd: day.Add(72 * time.Hour).Unix()}, []string{"f2", "f3"}},
+		{"paid", &proto.OrderFilterRequest{PaidStart: day.Unix(), PaidEnd: day.Add(48 * time.Hour).Unix()}, []string{"f1", "f5"}},
+		{"order sn", &proto.OrderFilterRequest{OrderSn: "f3"}, []string{"f3"}},
+		{"mobile", &proto.OrderFilterRequest{Mobile: "13800000002"}, []string{"f3", "f4"}},
+		{"mobile and user", &proto.OrderFilterRequest{UserId: 1, Mobile: "13800000002"}, []string{"f3"}},
+		{"amount", &proto.OrderFilterRequest{MinAmountCents: 3000, MaxAmountCents: 8000}, []string{"f2", "f4", "f5"}},
+		{"min amount", &proto.OrderFilterRequest{MinAmountCents: 8000}, []string{"f3", "f5"}},
+		// 用户输入的%和_按照普通字符匹配
+		{"goods percent", &proto.OrderFilterRequest{GoodsName: "50%"}, []string{"f1"}},
+		{"goods prefix", &proto.OrderFilterRequest{GoodsName: "50"}, []string{"f1", "f2"}},
+		{"goods underscore", &proto.OrderFilterRequest{GoodsName: "a_"}, []string{"f3"}},
+		{"goods escape char", &proto.OrderFilterRequest{GoodsName: "a!"}, []string{"f5"}},
+		{"goods not prefix", &proto.OrderFilterRequest{GoodsName: "手机"}, nil},
+		{"combined", &proto.OrderFilterRequest{UserId: 1, Status: []string{"WAIT_BUYER_PAY"}, GoodsName: "500"}, []string{"f2"}},
+	}
+	for _, c := range cases {
+		c.req.PagePerNums = 100
+		rsp, err := (&OrderServer{}).OrderList(context.Background(), c.req)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		var got []string
+		for _, order := range rsp.Data {
+			got = append(got, order.OrderSn)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, c.want) || int(rsp.Total) != len(c.want) {
+			t.Errorf("%s: total = %d, orders = %v, want %v", c.name, rsp.Total, got, c.want)
+		}
+	}
+
+	// 分页的时候总数和不分页一致， 翻页按照下单时间倒序并且不重复
+	var paged []string
+	for page := int32(1); page <= 3; page++ {
+		rsp, err := (&OrderServer{}).OrderList(context.Background(), &proto.OrderFilterRequest{UserId: 1, Pages: page, PagePerNums: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rsp.Total != 3 {
+			t.Fatalf("page %d: total = %d", page, rsp.Total)
+		}
+		for _, order := range rsp.Data {
+			paged = append(paged, order.OrderSn)
+		}
+	}
+	if want := []string{"f3", "f2", "f1"}; !reflect.DeepEqual(paged, want) {
+		t.Fatalf("paged = %v, want %v", paged, want)
+	}
+
+	invalid := []*proto.OrderFilterRequest{
+		{Status: []string{"UNKNOWN"}},
+		{Status: []string{"STATUS_UNKNOWN"}},
+		{MinAmountCents: 200, MaxAmountCents: 100},
+	}
+	for _, req := range invalid {
+		if _, err := (&OrderServer{}).OrderList(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: err = %v", req, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/cart"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// PreviewOrder 结算页的预览， 使用商品当前的价格和库存计算应付金额， 不锁定库存和优惠券
+// 金额的计算方式和下单保持一致， 下架或者库存不足的商品会被标记出来
+func (*OrderServer) PreviewOrder(ctx context.Context, req *proto.OrderRequest) (*proto.OrderPreviewResponse, error) {
+	items, err := global.CartStore.List(ctx, cart.UserOwner(req.UserId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	var checked []cart.Item
+	var goodsIds []int32
+	goodsNums := make(map[int32]int32)
+	for _, item := range items {
+		if item.Checked {
+			checked = append(checked, item)
+			goodsIds = append(goodsIds, item.Goods)
+			goodsNums[item.Goods] = item.Nums
+		}
+	}
+	if len(checked) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "没有选中结算的商品")
+	}
+
+	goods, err := global.GoodsSrvClient.BatchGetGoods(ctx, &proto.BatchGoodsIdInfo{Id: goodsIds})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "批量查询商品信息失败")
+	}
+	invs, err := global.InventorySrvClient.BatchInvDetail(ctx, &proto.BatchInvRequest{GoodsIds: goodsIds})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "批量查询库存信息失败")
+	}
+	stocks := make(map[int32]int32)
+	for _, inv := range invs.Data {
+		stocks[inv.GoodsId] = inv.Num
+	}
+
+	rsp, discountGoods := previewOrder(checked, goods.Data, stocks)
+	freightTemplate, err := defaultFreightTemplate(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询运费模板失败")
+	}
+	freight, err := orderFreight(freightTemplate, req.Province, req.City, money.FromCents(rsp.GoodsCents), goods.Data, goodsNums)
+	if err != nil {
+		return nil, err
+	}
+	rsp.ShippingCents = freight.Cents()
+	if len(req.CouponIds) > 0 {
+		discount, err := global.PromotionSrvClient.CalculateDiscount(ctx, &proto.CalculateDiscountRequest{
+			UserId:    req.UserId,
+			Goods:     discountGoods,
+			CouponIds: req.CouponIds,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rsp.DiscountCents = discount.DiscountCents
+	}
+	rsp.TotalCents = money.FromCents(rsp.GoodsCents).
+		Sub(money.FromCents(rsp.DiscountCents)).
+		Add(money.FromCents(rsp.ShippingCents)).
+		Cents()
+	return rsp, nil
+}
+
+// previewOrder 按照商品当前的价格计算每一行的小计和商品总金额， 运费和优惠金额由调用方填写
+// 和下单一样， 商品服务没有返回的商品不计入金额
+func previewOrder(items []cart.Item, goods []*proto.GoodsInfoResponse, stocks map[int32]int32) (*proto.OrderPreviewResponse, []*proto.DiscountGoodsItem) {
+	goodsMap := make(map[int32]*proto.GoodsInfoResponse)
+	for _, good := range goods {
+		goodsMap[good.Id] = good
+	}
+
+	rsp := proto.OrderPreviewResponse{Payable: true}
+	var amount money.Money
+	var discountGoods []*proto.DiscountGoodsItem
+	for _, item := range items {
+		line := &proto.OrderPreviewGoodsResponse{
+			GoodsId:        item.Goods,
+			Nums:           item.Nums,
+			CartPriceCents: item.Price.Cents(),
+			Stock:          stocks[item.Goods],
+		}
+		rsp.Goods = append(rsp.Goods, line)
+
+		good, ok := goodsMap[item.Goods]
+		if !ok {
+			// 商品已经被删除了
+			rsp.Payable = false
+			continue
+		}
+		price := money.FromProto(good.ShopPriceCents, good.ShopPrice)
+		line.GoodsName = good.Name
+		line.GoodsImage = good.GoodsFrontImage
+		line.PriceCents = price.Cents()
+		line.PriceChanged = item.Price > 0 && item.Price != price
+		line.OnSale = good.OnSale
+		line.AmountCents = price.Mul(item.Nums).Cents()
+		if !line.OnSale || line.Stock < item.Nums {
+			rsp.Payable = false
+		}
+
+		amount = amount.Add(price.Mul(item.Nums))
+		discountGoods = append(discountGoods, &proto.DiscountGoodsItem{
+			GoodsId:    good.Id,
+			CategoryId: good.CategoryId,
+			BrandId:    good.Brand.GetId(),
+			PriceCents: price.Cents(),
+			Nums:       item.Nums,
+		})
+	}
+
+	rsp.GoodsCents = amount.Cents()
+	return &rsp, discountGoods
+}
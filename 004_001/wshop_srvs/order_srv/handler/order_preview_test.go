@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/cart"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+)
+
+func TestPreviewOrder(t *testing.T) {
+	items := []cart.Item{
+		{Goods: 1, Nums: 2, Price: 1000, Checked: true},
+		{Goods: 2, Nums: 1, Price: 500, Checked: true},
+		{Goods: 3, Nums: 3, Checked: true},
+	}
+	goods := []*proto.GoodsInfoResponse{
+		{Id: 1, Name: "a", ShopPriceCents: 1000, OnSale: true},
+		{Id: 2, Name: "b", ShopPriceCents: 450, OnSale: true},
+		{Id: 3, Name: "c", ShopPrice: 1.1, OnSale: true},
+	}
+	stocks := map[int32]int32{1: 2, 2: 10, 3: 3}
+
+	rsp, discountGoods := previewOrder(items, goods, stocks)
+	if !rsp.Payable {
+		t.Error("order should be payable")
+	}
+	if rsp.GoodsCents != 2000+450+330 {
+		t.Errorf("goods cents = %d", rsp.GoodsCents)
+	}
+	if len(discountGoods) != 3 {
+		t.Errorf("discount goods = %d", len(discountGoods))
+	}
+
+	want := []struct {
+		price   int64
+		changed bool
+		amount  int64
+	}{
+		{1000, false, 2000},
+		{450, true, 450},  // 降价了
+		{110, false, 330}, // 没有记录加入购物车时的价格
+	}
+	for i, w := range want {
+		line := rsp.Goods[i]
+		if line.PriceCents != w.price || line.PriceChanged != w.changed || line.AmountCents != w.amount {
+			t.Errorf("line %d = %+v", i, line)
+		}
+	}
+}
+
+func TestPreviewOrderNotPayable(t *testing.T) {
+	cases := []struct {
+		name   string
+		goods  []*proto.GoodsInfoResponse
+		stocks map[int32]int32
+	}{
+		{"off sale", []*proto.GoodsInfoResponse{{Id: 1, ShopPriceCents: 100}}, map[int32]int32{1: 5}},
+		{"out of stock", []*proto.GoodsInfoResponse{{Id: 1, ShopPriceCents: 100, OnSale: true}}, map[int32]int32{1: 1}},
+		{"no inventory", []*proto.GoodsInfoResponse{{Id: 1, ShopPriceCents: 100, OnSale: true}}, map[int32]int32{}},
+		{"deleted", nil, map[int32]int32{1: 5}},
+	}
+	for _, c := range cases {
+		rsp, _ := previewOrder([]cart.Item{{Goods: 1, Nums: 2, Checked: true}}, c.goods, c.stocks)
+		if rsp.Payable {
+			t.Errorf("%s: order should not be payable", c.name)
+		}
+		if len(rsp.Goods) != 1 {
+			t.Errorf("%s: lines = %d", c.name, len(rsp.Goods))
+		}
+	}
+}
+
+// previewGoods 商品服务， 每个商品10元
+type previewGoods struct {
+	proto.GoodsClient
+}
+
+func (previewGoods) BatchGetGoods(ctx context.Context, in *proto.BatchGoodsIdInfo, opts ...grpc.CallOption) (*proto.GoodsListResponse, error) {
+	var rsp proto.GoodsListResponse
+	for _, id := range in.Id {
+		rsp.Data = append(rsp.Data, &proto.GoodsInfoResponse{Id: id, ShopPriceCents: 1000, OnSale: true})
+	}
+	return &rsp, nil
+}
+
+// previewInventory 库存服务， 每个商品都有100件
+type previewInventory struct {
+	proto.InventoryClient
+}
+
+func (previewInventory) BatchInvDetail(ctx context.Context, in *proto.BatchInvRequest, opts ...grpc.CallOption) (*proto.BatchInvResponse, error) {
+	var rsp proto.BatchInvResponse
+	for _, id := range in.GoodsIds {
+		rsp.Data = append(rsp.Data, &proto.GoodsInvInfo{GoodsId: id, Num: 100})
+	}
+	return &rsp, nil
+}
+
+func TestPreviewOrderFreight(t *testing.T) {
+	db := setupDB(t)
+	global.GoodsSrvClient = previewGoods{}
+	global.InventorySrvClient = previewInventory{}
+	global.CartStore = &cart.GormStore{DB: db}
+	if _, err := global.CartStore.Save(context.Background(), cart.UserOwner(1), cart.Item{Goods: 1, Nums: 2, Checked: true}); err != nil {
+		t.Fatal(err)
+	}
+	template := model.FreightTemplate{Name: "默认", ChargeType: model.FREIGHT_BY_PIECE, IsDefault: true, Rules: []model.FreightRule{
+		{FirstUnit: 1, FirstFee: 1000},
+		{Province: "浙江", FirstUnit: 1, FirstFee: 600},
+		{Province: "浙江", City: "杭州", FirstUnit: 1, FirstFee: 0},
+	}}
+	if err := db.Create(&template).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 结算页按照收货地址计算运费， 和下单时一致
+	cases := []struct {
+		province, city string
+		shipping       int64
+	}{
+		{"", "", 1000},
+		{"浙江", "宁波", 600},
+		{"浙江", "杭州", 0},
+	}
+	for _, c := range cases {
+		rsp, err := (&OrderServer{}).PreviewOrder(context.Background(), &proto.OrderRequest{UserId: 1, Province: c.province, City: c.city})
+		if err != nil {
+			t.Fatalf("%s%s: %v", c.province, c.city, err)
+		}
+		if rsp.ShippingCents != c.shipping || rsp.TotalCents != 2000+c.shipping {
+			t.Errorf("%s%s: shipping = %d, total = %d, want %d", c.province, c.city, rsp.ShippingCents, rsp.TotalCents, c.shipping)
+		}
+	}
+
+	// 没有配置默认地区的时候， 不在配送范围内的地址不能结算
+	db.Where("province = ?", "").Delete(&model.FreightRule{})
+	if _, err := (&OrderServer{}).PreviewOrder(context.Background(), &proto.OrderRequest{UserId: 1, Province: "新疆"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("新疆: err = %v", err)
+	}
+}
+
+// stockInventory 库存服务， stocks是商品的可售库存， 没有的商品不返回
+type stockInventory struct {
+	proto.InventoryClient
+	stocks map[int32]int32
+	err    error
+}
+
+func (f stockInventory) BatchInvDetail(ctx context.Context, in *proto.BatchInvRequest, opts ...grpc.CallOption) (*proto.BatchInvResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var rsp proto.BatchInvResponse
+	for _, id := range in.GoodsIds {
+		if num, ok := f.stocks[id]; ok {
+			rsp.Data = append(rsp.Data, &proto.GoodsInvInfo{GoodsId: id, Num: num})
+		}
+	}
+	return &rsp, nil
+}
+
+func TestStockLimit(t *testing.T) {
+	old := global.InventorySrvClient
+	t.Cleanup(func() { global.InventorySrvClient = old })
+	// 加入购物车和结算预览一样使用可售库存， 商品2的库存都被冻结了
+	global.InventorySrvClient = stockInventory{stocks: map[int32]int32{1: 7, 2: 0}}
+	ctx := context.Background()
+	for goods, want := range map[int32]int32{1: 7, 2: 0, 3: 0} {
+		if got, err := stockLimit(ctx, goods); err != nil || got != want {
+			t.Errorf("stockLimit(%d) = %d, %v, want %d", goods, got, err, want)
+		}
+	}
+
+	global.InventorySrvClient = stockInventory{err: status.Errorf(codes.Unavailable, "库存服务不可用")}
+	if _, err := stockLimit(ctx, 1); status.Code(err) != codes.Unavailable {
+		t.Errorf("库存服务不可用 err = %v", err)
+	}
+}
@@ -0,0 +1,391 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/order_srv/cart"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/saga"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+const (
+	CreateOrderSaga  = "create_order"
+	orderStepTimeout = 5 * time.Second // 下单每个步骤的超时时间
+)
+
+// createOrderData 下单saga在步骤之间传递的数据， 会序列化之后保存到saga_instance表中
+type createOrderData struct {
+	Order     model.OrderInfo
+	CouponIds []int32
+
+	Carts         []model.ShoppingCart
+	Goods         []*model.OrderGoods
+	GoodsInvInfo  []*proto.GoodsInvInfo
+	DiscountGoods []*proto.DiscountGoodsItem
+	Amount        money.Money
+	Discount      money.Money
+	Freight       money.Money
+	PayTimeout    time.Duration
+
+	DeletedCarts []int32 // 被这次下单删除的购物车记录， 补偿的时候恢复
+}
+
+// createOrderBackend 下单过程中调用的其他服务和数据库操作， 测试的时候替换成内存的实现
+type createOrderBackend interface {
+	SelectedCarts(ctx context.Context, userId int32) ([]model.ShoppingCart, error)
+	BatchGetGoods(ctx context.Context, goodsIds []int32) ([]*proto.GoodsInfoResponse, error)
+	FreightTemplate(ctx context.Context) (*model.FreightTemplate, error)
+	LockCoupons(ctx context.Context, req *proto.LockCouponsRequest) (money.Money, error)
+	ReleaseCoupons(ctx context.Context, orderSn string) error
+	Sell(ctx context.Context, sellInfo *proto.SellInfo) error
+	Reback(ctx context.Context, orderSn string) error
+	SaveOrder(ctx context.Context, order *model.OrderInfo, goods []*model.OrderGoods) error
+	CloseOrder(ctx context.Context, orderSn string) error
+	DeleteCarts(ctx context.Context, cartIds []int32) ([]int32, error)
+	RestoreCarts(ctx context.Context, cartIds []int32) error
+}
+
+// NewCreateOrderSaga 下单的saga
+// 调用方在下单失败之后已经拿到了错误， 进程重启之后继续下单没有意义， 所以中断的下单直接补偿
+func NewCreateOrderSaga(b createOrderBackend) *saga.Definition {
+	return &saga.Definition{
+		Name:     CreateOrderSaga,
+		NewData:  func() interface{} { return &createOrderData{} },
+		Recovery: saga.RecoverBackward,
+		Steps: []saga.Step{
+			{
+				Name:    "select_shopcart",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					carts, err := b.SelectedCarts(ctx, d.Order.User)
+					if err != nil {
+						return status.Errorf(codes.Internal, "查询购物车失败")
+					}
+					if len(carts) == 0 {
+						return status.Errorf(codes.InvalidArgument, "没有选中结算的商品")
+					}
+					d.Carts = carts
+					return nil
+				},
+			},
+			{
+				Name:    "query_goods",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					var goodsIds []int32
+					goodsNumsMap := make(map[int32]int32)
+					for _, cart := range d.Carts {
+						goodsIds = append(goodsIds, cart.Goods)
+						goodsNumsMap[cart.Goods] = cart.Nums
+					}
+
+					goods, err := b.BatchGetGoods(ctx, goodsIds)
+					if err != nil {
+						return status.Errorf(codes.Internal, "批量查询商品信息失败")
+					}
+					d.Amount, d.Goods, d.GoodsInvInfo, d.DiscountGoods = 0, nil, nil, nil
+					for _, good := range goods {
+						price := money.FromProto(good.ShopPriceCents, good.ShopPrice)
+						d.Amount = d.Amount.Add(price.Mul(goodsNumsMap[good.Id]))
+						d.Goods = append(d.Goods, &model.OrderGoods{
+							Goods:      good.Id,
+							GoodsName:  good.Name,
+							GoodsImage: good.GoodsFrontImage,
+							GoodsPrice: price,
+							Nums:       goodsNumsMap[good.Id],
+						})
+						d.GoodsInvInfo = append(d.GoodsInvInfo, &proto.GoodsInvInfo{
+							GoodsId: good.Id,
+							Num:     goodsNumsMap[good.Id],
+						})
+						d.DiscountGoods = append(d.DiscountGoods, &proto.DiscountGoodsItem{
+							GoodsId:    good.Id,
+							CategoryId: good.CategoryId,
+							BrandId:    good.Brand.GetId(),
+							PriceCents: price.Cents(),
+							Nums:       goodsNumsMap[good.Id],
+						})
+					}
+
+					d.PayTimeout = payTimeout(global.ServerConfig.PayTimeoutInfo, goods)
+
+					// 运费按照商品金额判断是否包邮， 不受优惠券影响
+					t, err := b.FreightTemplate(ctx)
+					if err != nil {
+						return status.Errorf(codes.Internal, "查询运费模板失败")
+					}
+					d.Freight, err = orderFreight(t, d.Order.Province, d.Order.City, d.Amount, goods, goodsNumsMap)
+					return err
+				},
+			},
+			{
+				Name:    "lock_coupons",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					if len(d.CouponIds) == 0 {
+						return nil
+					}
+					discount, err := b.LockCoupons(ctx, &proto.LockCouponsRequest{
+						UserId:    d.Order.User,
+						OrderSn:   d.Order.OrderSn,
+						Goods:     d.DiscountGoods,
+						CouponIds: d.CouponIds,
+					})
+					if err != nil {
+						return err
+					}
+					d.Discount = discount
+					return nil
+				},
+				Compensate: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					if len(d.CouponIds) == 0 {
+						return nil
+					}
+					return b.ReleaseCoupons(ctx, d.Order.OrderSn)
+				},
+			},
+			{
+				Name:    "sell_inventory",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					if err := b.Sell(ctx, &proto.SellInfo{OrderSn: d.Order.OrderSn, GoodsInfo: d.GoodsInvInfo, Province: d.Order.Province}); err != nil {
+						return status.Errorf(codes.ResourceExhausted, "扣减库存失败")
+					}
+					return nil
+				},
+				Compensate: func(ctx context.Context, data interface{}) error {
+					return b.Reback(ctx, data.(*createOrderData).Order.OrderSn)
+				},
+			},
+			{
+				Name:    "save_order",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					d.Order.DiscountAmount = d.Discount
+					d.Order.Freight = d.Freight
+					d.Order.OrderMount = d.Amount.Sub(d.Discount).Add(d.Freight)
+					d.Order.Status = proto.OrderStatusCode_WAIT_BUYER_PAY.String()
+					expireAt := time.Now().Add(d.PayTimeout)
+					d.Order.ExpireAt = &expireAt
+					if err := b.SaveOrder(ctx, &d.Order, d.Goods); err != nil {
+						return status.Errorf(codes.Internal, "创建订单失败")
+					}
+					return nil
+				},
+				Compensate: func(ctx context.Context, data interface{}) error {
+					return b.CloseOrder(ctx, data.(*createOrderData).Order.OrderSn)
+				},
+			},
+			{
+				Name:    "delete_shopcart",
+				Timeout: orderStepTimeout,
+				Action: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					var cartIds []int32
+					for _, cart := range d.Carts {
+						cartIds = append(cartIds, cart.ID)
+					}
+					deleted, err := b.DeleteCarts(ctx, cartIds)
+					d.DeletedCarts = deleted
+					if err != nil || len(deleted) == 0 {
+						// 购物车已经被同时提交的另一个订单删除了
+						return status.Errorf(codes.Internal, "删除购物车记录失败")
+					}
+					return nil
+				},
+				Compensate: func(ctx context.Context, data interface{}) error {
+					d := data.(*createOrderData)
+					if len(d.DeletedCarts) == 0 {
+						return nil
+					}
+					return b.RestoreCarts(ctx, d.DeletedCarts)
+				},
+			},
+		},
+	}
+}
+
+// createOrderBySaga 使用saga下单
+func createOrderBySaga(ctx context.Context, order model.OrderInfo, couponIds []int32) (*proto.OrderInfoResponse, error) {
+	data := createOrderData{Order: order, CouponIds: couponIds}
+	if err := global.SagaOrchestrator.Execute(ctx, CreateOrderSaga, order.OrderSn, &data); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.OrderInfoResponse{
+		Id:         data.Order.ID,
+		OrderSn:    data.Order.OrderSn,
+		Total:      data.Order.OrderMount.Yuan(),
+		TotalCents: data.Order.OrderMount.Cents(),
+
+		DiscountCents: data.Discount.Cents(),
+		FreightCents:  data.Freight.Cents(),
+		ExpireAt:      formatExpireAt(&data.Order),
+	}, nil
+}
+
+// orderBackend 下单saga使用的真实服务
+type orderBackend struct{}
+
+func NewOrderBackend() createOrderBackend {
+	return orderBackend{}
+}
+
+func (orderBackend) SelectedCarts(ctx context.Context, userId int32) ([]model.ShoppingCart, error) {
+	var carts []model.ShoppingCart
+	result := global.DB.WithContext(ctx).Where(&model.ShoppingCart{User: userId, Checked: true}).Find(&carts)
+	return carts, result.Error
+}
+
+func (orderBackend) BatchGetGoods(ctx context.Context, goodsIds []int32) ([]*proto.GoodsInfoResponse, error) {
+	rsp, err := global.GoodsSrvClient.BatchGetGoods(ctx, &proto.BatchGoodsIdInfo{Id: goodsIds})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Data, nil
+}
+
+func (orderBackend) FreightTemplate(ctx context.Context) (*model.FreightTemplate, error) {
+	return defaultFreightTemplate(ctx)
+}
+
+func (orderBackend) LockCoupons(ctx context.Context, req *proto.LockCouponsRequest) (money.Money, error) {
+	rsp, err := global.PromotionSrvClient.LockCoupons(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return money.FromCents(rsp.DiscountCents), nil
+}
+
+func (orderBackend) ReleaseCoupons(ctx context.Context, orderSn string) error {
+	_, err := global.PromotionSrvClient.ReleaseCoupons(ctx, &proto.CouponOrderRequest{OrderSn: orderSn})
+	return err
+}
+
+func (orderBackend) Sell(ctx context.Context, sellInfo *proto.SellInfo) error {
+	_, err := global.InventorySrvClient.Sell(ctx, sellInfo)
+	return err
+}
+
+// Reback 通过order_reback消息归还库存， AutoReback按照订单号归还， 重复的消息和没有扣减的订单都不会多归还
+func (orderBackend) Reback(ctx context.Context, orderSn string) error {
+	body, _ := json.Marshal(model.OrderInfo{OrderSn: orderSn})
+	if err := sendOrderReback(global.DB.WithContext(ctx), body); err != nil {
+		return err
+	}
+	global.OutboxRelay.Notify()
+	return nil
+}
+
+func (orderBackend) SaveOrder(ctx context.Context, order *model.OrderInfo, goods []*model.OrderGoods) error {
+	tx := global.DB.WithContext(ctx).Begin()
+	if result := tx.Save(order); result.RowsAffected == 0 {
+		tx.Rollback()
+		return status.Errorf(codes.Internal, "创建订单失败")
+	}
+	// 创建订单也是一次状态变更, 从空状态变为交易创建
+	if err := recordOrderStatusHistory(tx, order, "", proto.OrderStatusCode_WAIT_BUYER_PAY, "创建订单"); err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, orderGood := range goods {
+		orderGood.Order = order.ID
+	}
+	if result := tx.CreateInBatches(goods, 100); result.RowsAffected == 0 {
+		tx.Rollback()
+		return status.Errorf(codes.Internal, "批量插入订单商品失败")
+	}
+
+	// 超时关闭的延时消息
+	if err := scheduleOrderTimeout(tx, order, time.Now()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	global.OutboxRelay.Notify()
+	return nil
+}
+
+// CloseOrder 关闭下单失败的订单， 订单不存在或者已经关闭的时候直接返回
+func (orderBackend) CloseOrder(ctx context.Context, orderSn string) error {
+	var order model.OrderInfo
+	if result := global.DB.WithContext(ctx).Where(&model.OrderInfo{OrderSn: orderSn}).First(&order); result.RowsAffected == 0 {
+		return nil
+	}
+	if ParseOrderStatus(order.Status) == proto.OrderStatusCode_TRADE_CLOSED {
+		return nil
+	}
+
+	tx := global.DB.WithContext(ctx).Begin()
+	if err := ChangeOrderStatus(tx, &order, proto.OrderStatusCode_TRADE_CLOSED, "下单失败"); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+func (orderBackend) DeleteCarts(ctx context.Context, cartIds []int32) ([]int32, error) {
+	var deleted []int32
+	err := global.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 只删除还在购物车中的记录， 补偿的时候不会恢复被其他订单删除的记录
+		if result := tx.Model(&model.ShoppingCart{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id in ?", cartIds).Pluck("id", &deleted); result.Error != nil {
+			return result.Error
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+		return tx.Delete(&model.ShoppingCart{}, deleted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidateCarts(ctx, deleted)
+	return deleted, nil
+}
+
+func (orderBackend) RestoreCarts(ctx context.Context, cartIds []int32) error {
+	if err := global.DB.WithContext(ctx).Unscoped().Model(&model.ShoppingCart{}).Where("id in ?", cartIds).Update("deleted_at", nil).Error; err != nil {
+		return err
+	}
+	invalidateCarts(ctx, cartIds)
+	return nil
+}
+
+// invalidateCarts 购物车记录是直接在数据库中修改的， 丢弃这些记录所属用户的购物车缓存
+func invalidateCarts(ctx context.Context, cartIds []int32) {
+	if len(cartIds) == 0 {
+		return
+	}
+	var users []int32
+	if result := global.DB.WithContext(ctx).Unscoped().Model(&model.ShoppingCart{}).Where("id in ?", cartIds).Distinct().Pluck("user", &users); result.Error != nil {
+		zap.S().Errorf("查询购物车所属用户失败: %s", result.Error.Error())
+		return
+	}
+	for _, user := range users {
+		if err := global.CartStore.Invalidate(ctx, cart.UserOwner(user)); err != nil {
+			zap.S().Errorf("丢弃用户%d的购物车缓存失败: %s", user, err.Error())
+		}
+	}
+}
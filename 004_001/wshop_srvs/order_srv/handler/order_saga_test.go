@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/saga"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// fakeBackend 内存中的商品、库存、优惠券、订单和购物车， failAt指定在哪个步骤注入失败
+type fakeBackend struct {
+	mu     sync.Mutex
+	failAt string
+
+	carts   map[int32]model.ShoppingCart // 购物车中的记录
+	deleted map[int32]model.ShoppingCart // 被删除的购物车记录
+	goods   map[int32]*proto.GoodsInfoResponse
+	freight *model.FreightTemplate
+	stocks  map[int32]int32
+	sold    map[string][]*proto.GoodsInvInfo
+	locked  map[string]bool // 被订单锁定的优惠券
+	orders  map[string]*model.OrderInfo
+
+	calls []string
+}
+
+func newFakeBackend(failAt string) *fakeBackend {
+	return &fakeBackend{
+		failAt: failAt,
+		carts: map[int32]model.ShoppingCart{
+			1: {BaseModel: model.BaseModel{ID: 1}, User: 7, Goods: 100, Nums: 2, Checked: true},
+			2: {BaseModel: model.BaseModel{ID: 2}, User: 7, Goods: 101, Nums: 1, Checked: true},
+		},
+		deleted: make(map[int32]model.ShoppingCart),
+		goods: map[int32]*proto.GoodsInfoResponse{
+			100: {Id: 100, Name: "苹果", ShopPriceCents: 1050, CategoryId: 1, Brand: &proto.BrandInfoResponse{Id: 1}},
+			101: {Id: 101, Name: "香蕉", ShopPriceCents: 300, CategoryId: 1, Brand: &proto.BrandInfoResponse{Id: 2}},
+		},
+		stocks: map[int32]int32{100: 10, 101: 10},
+		sold:   make(map[string][]*proto.GoodsInvInfo),
+		locked: make(map[string]bool),
+		orders: make(map[string]*model.OrderInfo),
+	}
+}
+
+func (b *fakeBackend) call(name string) error {
+	b.calls = append(b.calls, name)
+	if b.failAt == name {
+		return errors.New("注入的错误")
+	}
+	return nil
+}
+
+func (b *fakeBackend) called(name string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, call := range b.calls {
+		if call == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *fakeBackend) SelectedCarts(ctx context.Context, userId int32) ([]model.ShoppingCart, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("SelectedCarts"); err != nil {
+		return nil, err
+	}
+	var carts []model.ShoppingCart
+	for _, id := range []int32{1, 2} {
+		if cart, ok := b.carts[id]; ok && cart.User == userId && cart.Checked {
+			carts = append(carts, cart)
+		}
+	}
+	return carts, nil
+}
+
+func (b *fakeBackend) BatchGetGoods(ctx context.Context, goodsIds []int32) ([]*proto.GoodsInfoResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("BatchGetGoods"); err != nil {
+		return nil, err
+	}
+	var goods []*proto.GoodsInfoResponse
+	for _, id := range goodsIds {
+		goods = append(goods, b.goods[id])
+	}
+	return goods, nil
+}
+
+func (b *fakeBackend) FreightTemplate(ctx context.Context) (*model.FreightTemplate, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("FreightTemplate"); err != nil {
+		return nil, err
+	}
+	return b.freight, nil
+}
+
+func (b *fakeBackend) LockCoupons(ctx context.Context, req *proto.LockCouponsRequest) (money.Money, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("LockCoupons"); err != nil {
+		return 0, err
+	}
+	b.locked[req.OrderSn] = true
+	return money.FromCents(500), nil
+}
+
+func (b *fakeBackend) ReleaseCoupons(ctx context.Context, orderSn string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_ = b.call("ReleaseCoupons")
+	delete(b.locked, orderSn)
+	return nil
+}
+
+func (b *fakeBackend) Sell(ctx context.Context, sellInfo *proto.SellInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("Sell"); err != nil {
+		return err
+	}
+	for _, info := range sellInfo.GoodsInfo {
+		if b.stocks[info.GoodsId] < info.Num {
+			return status.Errorf(codes.ResourceExhausted, "库存不足")
+		}
+	}
+	for _, info := range sellInfo.GoodsInfo {
+		b.stocks[info.GoodsId] -= info.Num
+	}
+	b.sold[sellInfo.OrderSn] = sellInfo.GoodsInfo
+	return nil
+}
+
+// Reback 和AutoReback一样按照订单号归还， 没有扣减的订单不会归还
+func (b *fakeBackend) Reback(ctx context.Context, orderSn string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_ = b.call("Reback")
+	for _, info := range b.sold[orderSn] {
+		b.stocks[info.GoodsId] += info.Num
+	}
+	delete(b.sold, orderSn)
+	return nil
+}
+
+func (b *fakeBackend) SaveOrder(ctx context.Context, order *model.OrderInfo, goods []*model.OrderGoods) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("SaveOrder"); err != nil {
+		return err
+	}
+	order.ID = int32(len(b.orders) + 1)
+	saved := *order
+	b.orders[order.OrderSn] = &saved
+	return nil
+}
+
+func (b *fakeBackend) CloseOrder(ctx context.Context, orderSn string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_ = b.call("CloseOrder")
+	if order, ok := b.orders[orderSn]; ok {
+		order.Status = proto.OrderStatusCode_TRADE_CLOSED.String()
+	}
+	return nil
+}
+
+func (b *fakeBackend) DeleteCarts(ctx context.Context, cartIds []int32) ([]int32, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.call("DeleteCarts"); err != nil {
+		return nil, err
+	}
+	var deleted []int32
+	for _, id := range cartIds {
+		if cart, ok := b.carts[id]; ok {
+			b.deleted[id] = cart
+			delete(b.carts, id)
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+func (b *fakeBackend) RestoreCarts(ctx context.Context, cartIds []int32) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_ = b.call("RestoreCarts")
+	for _, id := range cartIds {
+		if cart, ok := b.deleted[id]; ok {
+			b.carts[id] = cart
+			delete(b.deleted, id)
+		}
+	}
+	return nil
+}
+
+func executeCreateOrder(t *testing.T, b *fakeBackend, couponIds []int32) (*createOrderData, error) {
+	t.Helper()
+	o := saga.NewOrchestrator(saga.NewMemoryStore())
+	o.Register(NewCreateOrderSaga(b))
+
+	data := &createOrderData{Order: model.OrderInfo{OrderSn: "20210601000001", User: 7}, CouponIds: couponIds}
+	err := o.Execute(context.Background(), CreateOrderSaga, data.Order.OrderSn, data)
+	return data, err
+}
+
+func TestCreateOrderSagaSucceeded(t *testing.T) {
+	b := newFakeBackend("")
+	data, err := executeCreateOrder(t, b, []int32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2*10.50 + 3.00 - 5.00
+	if data.Order.OrderMount != money.FromCents(1900) || data.Discount != money.FromCents(500) {
+		t.Fatalf("订单金额不正确: %d， 优惠: %d", data.Order.OrderMount, data.Discount)
+	}
+	if b.stocks[100] != 8 || b.stocks[101] != 9 {
+		t.Fatalf("库存没有扣减: %v", b.stocks)
+	}
+	if len(b.carts) != 0 || !b.locked[data.Order.OrderSn] {
+		t.Fatal("购物车没有删除或者优惠券没有锁定")
+	}
+	if order := b.orders[data.Order.OrderSn]; order == nil || order.Status != proto.OrderStatusCode_WAIT_BUYER_PAY.String() {
+		t.Fatal("订单没有保存")
+	}
+}
+
+func TestCreateOrderSagaFreight(t *testing.T) {
+	b := newFakeBackend("")
+	b.goods[101].ShipFree = true
+	b.freight = &model.FreightTemplate{
+		ChargeType: model.FREIGHT_BY_PIECE,
+		Rules:      []model.FreightRule{{FirstUnit: 1, FirstFee: 800, AdditionalUnit: 1, AdditionalFee: 200}},
+	}
+	data, err := executeCreateOrder(t, b, []int32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 香蕉包邮， 苹果2件: 8.00 + 2.00
+	if data.Order.Freight != money.FromCents(1000) || data.Order.OrderMount != money.FromCents(1900+1000) {
+		t.Fatalf("运费: %d， 订单金额: %d", data.Order.Freight, data.Order.OrderMount)
+	}
+
+	// 不在配送范围内的地址不能下单
+	b = newFakeBackend("")
+	b.freight = &model.FreightTemplate{
+		ChargeType: model.FREIGHT_BY_PIECE,
+		Rules:      []model.FreightRule{{Province: "浙江", FirstUnit: 1, FirstFee: 800}},
+	}
+	if _, err := executeCreateOrder(t, b, nil); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("err = %v", err)
+	}
+	if b.stocks[100] != 10 || len(b.carts) != 2 {
+		t.Fatal("下单失败之后库存和购物车应该不变")
+	}
+}
+
+func TestCreateOrderSagaCompensation(t *testing.T) {
+	// 在每个步骤注入失败， 检查前面步骤的补偿都执行了并且数据恢复到下单之前
+	cases := []struct {
+		failAt      string
+		code        codes.Code
+		compensated []string
+	}{
+		{"SelectedCarts", codes.Internal, nil},
+		{"BatchGetGoods", codes.Internal, nil},
+		{"LockCoupons", codes.Unknown, []string{"ReleaseCoupons"}},
+		{"Sell", codes.ResourceExhausted, []string{"Reback", "ReleaseCoupons"}},
+		{"SaveOrder", codes.Internal, []string{"CloseOrder", "Reback", "ReleaseCoupons"}},
+		{"DeleteCarts", codes.Internal, []string{"CloseOrder", "Reback", "ReleaseCoupons"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.failAt, func(t *testing.T) {
+			b := newFakeBackend(c.failAt)
+			data, err := executeCreateOrder(t, b, []int32{1})
+			if status.Code(err) != c.code {
+				t.Fatalf("错误码不正确， 期望: %s， 实际: %v", c.code, err)
+			}
+
+			for _, name := range c.compensated {
+				if !b.called(name) {
+					t.Fatalf("%s失败之后应该执行%s， 实际调用: %v", c.failAt, name, b.calls)
+				}
+			}
+			for _, name := range []string{"ReleaseCoupons", "Reback", "CloseOrder", "RestoreCarts"} {
+				expected := false
+				for _, n := range c.compensated {
+					expected = expected || n == name
+				}
+				if !expected && b.called(name) {
+					t.Fatalf("%s失败之后不应该执行%s", c.failAt, name)
+				}
+			}
+
+			if b.stocks[100] != 10 || b.stocks[101] != 10 {
+				t.Fatalf("库存没有归还: %v", b.stocks)
+			}
+			if len(b.carts) != 2 {
+				t.Fatalf("购物车没有恢复: %v", b.carts)
+			}
+			if b.locked[data.Order.OrderSn] {
+				t.Fatal("优惠券没有释放")
+			}
+			if order := b.orders[data.Order.OrderSn]; order != nil && order.Status != proto.OrderStatusCode_TRADE_CLOSED.String() {
+				t.Fatalf("订单应该被关闭， 实际状态: %s", order.Status)
+			}
+		})
+	}
+}
+
+func TestCreateOrderSagaRestoreCarts(t *testing.T) {
+	// 购物车删除之后的步骤失败要恢复购物车， 这里用一个额外的步骤模拟
+	b := newFakeBackend("")
+	def := NewCreateOrderSaga(b)
+	def.Steps = append(def.Steps, saga.Step{
+		Name: "after_delete",
+		Action: func(ctx context.Context, data interface{}) error {
+			return status.Errorf(codes.Internal, "注入的错误")
+		},
+	})
+	o := saga.NewOrchestrator(saga.NewMemoryStore())
+	o.Register(def)
+
+	data := &createOrderData{Order: model.OrderInfo{OrderSn: "20210601000002", User: 7}}
+	if err := o.Execute(context.Background(), CreateOrderSaga, data.Order.OrderSn, data); status.Code(err) != codes.Internal {
+		t.Fatalf("应该返回步骤的错误， 实际: %v", err)
+	}
+	if !b.called("RestoreCarts") || len(b.carts) != 2 {
+		t.Fatalf("购物车没有恢复: %v", b.carts)
+	}
+	if b.called("ReleaseCoupons") {
+		t.Fatal("没有使用优惠券不应该调用营销服务")
+	}
+	if b.stocks[100] != 10 || b.stocks[101] != 10 {
+		t.Fatalf("库存没有归还: %v", b.stocks)
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 订单状态机 key是当前状态 value是允许流转到的状态，没有出现在key中的状态都是终态
+var orderStatusTransitions = map[proto.OrderStatusCode][]proto.OrderStatusCode{
+	proto.OrderStatusCode_WAIT_BUYER_PAY: {
+		proto.OrderStatusCode_PAYING,
+		proto.OrderStatusCode_TRADE_SUCCESS,
+		proto.OrderStatusCode_TRADE_CLOSED,
+		proto.OrderStatusCode_TRADE_FINISHED,
+	},
+	proto.OrderStatusCode_PAYING: {
+		proto.OrderStatusCode_WAIT_BUYER_PAY,
+		proto.OrderStatusCode_TRADE_SUCCESS,
+		proto.OrderStatusCode_TRADE_CLOSED,
+		proto.OrderStatusCode_TRADE_FINISHED,
+	},
+	proto.OrderStatusCode_TRADE_SUCCESS: {
+		proto.OrderStatusCode_TRADE_SHIPPED,
+		proto.OrderStatusCode_TRADE_FINISHED,
+		proto.OrderStatusCode_TRADE_REFUNDED,
+	},
+	// 所有商品都发货之后
+	proto.OrderStatusCode_TRADE_SHIPPED: {
+		proto.OrderStatusCode_TRADE_FINISHED,
+		proto.OrderStatusCode_TRADE_REFUNDED,
+	},
+	// 确认收货之后还可以申请售后
+	proto.OrderStatusCode_TRADE_FINISHED: {
+		proto.OrderStatusCode_TRADE_REFUNDED,
+	},
+}
+
+// ParseOrderStatus 把数据库中的状态字符串转换成枚举，历史数据中空的状态当作刚创建的订单
+func ParseOrderStatus(s string) proto.OrderStatusCode {
+	if s == "" {
+		return proto.OrderStatusCode_WAIT_BUYER_PAY
+	}
+	return proto.OrderStatusCode(proto.OrderStatusCode_value[s])
+}
+
+func CanTransitOrderStatus(from, to proto.OrderStatusCode) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangeOrderStatus 在事务中修改订单状态并记录状态流水
+// update语句带上了旧的状态作为条件(compare-and-set)，并发修改时只会有一个成功
+func ChangeOrderStatus(tx *gorm.DB, order *model.OrderInfo, to proto.OrderStatusCode, remark string) error {
+	from := ParseOrderStatus(order.Status)
+	if !CanTransitOrderStatus(from, to) {
+		return status.Errorf(codes.FailedPrecondition, "订单状态不能从%s变更为%s", from, to)
+	}
+
+	result := tx.Model(&model.OrderInfo{}).Where("id = ? and status = ?", order.ID, order.Status).Update("status", to.String())
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "修改订单状态失败")
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(codes.Aborted, "订单状态已被修改")
+	}
+
+	if err := recordOrderStatusHistory(tx, order, order.Status, to, remark); err != nil {
+		return err
+	}
+	order.Status = to.String()
+	return nil
+}
+
+func recordOrderStatusHistory(tx *gorm.DB, order *model.OrderInfo, from string, to proto.OrderStatusCode, remark string) error {
+	history := model.OrderStatusHistory{
+		Order:      order.ID,
+		OrderSn:    order.OrderSn,
+		User:       order.User,
+		FromStatus: from,
+		ToStatus:   to.String(),
+		Remark:     remark,
+	}
+	if result := tx.Create(&history); result.RowsAffected == 0 {
+		return status.Errorf(codes.Internal, "保存订单状态流水失败")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+)
+
+func TestCanTransitOrderStatus(t *testing.T) {
+	const (
+		created  = proto.OrderStatusCode_WAIT_BUYER_PAY
+		paying   = proto.OrderStatusCode_PAYING
+		paid     = proto.OrderStatusCode_TRADE_SUCCESS
+		shipped  = proto.OrderStatusCode_TRADE_SHIPPED
+		finished = proto.OrderStatusCode_TRADE_FINISHED
+		closed   = proto.OrderStatusCode_TRADE_CLOSED
+		refunded = proto.OrderStatusCode_TRADE_REFUNDED
+	)
+	all := []proto.OrderStatusCode{created, paying, paid, shipped, finished, closed, refunded}
+	allowed := map[proto.OrderStatusCode][]proto.OrderStatusCode{
+		created:  {paying, paid, closed, finished},
+		paying:   {created, paid, closed, finished},
+		paid:     {shipped, finished, refunded},
+		shipped:  {finished, refunded},
+		finished: {refunded},
+		// 关闭和全部退款是终态
+		closed:   nil,
+		refunded: nil,
+	}
+	for _, from := range all {
+		for _, to := range all {
+			want := false
+			for _, next := range allowed[from] {
+				want = want || next == to
+			}
+			if got := CanTransitOrderStatus(from, to); got != want {
+				t.Errorf("%s -> %s = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+
+	// 历史数据中空的状态当作刚创建的订单
+	if got := ParseOrderStatus(""); got != created {
+		t.Errorf("ParseOrderStatus(\"\") = %s", got)
+	}
+	if got := ParseOrderStatus("TRADE_SHIPPED"); got != shipped {
+		t.Errorf("ParseOrderStatus(TRADE_SHIPPED) = %s", got)
+	}
+}
+
+func TestChangeOrderStatus(t *testing.T) {
+	db := setupDB(t)
+	order := model.OrderInfo{User: 1, OrderSn: "status-1", Status: proto.OrderStatusCode_WAIT_BUYER_PAY.String()}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	stale := order
+
+	if err := ChangeOrderStatus(db, &order, proto.OrderStatusCode_PAYING, "发起支付"); err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != "PAYING" {
+		t.Fatalf("order status = %s", order.Status)
+	}
+	var histories []model.OrderStatusHistory
+	db.Where(&model.OrderStatusHistory{Order: order.ID}).Find(&histories)
+	if len(histories) != 1 || histories[0].FromStatus != "WAIT_BUYER_PAY" || histories[0].ToStatus != "PAYING" || histories[0].Remark != "发起支付" {
+		t.Fatalf("状态流水不正确: %+v", histories)
+	}
+
+	// 使用旧的状态修改， CAS失败
+	if err := ChangeOrderStatus(db, &stale, proto.OrderStatusCode_TRADE_CLOSED, "订单超时关闭"); status.Code(err) != codes.Aborted {
+		t.Fatalf("并发修改的时候应该返回Aborted, err = %v", err)
+	}
+	if stale.Status != "WAIT_BUYER_PAY" {
+		t.Fatalf("CAS失败之后不应该修改内存中的状态: %s", stale.Status)
+	}
+
+	// 终态不能再变更
+	if err := ChangeOrderStatus(db, &order, proto.OrderStatusCode_TRADE_CLOSED, "用户取消订单"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ChangeOrderStatus(db, &order, proto.OrderStatusCode_WAIT_BUYER_PAY, "重新打开"); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("非法的状态变更应该返回FailedPrecondition, err = %v", err)
+	}
+
+	var saved model.OrderInfo
+	db.First(&saved, order.ID)
+	db.Where(&model.OrderStatusHistory{Order: order.ID}).Find(&histories)
+	if saved.Status != "TRADE_CLOSED" || len(histories) != 2 {
+		t.Fatalf("status = %s, histories = %d", saved.Status, len(histories))
+	}
+
+	// 历史数据中状态为空的订单也能通过CAS修改
+	legacy := model.OrderInfo{User: 1, OrderSn: "status-2"}
+	if err := db.Create(&legacy).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := ChangeOrderStatus(db, &legacy, proto.OrderStatusCode_TRADE_CLOSED, "订单超时关闭"); err != nil {
+		t.Fatal(err)
+	}
+	db.First(&saved, legacy.ID)
+	if saved.Status != "TRADE_CLOSED" {
+		t.Fatalf("legacy status = %s", saved.Status)
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/config"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/mq"
+	"wshop_srvs/order_srv/outbox"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 没有配置支付时间的时候， 下单之后30分钟没有支付自动关闭
+const defaultPayTimeout = 30 * time.Minute
+
+// payTimeout 订单的支付时间， 订单中有多个分类的商品的时候使用最短的
+func payTimeout(c config.PayTimeoutConfig, goods []*proto.GoodsInfoResponse) time.Duration {
+	timeout := defaultPayTimeout
+	if c.Default > 0 {
+		timeout = time.Duration(c.Default) * time.Minute
+	}
+
+	categories := make(map[int32]time.Duration)
+	for _, category := range c.Categories {
+		if category.Timeout > 0 {
+			categories[category.CategoryId] = time.Duration(category.Timeout) * time.Minute
+		}
+	}
+	for _, good := range goods {
+		if t, ok := categories[good.CategoryId]; ok && t < timeout {
+			timeout = t
+		}
+	}
+	return timeout
+}
+
+// scheduleOrderTimeout 在事务中写入订单超时关闭的延时消息
+// 延时等级最长只有2小时， 消息中带上支付截止时间， 消费者收到之后时间还没有到的话会再调用一次
+func scheduleOrderTimeout(tx *gorm.DB, order *model.OrderInfo, now time.Time) error {
+	body, _ := json.Marshal(model.OrderInfo{OrderSn: order.OrderSn, ExpireAt: order.ExpireAt})
+	level := mq.DelayLevelFor(order.ExpireAt.Sub(now))
+	return outbox.Add(tx, "order_timeout", body, int32(level))
+}
+
+// formatExpireAt 返回给客户端的支付截止时间， 历史订单没有截止时间
+func formatExpireAt(order *model.OrderInfo) string {
+	if order.ExpireAt == nil {
+		return ""
+	}
+	return order.ExpireAt.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/config"
+	"wshop_srvs/order_srv/proto"
+)
+
+func TestPayTimeout(t *testing.T) {
+	c := config.PayTimeoutConfig{
+		Default: 60,
+		Categories: []config.CategoryPayTimeoutConfig{
+			{CategoryId: 10, Timeout: 5},  // 秒杀
+			{CategoryId: 20, Timeout: 90}, // 比默认时间长的不生效
+		},
+	}
+	cases := []struct {
+		categories []int32
+		want       time.Duration
+	}{
+		{[]int32{1}, time.Hour},
+		{[]int32{1, 10}, 5 * time.Minute},
+		{[]int32{20}, time.Hour},
+	}
+	for _, tc := range cases {
+		var goods []*proto.GoodsInfoResponse
+		for _, category := range tc.categories {
+			goods = append(goods, &proto.GoodsInfoResponse{CategoryId: category})
+		}
+		if got := payTimeout(c, goods); got != tc.want {
+			t.Errorf("categories %v: timeout = %s, want %s", tc.categories, got, tc.want)
+		}
+	}
+
+	if got := payTimeout(config.PayTimeoutConfig{}, nil); got != defaultPayTimeout {
+		t.Errorf("default timeout = %s", got)
+	}
+}
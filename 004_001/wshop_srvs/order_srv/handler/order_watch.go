@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/watch"
+)
+
+// watchFilter 只推送用户自己的订单， 指定了订单的时候只推送这些订单
+func watchFilter(req *proto.WatchOrdersRequest) func(*watch.Event) bool {
+	orderIds := make(map[int32]bool, len(req.OrderIds))
+	for _, id := range req.OrderIds {
+		orderIds[id] = true
+	}
+	return func(e *watch.Event) bool {
+		if e.UserId != req.UserId {
+			return false
+		}
+		return len(orderIds) == 0 || orderIds[e.OrderId]
+	}
+}
+
+func (*OrderServer) WatchOrders(req *proto.WatchOrdersRequest, stream proto.Order_WatchOrdersServer) error {
+	// 推送订阅之后的状态变化， 客户端订阅之后再查询一次订单详情， 避免漏掉订阅之前的变化
+	if req.UserId == 0 {
+		return status.Errorf(codes.InvalidArgument, "缺少用户信息")
+	}
+	if global.OrderWatcher == nil {
+		return status.Errorf(codes.Unavailable, "订单状态推送没有启动")
+	}
+
+	sub := global.OrderWatcher.Subscribe(watchFilter(req))
+	defer sub.Close()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-sub.C:
+			if !ok {
+				// 处理太慢或者服务正在退出， 客户端重新订阅
+				return status.Errorf(codes.Unavailable, "订阅已经断开， 请重新订阅")
+			}
+			if err := stream.Send(&proto.OrderEventResponse{
+				Id:         e.Id,
+				OrderId:    e.OrderId,
+				OrderSn:    e.OrderSn,
+				UserId:     e.UserId,
+				FromStatus: e.FromStatus,
+				Status:     e.ToStatus,
+				Remark:     e.Remark,
+				Time:       e.Time.Format("2006-01-02 15:04:05"),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
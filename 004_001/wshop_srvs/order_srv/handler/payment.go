@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/order_srv/config"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/outbox"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 历史订单和没有选择支付方式的订单都使用支付宝支付
+const DefaultPayType = "alipay"
+
+var payTypes = map[string]bool{"alipay": true, "wechat": true}
+
+// supportPayType 模拟支付只在开发环境开启， 线上环境的订单不能使用模拟支付
+func supportPayType(c config.PaymentConfig, payType string) bool {
+	if payType == "mock" {
+		return c.Mock
+	}
+	return payTypes[payType]
+}
+
+func paymentResponse(order *model.OrderInfo, payment *model.Payment) *proto.PaymentInfoResponse {
+	return &proto.PaymentInfoResponse{
+		Id:          payment.ID,
+		OrderId:     order.ID,
+		OrderSn:     order.OrderSn,
+		PayType:     payment.PayType,
+		AmountCents: payment.Amount.Cents(),
+		Status:      payment.Status,
+		ExpireAt:    formatExpireAt(order),
+	}
+}
+
+func (*OrderServer) CreatePayment(ctx context.Context, req *proto.PaymentRequest) (*proto.PaymentInfoResponse, error) {
+	/*
+		发起支付
+			1. 只有待支付的订单可以支付， 超过支付截止时间的订单等待超时关闭
+			2. 使用相同的支付方式重复发起支付的时候返回之前的记录
+			3. 切换支付方式的时候关闭之前的记录， web层需要关闭之前支付方式中创建的交易， 避免重复支付
+	*/
+	if req.PayType != "" && !supportPayType(global.ServerConfig.PaymentInfo, req.PayType) {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的支付方式: %s", req.PayType)
+	}
+
+	tx := global.DB.Begin()
+	var order model.OrderInfo
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.OrderId}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	switch ParseOrderStatus(order.Status) {
+	case proto.OrderStatusCode_WAIT_BUYER_PAY, proto.OrderStatusCode_PAYING:
+	default:
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单当前状态不能支付")
+	}
+	if order.ExpireAt != nil && time.Now().After(*order.ExpireAt) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单已经超过支付时间")
+	}
+
+	payType := req.PayType
+	if payType == "" {
+		payType = order.PayType
+	}
+	if payType == "" {
+		payType = DefaultPayType
+	}
+
+	var pending []model.Payment
+	if result := tx.Where(&model.Payment{Order: order.ID, Status: model.PAYMENT_PENDING}).Order("id desc").Find(&pending); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "查询支付记录失败")
+	}
+	if len(pending) > 0 && pending[0].PayType == payType {
+		tx.Commit()
+		return paymentResponse(&order, &pending[0]), nil
+	}
+
+	var previousPayType string
+	if len(pending) > 0 {
+		previousPayType = pending[0].PayType
+		if err := closePayments(tx, order.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	payment := model.Payment{
+		Order:   order.ID,
+		OrderSn: order.OrderSn,
+		PayType: payType,
+		Amount:  order.OrderMount,
+		Status:  model.PAYMENT_PENDING,
+	}
+	if result := tx.Create(&payment); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存支付记录失败")
+	}
+	if order.PayType != payType {
+		if result := tx.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("pay_type", payType); result.Error != nil {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "修改支付方式失败")
+		}
+	}
+	tx.Commit()
+
+	rsp := paymentResponse(&order, &payment)
+	rsp.PreviousPayType = previousPayType
+	return rsp, nil
+}
+
+// closePayments 关闭订单所有等待支付的记录， 切换支付方式和订单关闭的时候调用
+func closePayments(tx *gorm.DB, orderId int32) error {
+	result := tx.Model(&model.Payment{}).Where(&model.Payment{Order: orderId, Status: model.PAYMENT_PENDING}).Update("status", model.PAYMENT_CLOSED)
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "关闭支付记录失败")
+	}
+	return nil
+}
+
+// markPaymentPaid 支付成功之后保存交易号， 并把对应支付方式的记录标记为支付成功
+// 没有支付记录的时候(比如发起支付之前下的历史订单)补一条记录， 对账的时候每笔支付都能找到记录
+func markPaymentPaid(tx *gorm.DB, order *model.OrderInfo, payType, tradeNo string, payTime time.Time) error {
+	if payType == "" {
+		payType = order.PayType
+	}
+	if payType == "" {
+		payType = DefaultPayType
+	}
+
+	updates := map[string]interface{}{"pay_type": payType}
+	if tradeNo != "" {
+		updates["trade_no"] = tradeNo
+	}
+	if result := tx.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Updates(updates); result.Error != nil {
+		return status.Errorf(codes.Internal, "保存交易号失败")
+	}
+
+	// 同一种支付方式可能发起过多次， 只修改最后一次
+	var payments []model.Payment
+	if result := tx.Where(&model.Payment{Order: order.ID, PayType: payType}).Order("id desc").Limit(1).Find(&payments); result.Error != nil {
+		return status.Errorf(codes.Internal, "查询支付记录失败")
+	}
+	if len(payments) > 0 {
+		result := tx.Model(&model.Payment{}).Where("id = ?", payments[0].ID).
+			Updates(map[string]interface{}{"status": model.PAYMENT_SUCCESS, "trade_no": tradeNo, "pay_time": payTime})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "修改支付记录失败")
+		}
+		return nil
+	}
+
+	payment := model.Payment{
+		Order:   order.ID,
+		OrderSn: order.OrderSn,
+		PayType: payType,
+		Amount:  order.OrderMount,
+		Status:  model.PAYMENT_SUCCESS,
+		TradeNo: tradeNo,
+		PayTime: &payTime,
+	}
+	if result := tx.Create(&payment); result.RowsAffected == 0 {
+		return status.Errorf(codes.Internal, "保存支付记录失败")
+	}
+	return nil
+}
+
+// OrderPaidEvent 订单支付成功的消息， 积分、通知等下游服务订阅order_paid这个topic
+type OrderPaidEvent struct {
+	OrderId     int32  `json:"order_id"`
+	OrderSn     string `json:"order_sn"`
+	UserId      int32  `json:"user_id"`
+	PayType     string `json:"pay_type"`
+	TradeNo     string `json:"trade_no"`
+	AmountCents int64  `json:"amount_cents"`
+	PayTime     string `json:"pay_time"`
+}
+
+// payAppId 支付方式收款的应用， 支付通知中的应用不一致的时候说明通知不是发给我们的
+// 模拟支付的通知使用固定的应用， 没有开启模拟支付的时候不接受模拟支付的通知
+func payAppId(c config.PaymentConfig, payType string) string {
+	switch payType {
+	case "alipay":
+		return c.AliPayInfo.AppID
+	case "wechat":
+		return c.WechatPayInfo.AppID
+	case "mock":
+		if c.Mock {
+			return "mock"
+		}
+	}
+	return ""
+}
+
+func (*OrderServer) PayOrder(ctx context.Context, req *proto.PayOrderRequest) (*emptypb.Empty, error) {
+	/*
+		支付平台的支付结果通知
+			1. 校验收款的应用和支付金额， 校验失败的通知不处理
+			2. 支付成功的时候在同一个事务中修改订单状态， 保存交易号、支付方式和支付时间， 写入订单支付成功的消息
+			3. 重复的通知和乱序到达的通知(比如支付成功之后才收到的交易创建通知)直接返回成功
+			4. 已经关闭的订单或者已经使用其他交易支付过的订单收到支付成功通知的时候返回FailedPrecondition， 需要人工退款
+	*/
+	if appId := payAppId(global.ServerConfig.PaymentInfo, req.PayType); appId == "" || appId != req.AppId {
+		zap.S().Errorw("支付通知的收款应用不一致", "order_sn", req.OrderSn, "pay_type", req.PayType, "app_id", req.AppId)
+		return nil, status.Errorf(codes.InvalidArgument, "收款应用不一致")
+	}
+	if err := ApplyPayment(ctx, req, "支付成功"); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ApplyPayment 处理支付平台中的交易结果， 支付通知和对账补单共用， remark记录在订单状态流水中
+func ApplyPayment(ctx context.Context, req *proto.PayOrderRequest, remark string) error {
+	if !supportPayType(global.ServerConfig.PaymentInfo, req.PayType) {
+		return status.Errorf(codes.InvalidArgument, "不支持的支付方式: %s", req.PayType)
+	}
+
+	switch proto.OrderStatusCode(proto.OrderStatusCode_value[req.TradeStatus]) {
+	case proto.OrderStatusCode_TRADE_SUCCESS, proto.OrderStatusCode_TRADE_FINISHED:
+	case proto.OrderStatusCode_WAIT_BUYER_PAY:
+		// 交易创建的通知不影响订单状态
+		return nil
+	case proto.OrderStatusCode_TRADE_CLOSED:
+		// 支付平台的交易关闭不代表订单关闭， 用户可以切换支付方式重新支付， 订单由支付超时关闭
+		// 已经支付的交易全额退款之后也会收到交易关闭的通知， 只关闭还在等待支付的记录
+		result := global.DB.Model(&model.Payment{}).Where(&model.Payment{OrderSn: req.OrderSn, PayType: req.PayType, Status: model.PAYMENT_PENDING}).Update("status", model.PAYMENT_CLOSED)
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "关闭支付记录失败")
+		}
+		return nil
+	default:
+		return status.Errorf(codes.InvalidArgument, "交易状态不合法: %s", req.TradeStatus)
+	}
+
+	payTime := time.Now()
+	if req.PayTime != "" {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", req.PayTime, time.Local)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "支付时间格式错误")
+		}
+		payTime = t
+	}
+
+	// 锁住订单， 同一个订单的通知串行处理
+	tx := global.DB.Begin()
+	var order model.OrderInfo
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.OrderInfo{OrderSn: req.OrderSn}).First(&order); result.RowsAffected == 0 {
+		tx.Rollback()
+		return status.Errorf(codes.NotFound, "订单不存在")
+	}
+	if req.AmountCents != order.OrderMount.Cents() {
+		tx.Rollback()
+		zap.S().Errorw("支付金额和订单金额不一致", "order_sn", order.OrderSn, "amount", req.AmountCents, "order_amount", order.OrderMount.Cents())
+		return status.Errorf(codes.InvalidArgument, "支付金额和订单金额不一致")
+	}
+
+	switch ParseOrderStatus(order.Status) {
+	case proto.OrderStatusCode_WAIT_BUYER_PAY, proto.OrderStatusCode_PAYING:
+	case proto.OrderStatusCode_TRADE_CLOSED:
+		tx.Rollback()
+		zap.S().Errorw("已经关闭的订单收到支付成功通知， 需要退款", "order_sn", order.OrderSn, "pay_type", req.PayType, "trade_no", req.TradeNo)
+		return status.Errorf(codes.FailedPrecondition, "订单已经关闭")
+	default:
+		// 已经支付过的订单， 相同交易的通知是重复通知
+		if order.TradeNo == req.TradeNo {
+			tx.Rollback()
+			return nil
+		}
+		// 之前的版本没有保存交易号， 补上交易号
+		if order.TradeNo == "" {
+			if err := markPaymentPaid(tx, &order, req.PayType, req.TradeNo, payTime); err != nil {
+				tx.Rollback()
+				return err
+			}
+			tx.Commit()
+			return nil
+		}
+		tx.Rollback()
+		zap.S().Errorw("订单重复支付， 需要退款", "order_sn", order.OrderSn, "trade_no", order.TradeNo, "pay_type", req.PayType, "duplicate_trade_no", req.TradeNo)
+		return status.Errorf(codes.FailedPrecondition, "订单已经使用其他交易支付")
+	}
+
+	if err := ChangeOrderStatus(tx, &order, proto.OrderStatusCode_TRADE_SUCCESS, remark); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if result := tx.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("pay_time", payTime); result.Error != nil {
+		tx.Rollback()
+		return status.Errorf(codes.Internal, "保存支付时间失败")
+	}
+	if err := markPaymentPaid(tx, &order, req.PayType, req.TradeNo, payTime); err != nil {
+		tx.Rollback()
+		return err
+	}
+	// 支付成功之后核销优惠券， 失败的时候返回错误让支付平台重新通知
+	if order.DiscountAmount > 0 {
+		if _, err := global.PromotionSrvClient.UseCoupons(context.Background(), &proto.CouponOrderRequest{OrderSn: order.OrderSn}); err != nil {
+			tx.Rollback()
+			zap.S().Errorf("核销优惠券失败: %s", err.Error())
+			return status.Errorf(codes.Internal, "核销优惠券失败")
+		}
+	}
+
+	body, _ := json.Marshal(OrderPaidEvent{
+		OrderId:     order.ID,
+		OrderSn:     order.OrderSn,
+		UserId:      order.User,
+		PayType:     req.PayType,
+		TradeNo:     req.TradeNo,
+		AmountCents: req.AmountCents,
+		PayTime:     payTime.Format("2006-01-02 15:04:05"),
+	})
+	if err := outbox.Add(tx, "order_paid", body, 0); err != nil {
+		tx.Rollback()
+		zap.S().Errorf("保存订单支付消息失败: %s", err.Error())
+		return status.Errorf(codes.Internal, "保存订单支付消息失败")
+	}
+	tx.Commit()
+	// 对账命令行中没有启动relay， 消息由服务中的relay定时发送
+	if global.OutboxRelay != nil {
+		global.OutboxRelay.Notify()
+	}
+	global.OrderWatcher.Notify()
+	return nil
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/config"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+func TestPayAppId(t *testing.T) {
+	c := config.PaymentConfig{
+		AliPayInfo:    config.AlipayConfig{AppID: "2021000000000000"},
+		WechatPayInfo: config.WechatPayConfig{AppID: "wx0000000000000000"},
+	}
+	cases := map[string]string{
+		"alipay":  "2021000000000000",
+		"wechat":  "wx0000000000000000",
+		"mock":    "",
+		"unknown": "",
+	}
+	for payType, want := range cases {
+		if got := payAppId(c, payType); got != want {
+			t.Errorf("%s: app id = %q, want %q", payType, got, want)
+		}
+	}
+
+	// 没有配置微信支付的时候不接受微信支付的通知
+	if got := payAppId(config.PaymentConfig{}, "wechat"); got != "" {
+		t.Errorf("unconfigured wechat app id = %q", got)
+	}
+	// 只有开启了模拟支付才接受模拟支付的通知
+	if got := payAppId(config.PaymentConfig{Mock: true}, "mock"); got != "mock" {
+		t.Errorf("mock app id = %q", got)
+	}
+	if supportPayType(c, "mock") || !supportPayType(config.PaymentConfig{Mock: true}, "mock") {
+		t.Error("没有开启模拟支付的时候不能使用模拟支付")
+	}
+}
+
+// createPayingOrder 创建一个已经发起支付宝支付的订单
+func createPayingOrder(t *testing.T, db *gorm.DB, orderSn string, orderStatus proto.OrderStatusCode) model.OrderInfo {
+	t.Helper()
+	order := model.OrderInfo{User: 1, OrderSn: orderSn, PayType: "alipay", Status: orderStatus.String(), OrderMount: money.FromCents(1234)}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	payment := model.Payment{Order: order.ID, OrderSn: orderSn, PayType: "alipay", Amount: order.OrderMount, Status: model.PAYMENT_PENDING}
+	if err := db.Create(&payment).Error; err != nil {
+		t.Fatal(err)
+	}
+	return order
+}
+
+func paidNotify(orderSn, tradeNo string, amount int64) *proto.PayOrderRequest {
+	return &proto.PayOrderRequest{OrderSn: orderSn, PayType: "alipay", TradeNo: tradeNo, TradeStatus: "TRADE_SUCCESS", AmountCents: amount, PayTime: "2021-05-01 10:00:00"}
+}
+
+func TestApplyPayment(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	createPayingOrder(t, db, "paying", proto.OrderStatusCode_PAYING)
+	createPayingOrder(t, db, "closed", proto.OrderStatusCode_TRADE_CLOSED)
+	createPayingOrder(t, db, "amount", proto.OrderStatusCode_WAIT_BUYER_PAY)
+
+	tests := []struct {
+		name string
+		req  *proto.PayOrderRequest
+		code codes.Code
+	}{
+		{"amount mismatch", paidNotify("amount", "T1", 1233), codes.InvalidArgument},
+		{"pay", paidNotify("paying", "T2", 1234), codes.OK},
+		// 支付成功之后才到达的交易创建通知不影响订单状态
+		{"late wait pay", &proto.PayOrderRequest{OrderSn: "paying", PayType: "alipay", TradeNo: "T2", TradeStatus: "WAIT_BUYER_PAY", AmountCents: 1234}, codes.OK},
+		{"duplicate", paidNotify("paying", "T2", 1234), codes.OK},
+		{"other trade", paidNotify("paying", "T3", 1234), codes.FailedPrecondition},
+		{"after close", paidNotify("closed", "T4", 1234), codes.FailedPrecondition},
+		{"unknown order", paidNotify("unknown", "T5", 1234), codes.NotFound},
+	}
+	for _, tt := range tests {
+		if err := ApplyPayment(ctx, tt.req, "支付成功"); status.Code(err) != tt.code {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+
+	var order model.OrderInfo
+	db.Where(&model.OrderInfo{OrderSn: "paying"}).First(&order)
+	if order.Status != proto.OrderStatusCode_TRADE_SUCCESS.String() || order.TradeNo != "T2" || order.PayTime == nil {
+		t.Errorf("支付成功的订单 status=%s tradeNo=%s payTime=%v", order.Status, order.TradeNo, order.PayTime)
+	}
+	var payment model.Payment
+	db.Where(&model.Payment{Order: order.ID}).First(&payment)
+	if payment.Status != model.PAYMENT_SUCCESS || payment.TradeNo != "T2" {
+		t.Errorf("支付记录 status=%d tradeNo=%s", payment.Status, payment.TradeNo)
+	}
+	// 重复的通知只写入一条状态流水和一条支付成功的消息
+	var histories, messages int64
+	db.Model(&model.OrderStatusHistory{}).Where(&model.OrderStatusHistory{Order: order.ID}).Count(&histories)
+	db.Model(&model.OutboxMessage{}).Where("topic = ?", "order_paid").Count(&messages)
+	if histories != 1 || messages != 1 {
+		t.Errorf("状态流水 = %d, 支付消息 = %d, want 1 1", histories, messages)
+	}
+
+	for _, orderSn := range []string{"amount", "closed"} {
+		var order model.OrderInfo
+		db.Where(&model.OrderInfo{OrderSn: orderSn}).First(&order)
+		if order.TradeNo != "" {
+			t.Errorf("%s: 校验失败的通知保存了交易号 %s", orderSn, order.TradeNo)
+		}
+	}
+}
+
+func TestUpdateOrderStatus(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	order := createPayingOrder(t, db, "o1", proto.OrderStatusCode_WAIT_BUYER_PAY)
+	db.Model(&order).Update("discount_amount", money.FromCents(500))
+	s := &OrderServer{}
+
+	tests := []struct {
+		name   string
+		status string
+		code   codes.Code
+	}{
+		// 支付成功只能通过支付通知修改
+		{"success", "TRADE_SUCCESS", codes.FailedPrecondition},
+		{"finished before pay", "TRADE_FINISHED", codes.FailedPrecondition},
+		{"refunded", "TRADE_REFUNDED", codes.FailedPrecondition},
+		{"unknown", "UNKNOWN", codes.InvalidArgument},
+		{"paying", "PAYING", codes.OK},
+		{"close", "TRADE_CLOSED", codes.OK},
+		{"shipped after close", "TRADE_SHIPPED", codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		_, err := s.UpdateOrderStatus(ctx, &proto.OrderStatus{OrderSn: "o1", Status: tt.status, TradeNo: "T1", PayType: "alipay"})
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+
+	order = model.OrderInfo{}
+	db.Where(&model.OrderInfo{OrderSn: "o1"}).First(&order)
+	if order.Status != proto.OrderStatusCode_TRADE_CLOSED.String() || order.TradeNo != "" || order.PayTime != nil {
+		t.Errorf("订单 status=%s tradeNo=%s payTime=%v", order.Status, order.TradeNo, order.PayTime)
+	}
+	var payment model.Payment
+	db.Where(&model.Payment{Order: order.ID}).First(&payment)
+	if payment.Status != model.PAYMENT_CLOSED {
+		t.Errorf("关闭订单之后支付记录的状态 = %d", payment.Status)
+	}
+	// 和取消订单一样归还库存和优惠券， 重复关闭不会再写入消息
+	if _, err := s.UpdateOrderStatus(ctx, &proto.OrderStatus{OrderSn: "o1", Status: "TRADE_CLOSED"}); err != nil {
+		t.Fatal(err)
+	}
+	if topics := outboxTopics(t, db); topics["order_reback"] != 1 || topics["order_coupon_release"] != 1 {
+		t.Errorf("关闭订单之后 outbox topics = %v", topics)
+	}
+}
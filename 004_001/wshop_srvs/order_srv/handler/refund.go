@@ -0,0 +1,431 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// 除了被拒绝的退款单， 其他状态的退款单都占用了订单商品的可退数量
+var refundOccupiedStatus = []int32{model.REFUND_PENDING, model.REFUND_APPROVED, model.REFUND_SUCCESS, model.REFUND_FAILED}
+
+func (*OrderServer) CreateRefund(ctx context.Context, req *proto.RefundRequest) (*proto.RefundInfoResponse, error) {
+	/*
+		申请退款
+			1. 只有支付成功之后的订单才能申请退款
+			2. 全额退款会退掉所有还没有申请过退款的商品
+			3. 部分退款按照订单的商品行退款， 每一行的退款数量不能超过剩余可退的数量
+	*/
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少用户信息")
+	}
+	if req.RefundType != model.REFUND_TYPE_FULL && req.RefundType != model.REFUND_TYPE_PARTIAL {
+		return nil, status.Errorf(codes.InvalidArgument, "退款类型不合法")
+	}
+
+	tx := global.DB.Begin()
+	// 锁住订单， 防止同一个订单并发申请退款超出可退数量
+	var order model.OrderInfo
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.OrderId}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	if !CanTransitOrderStatus(ParseOrderStatus(order.Status), proto.OrderStatusCode_TRADE_REFUNDED) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单当前状态不能申请退款")
+	}
+
+	var orderGoods []model.OrderGoods
+	if result := tx.Where(&model.OrderGoods{Order: order.ID}).Find(&orderGoods); result.Error != nil {
+		tx.Rollback()
+		return nil, result.Error
+	}
+	refunded, err := refundedGoodsNums(tx, order.ID, refundOccupiedStatus)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	remains := make(map[int32]int32)
+	orderGoodsMap := make(map[int32]model.OrderGoods)
+	for _, orderGood := range orderGoods {
+		remains[orderGood.ID] = orderGood.Nums - refunded[orderGood.ID]
+		orderGoodsMap[orderGood.ID] = orderGood
+	}
+
+	var refundGoods []*model.RefundGoods
+	if req.RefundType == model.REFUND_TYPE_FULL {
+		for _, orderGood := range orderGoods {
+			if remains[orderGood.ID] > 0 {
+				refundGoods = append(refundGoods, newRefundGoods(orderGood, remains[orderGood.ID]))
+			}
+		}
+	} else {
+		for _, item := range req.Goods {
+			orderGood, ok := orderGoodsMap[item.OrderGoodsId]
+			if !ok {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "订单中没有商品行%d", item.OrderGoodsId)
+			}
+			if item.Nums <= 0 || item.Nums > remains[orderGood.ID] {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "%s 的退款数量超出可退数量", orderGood.GoodsName)
+			}
+			// 同一个商品行传了多次的时候也要累计判断
+			remains[orderGood.ID] -= item.Nums
+			refundGoods = append(refundGoods, newRefundGoods(orderGood, item.Nums))
+		}
+	}
+	if len(refundGoods) == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "没有可以退款的商品")
+	}
+
+	// 退款单号和订单号使用同一个生成器， 不会和订单号重复
+	refundSn, err := global.OrderSnGenerator.Next()
+	if err != nil {
+		tx.Rollback()
+		zap.S().Errorf("生成退款单号失败: %s", err.Error())
+		return nil, status.Error(codes.Internal, "生成退款单号失败")
+	}
+
+	var amount money.Money
+	for _, refundGood := range refundGoods {
+		amount = amount.Add(refundGood.GoodsPrice.Mul(refundGood.Nums))
+	}
+	// 使用了优惠券的订单按照商品实付金额的比例退款， 向下取整保证多次部分退款的总额不会超过实付金额
+	// 运费不退
+	if order.DiscountAmount > 0 {
+		goodsPaid := order.OrderMount.Sub(order.Freight)
+		goodsAmount := goodsPaid.Add(order.DiscountAmount)
+		amount = money.FromCents(amount.Cents() * goodsPaid.Cents() / goodsAmount.Cents())
+	}
+	refund := model.Refund{
+		User:       req.UserId,
+		Order:      order.ID,
+		OrderSn:    order.OrderSn,
+		RefundSn:   refundSn,
+		RefundType: req.RefundType,
+		Status:     model.REFUND_PENDING,
+		Reason:     req.Reason,
+		Images:     req.Images,
+		Amount:     amount,
+	}
+	if result := tx.Create(&refund); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "创建退款单失败")
+	}
+	for _, refundGood := range refundGoods {
+		refundGood.Refund = refund.ID
+	}
+	if result := tx.CreateInBatches(refundGoods, 100); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存退款商品失败")
+	}
+	tx.Commit()
+
+	return refundInfoResponse(&refund, refundGoods), nil
+}
+
+func (*OrderServer) RefundList(ctx context.Context, req *proto.RefundFilterRequest) (*proto.RefundListResponse, error) {
+	var refunds []model.Refund
+	var rsp proto.RefundListResponse
+
+	// 用户id为0的时候是后台管理系统查询所有的退款单
+	filter := &model.Refund{User: req.UserId, Status: req.Status}
+	var total int64
+	global.DB.Model(&model.Refund{}).Where(filter).Count(&total)
+	rsp.Total = int32(total)
+
+	if result := global.DB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Where(filter).Order("id desc").Find(&refunds); result.Error != nil {
+		return nil, result.Error
+	}
+	if len(refunds) == 0 {
+		return &rsp, nil
+	}
+
+	var refundIds []int32
+	for _, refund := range refunds {
+		refundIds = append(refundIds, refund.ID)
+	}
+	var refundGoods []*model.RefundGoods
+	if result := global.DB.Where("refund in ?", refundIds).Find(&refundGoods); result.Error != nil {
+		return nil, result.Error
+	}
+	goodsMap := make(map[int32][]*model.RefundGoods)
+	for _, refundGood := range refundGoods {
+		goodsMap[refundGood.Refund] = append(goodsMap[refundGood.Refund], refundGood)
+	}
+
+	for i := range refunds {
+		rsp.Data = append(rsp.Data, refundInfoResponse(&refunds[i], goodsMap[refunds[i].ID]))
+	}
+	return &rsp, nil
+}
+
+func (*OrderServer) RefundDetail(ctx context.Context, req *proto.RefundRequest) (*proto.RefundInfoResponse, error) {
+	// 和订单详情一样， 电商系统需要传递用户id， 后台管理系统只传递退款单id
+	var refund model.Refund
+	if result := global.DB.Where(&model.Refund{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId}).First(&refund); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "退款单不存在")
+	}
+
+	var refundGoods []*model.RefundGoods
+	if result := global.DB.Where(&model.RefundGoods{Refund: refund.ID}).Find(&refundGoods); result.Error != nil {
+		return nil, result.Error
+	}
+	return refundInfoResponse(&refund, refundGoods), nil
+}
+
+func (*OrderServer) AuditRefund(ctx context.Context, req *proto.RefundAuditRequest) (*proto.RefundInfoResponse, error) {
+	/*
+		审核退款
+			拒绝： 退款单直接变为审核拒绝
+			通过： 1. 调用库存服务归还退款商品的库存 2. 调用支付渠道原路退款 3. 所有商品都退完之后订单变为全部退款
+			退款失败的退款单可以再次审核通过重新发起退款， 已经归还过的库存不会重复归还
+			停留在审核通过状态的退款单(处理过程中服务重启等)也可以再次审核通过继续处理
+			库存服务按照退款单号归还， 支付渠道按照退款单号退款， 重试不会重复归还和重复退款
+	*/
+	var refund model.Refund
+	if result := global.DB.Where(&model.Refund{BaseModel: model.BaseModel{ID: req.Id}}).First(&refund); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "退款单不存在")
+	}
+
+	if !req.Approved {
+		if refund.Status != model.REFUND_PENDING {
+			return nil, status.Errorf(codes.FailedPrecondition, "退款单已经审核过了")
+		}
+		if err := changeRefundStatus(&refund, model.REFUND_REJECTED, req.AdminRemark); err != nil {
+			return nil, err
+		}
+		return refundDetail(&refund)
+	}
+
+	switch refund.Status {
+	case model.REFUND_PENDING, model.REFUND_FAILED:
+		if err := changeRefundStatus(&refund, model.REFUND_APPROVED, req.AdminRemark); err != nil {
+			return nil, err
+		}
+	case model.REFUND_APPROVED:
+		// 已经是审核通过的状态， 直接重试
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "退款单已经审核过了")
+	}
+	if err := processRefund(ctx, &refund); err != nil {
+		return nil, err
+	}
+	return refundDetail(&refund)
+}
+
+// changeRefundStatus 和订单状态一样使用旧的状态作为条件更新， 并发审核只有一个会成功
+func changeRefundStatus(refund *model.Refund, to int32, adminRemark string) error {
+	result := global.DB.Model(&model.Refund{}).Where("id = ? and status = ?", refund.ID, refund.Status).Updates(map[string]interface{}{
+		"status":       to,
+		"admin_remark": adminRemark,
+	})
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "修改退款单状态失败")
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(codes.Aborted, "退款单状态已被修改")
+	}
+	refund.Status = to
+	refund.AdminRemark = adminRemark
+	return nil
+}
+
+func processRefund(ctx context.Context, refund *model.Refund) error {
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: refund.Order}}).First(&order); result.RowsAffected == 0 {
+		return status.Errorf(codes.NotFound, "订单不存在")
+	}
+	var refundGoods []*model.RefundGoods
+	if result := global.DB.Where(&model.RefundGoods{Refund: refund.ID}).Find(&refundGoods); result.Error != nil {
+		return result.Error
+	}
+
+	// 归还库存
+	if !refund.StockReturned {
+		var goodsInvInfo []*proto.GoodsInvInfo
+		for _, refundGood := range refundGoods {
+			goodsInvInfo = append(goodsInvInfo, &proto.GoodsInvInfo{
+				GoodsId: refundGood.Goods,
+				Num:     refundGood.Nums,
+			})
+		}
+		// 以退款单号作为归还单号， 库存服务保证同一个退款单只归还一次
+		if _, err := global.InventorySrvClient.Reback(ctx, &proto.SellInfo{OrderSn: refund.OrderSn, RebackSn: refund.RefundSn, GoodsInfo: goodsInvInfo}); err != nil {
+			zap.S().Errorf("退款单 %s 归还库存失败: %s", refund.RefundSn, err.Error())
+			return failRefund(refund, "归还库存失败")
+		}
+		if result := global.DB.Model(&model.Refund{}).Where("id = ?", refund.ID).Update("stock_returned", true); result.Error != nil {
+			// 库存已经归还了， 重试的时候库存服务不会重复归还
+			zap.S().Errorf("退款单 %s 保存库存归还状态失败: %s", refund.RefundSn, result.Error.Error())
+			return failRefund(refund, "保存库存归还状态失败")
+		}
+		refund.StockReturned = true
+	}
+
+	// 原路退款， 历史订单没有记录支付方式的都是支付宝支付的
+	payType := order.PayType
+	if payType == "" {
+		payType = "alipay"
+	}
+	provider, err := payment.Get(payType)
+	if err != nil {
+		return failRefund(refund, err.Error())
+	}
+	refundResult, err := provider.Refund(ctx, &payment.RefundRequest{
+		OrderSn:  order.OrderSn,
+		TradeNo:  order.TradeNo,
+		RefundSn: refund.RefundSn,
+		Amount:   refund.Amount,
+		Total:    order.OrderMount,
+		Reason:   refund.Reason,
+	})
+	if err != nil {
+		zap.S().Errorf("退款单 %s 调用支付渠道退款失败: %s", refund.RefundSn, err.Error())
+		return failRefund(refund, err.Error())
+	}
+
+	now := time.Now()
+	tx := global.DB.Begin()
+	// 钱已经退出去了， 并发重试中失败的一方把退款单改成了退款失败也要改回退款成功
+	result := tx.Model(&model.Refund{}).Where("id = ? and status in ?", refund.ID, []int32{model.REFUND_APPROVED, model.REFUND_FAILED}).Updates(map[string]interface{}{
+		"status":          model.REFUND_SUCCESS,
+		"refund_trade_no": refundResult.RefundTradeNo,
+		"refund_time":     &now,
+		"fail_reason":     "",
+	})
+	if result.Error != nil {
+		tx.Rollback()
+		return status.Errorf(codes.Internal, "修改退款单状态失败")
+	}
+	refund.Status = model.REFUND_SUCCESS
+	refund.FailReason = ""
+	if result.RowsAffected == 0 {
+		// 并发的重试已经处理完成了
+		tx.Rollback()
+		return nil
+	}
+
+	// 订单中的商品全部退款成功之后， 订单变为全部退款
+	refunded, err := refundedGoodsNums(tx, order.ID, []int32{model.REFUND_SUCCESS})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	var orderGoods []model.OrderGoods
+	tx.Where(&model.OrderGoods{Order: order.ID}).Find(&orderGoods)
+	allRefunded := len(orderGoods) > 0
+	for _, orderGood := range orderGoods {
+		if refunded[orderGood.ID] < orderGood.Nums {
+			allRefunded = false
+			break
+		}
+	}
+	if allRefunded {
+		// 钱已经退出去了， 订单状态修改失败也不能影响退款单的结果
+		if err := ChangeOrderStatus(tx, &order, proto.OrderStatusCode_TRADE_REFUNDED, "售后退款"); err != nil {
+			zap.S().Warnf("订单 %s 修改为全部退款失败: %s", order.OrderSn, err.Error())
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
+// failRefund 记录退款失败的原因， 退款单可以再次审核通过重试
+// 只修改审核通过的退款单， 不会覆盖并发重试已经成功的结果
+func failRefund(refund *model.Refund, reason string) error {
+	if result := global.DB.Model(&model.Refund{}).Where("id = ? and status = ?", refund.ID, model.REFUND_APPROVED).Updates(map[string]interface{}{
+		"status":      model.REFUND_FAILED,
+		"fail_reason": reason,
+	}); result.Error != nil {
+		zap.S().Errorf("退款单 %s 保存失败原因失败: %s", refund.RefundSn, result.Error.Error())
+	}
+	refund.Status = model.REFUND_FAILED
+	refund.FailReason = reason
+	return status.Errorf(codes.Internal, "退款失败: %s", reason)
+}
+
+// refundedGoodsNums 统计订单中每个商品行在指定状态的退款单中的数量
+func refundedGoodsNums(db *gorm.DB, orderId int32, statuses []int32) (map[int32]int32, error) {
+	var rows []struct {
+		OrderGoods int32
+		Nums       int32
+	}
+	result := db.Model(&model.RefundGoods{}).
+		Select("refundgoods.order_goods, sum(refundgoods.nums) as nums").
+		Joins("join refund on refund.id = refundgoods.refund and refund.deleted_at is null").
+		Where("refund.`order` = ? and refund.status in ?", orderId, statuses).
+		Group("refundgoods.order_goods").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询已退款商品失败")
+	}
+
+	nums := make(map[int32]int32)
+	for _, row := range rows {
+		nums[row.OrderGoods] = row.Nums
+	}
+	return nums, nil
+}
+
+func refundDetail(refund *model.Refund) (*proto.RefundInfoResponse, error) {
+	var refundGoods []*model.RefundGoods
+	if result := global.DB.Where(&model.RefundGoods{Refund: refund.ID}).Find(&refundGoods); result.Error != nil {
+		return nil, result.Error
+	}
+	return refundInfoResponse(refund, refundGoods), nil
+}
+
+func newRefundGoods(orderGood model.OrderGoods, nums int32) *model.RefundGoods {
+	return &model.RefundGoods{
+		OrderGoods: orderGood.ID,
+		Goods:      orderGood.Goods,
+		GoodsName:  orderGood.GoodsName,
+		GoodsPrice: orderGood.GoodsPrice,
+		Nums:       nums,
+	}
+}
+
+func refundInfoResponse(refund *model.Refund, refundGoods []*model.RefundGoods) *proto.RefundInfoResponse {
+	rsp := proto.RefundInfoResponse{
+		Id:          refund.ID,
+		UserId:      refund.User,
+		OrderId:     refund.Order,
+		OrderSn:     refund.OrderSn,
+		RefundSn:    refund.RefundSn,
+		RefundType:  refund.RefundType,
+		Status:      refund.Status,
+		Reason:      refund.Reason,
+		Images:      refund.Images,
+		Amount:      refund.Amount.Yuan(),
+		AmountCents: refund.Amount.Cents(),
+		AdminRemark: refund.AdminRemark,
+		FailReason:  refund.FailReason,
+		AddTime:     refund.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	for _, refundGood := range refundGoods {
+		rsp.Goods = append(rsp.Goods, &proto.RefundGoodsResponse{
+			Id:              refundGood.ID,
+			OrderGoodsId:    refundGood.OrderGoods,
+			GoodsId:         refundGood.Goods,
+			GoodsName:       refundGood.GoodsName,
+			GoodsPrice:      refundGood.GoodsPrice.Yuan(),
+			GoodsPriceCents: refundGood.GoodsPrice.Cents(),
+			Nums:            refundGood.Nums,
+		})
+	}
+	return &rsp
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// fakeInventory 记录Reback的请求， 和库存服务一样同一个rebackSn只归还一次
+type fakeInventory struct {
+	proto.InventoryClient
+	fail     bool
+	rebacked map[string]int32
+}
+
+func (f *fakeInventory) Reback(ctx context.Context, in *proto.SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if f.fail {
+		return nil, errors.New("库存服务不可用")
+	}
+	if _, ok := f.rebacked[in.RebackSn]; !ok {
+		for _, goods := range in.GoodsInfo {
+			f.rebacked[in.RebackSn] += goods.Num
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// createPaidOrder 创建一个使用模拟支付完成支付的订单， 一个商品行
+func createPaidOrder(t *testing.T, db *gorm.DB, orderSn string, nums int32) (model.OrderInfo, model.OrderGoods) {
+	t.Helper()
+	order := model.OrderInfo{User: 1, OrderSn: orderSn, PayType: "refundtest", Status: proto.OrderStatusCode_TRADE_SUCCESS.String(),
+		TradeNo: "T" + orderSn, OrderMount: money.FromCents(1000).Mul(nums)}
+	if err := db.Create(&order).Error; err != nil {
+		t.Fatal(err)
+	}
+	orderGoods := model.OrderGoods{Order: order.ID, Goods: 1, GoodsName: "商品", GoodsPrice: money.FromCents(1000), Nums: nums}
+	if err := db.Create(&orderGoods).Error; err != nil {
+		t.Fatal(err)
+	}
+	return order, orderGoods
+}
+
+func TestAuditRefundRetry(t *testing.T) {
+	db := setupDB(t)
+	inventory := &fakeInventory{fail: true, rebacked: make(map[string]int32)}
+	global.InventorySrvClient = inventory
+	payment.Register("refundtest", payment.NewMockProvider())
+	order, orderGoods := createPaidOrder(t, db, "o1", 2)
+
+	refund := model.Refund{User: 1, Order: order.ID, OrderSn: order.OrderSn, RefundSn: "r1", RefundType: model.REFUND_TYPE_FULL,
+		Status: model.REFUND_PENDING, Amount: money.FromCents(2000)}
+	db.Create(&refund)
+	db.Create(&model.RefundGoods{Refund: refund.ID, OrderGoods: orderGoods.ID, Goods: 1, GoodsPrice: money.FromCents(1000), Nums: 2})
+
+	s := &OrderServer{}
+	ctx := context.Background()
+	// 库存服务不可用， 退款失败
+	if _, err := s.AuditRefund(ctx, &proto.RefundAuditRequest{Id: refund.ID, Approved: true}); err == nil {
+		t.Fatal("库存服务不可用的时候退款成功了")
+	}
+	db.First(&refund, refund.ID)
+	if refund.Status != model.REFUND_FAILED || refund.StockReturned {
+		t.Fatalf("status=%d stockReturned=%v, want 退款失败", refund.Status, refund.StockReturned)
+	}
+
+	// 服务在处理过程中重启， 退款单停留在审核通过， 库存已经归还但是没有记录下来
+	inventory.fail = false
+	inventory.rebacked["r1"] = 2
+	db.Model(&model.Refund{}).Where("id = ?", refund.ID).Update("status", model.REFUND_APPROVED)
+
+	rsp, err := s.AuditRefund(ctx, &proto.RefundAuditRequest{Id: refund.ID, Approved: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Status != model.REFUND_SUCCESS {
+		t.Errorf("退款单状态 = %d, want %d", rsp.Status, model.REFUND_SUCCESS)
+	}
+	if len(inventory.rebacked) != 1 || inventory.rebacked["r1"] != 2 {
+		t.Errorf("归还的库存 = %v, 同一个退款单只能归还一次", inventory.rebacked)
+	}
+	db.First(&order, order.ID)
+	if order.Status != proto.OrderStatusCode_TRADE_REFUNDED.String() {
+		t.Errorf("订单状态 = %s, want TRADE_REFUNDED", order.Status)
+	}
+
+	// 退款成功之后不能再次审核
+	if _, err := s.AuditRefund(ctx, &proto.RefundAuditRequest{Id: refund.ID, Approved: true}); err == nil {
+		t.Error("退款成功之后再次审核通过成功了")
+	}
+}
@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/logistics"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 物流轨迹的拉取间隔， 查询物流信息的时候距离上次拉取超过这个时间才会重新拉取
+const trackingRefreshInterval = 10 * time.Minute
+
+func (*OrderServer) ShipOrder(ctx context.Context, req *proto.ShipOrderRequest) (*proto.ShipmentInfoResponse, error) {
+	/*
+		订单发货
+			1. 可以按照订单商品行拆分成多个包裹发货， 没有指定商品的时候发出所有还没有发货的商品
+			2. 已经退款成功的商品不需要再发货
+			3. 所有商品都发货之后订单变为已发货
+	*/
+	if req.TrackingNo == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "运单号不能为空")
+	}
+	if _, err := logistics.Get(req.Carrier); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx := global.DB.Begin()
+	// 锁住订单， 防止并发发货超出购买数量
+	var order model.OrderInfo
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.OrderId}}).First(&order); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	if !CanTransitOrderStatus(ParseOrderStatus(order.Status), proto.OrderStatusCode_TRADE_SHIPPED) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "订单当前状态不能发货")
+	}
+
+	var orderGoods []model.OrderGoods
+	if result := tx.Where(&model.OrderGoods{Order: order.ID}).Find(&orderGoods); result.Error != nil {
+		tx.Rollback()
+		return nil, result.Error
+	}
+	remains, err := unshippedGoodsNums(tx, order.ID, orderGoods)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	orderGoodsMap := make(map[int32]model.OrderGoods)
+	for _, orderGood := range orderGoods {
+		orderGoodsMap[orderGood.ID] = orderGood
+	}
+
+	var shipmentGoods []*model.ShipmentGoods
+	if len(req.Goods) == 0 {
+		for _, orderGood := range orderGoods {
+			if remains[orderGood.ID] > 0 {
+				shipmentGoods = append(shipmentGoods, newShipmentGoods(orderGood, remains[orderGood.ID]))
+				remains[orderGood.ID] = 0
+			}
+		}
+	} else {
+		for _, item := range req.Goods {
+			orderGood, ok := orderGoodsMap[item.OrderGoodsId]
+			if !ok {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "订单中没有商品行%d", item.OrderGoodsId)
+			}
+			if item.Nums <= 0 || item.Nums > remains[orderGood.ID] {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "%s 的发货数量超出待发货数量", orderGood.GoodsName)
+			}
+			remains[orderGood.ID] -= item.Nums
+			shipmentGoods = append(shipmentGoods, newShipmentGoods(orderGood, item.Nums))
+		}
+	}
+	if len(shipmentGoods) == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "没有需要发货的商品")
+	}
+
+	now := time.Now()
+	shipment := model.Shipment{
+		Order:      order.ID,
+		OrderSn:    order.OrderSn,
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+		Status:     model.SHIPMENT_SHIPPED,
+		ShipTime:   &now,
+	}
+	if result := tx.Create(&shipment); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "创建包裹失败")
+	}
+	for _, shipmentGood := range shipmentGoods {
+		shipmentGood.Shipment = shipment.ID
+	}
+	if result := tx.CreateInBatches(shipmentGoods, 100); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "保存包裹商品失败")
+	}
+
+	allShipped := true
+	for _, remain := range remains {
+		if remain > 0 {
+			allShipped = false
+			break
+		}
+	}
+	if allShipped {
+		if err := ChangeOrderStatus(tx, &order, proto.OrderStatusCode_TRADE_SHIPPED, fmt.Sprintf("订单发货 %s %s", req.Carrier, req.TrackingNo)); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		tx.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("ship_time", &now)
+	}
+	tx.Commit()
+
+	return shipmentInfoResponse(&shipment, shipmentGoods, nil), nil
+}
+
+func (*OrderServer) ShipmentList(ctx context.Context, req *proto.OrderRequest) (*proto.ShipmentListResponse, error) {
+	// 和订单详情一样， 电商系统需要传递用户id， 后台管理系统只传递订单id
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+
+	var shipments []model.Shipment
+	if result := global.DB.Where(&model.Shipment{Order: order.ID}).Order("id").Find(&shipments); result.Error != nil {
+		return nil, result.Error
+	}
+
+	var rsp proto.ShipmentListResponse
+	rsp.Total = int32(len(shipments))
+	for i := range shipments {
+		shipment := &shipments[i]
+		// 拉取物流轨迹失败不影响查询， 返回已经保存的轨迹
+		if err := refreshTracking(ctx, shipment); err != nil {
+			zap.S().Warnf("包裹 %s %s 拉取物流轨迹失败: %s", shipment.Carrier, shipment.TrackingNo, err.Error())
+		}
+
+		var shipmentGoods []*model.ShipmentGoods
+		if result := global.DB.Where(&model.ShipmentGoods{Shipment: shipment.ID}).Find(&shipmentGoods); result.Error != nil {
+			return nil, result.Error
+		}
+		var events []model.TrackingEvent
+		if result := global.DB.Where(&model.TrackingEvent{Shipment: shipment.ID}).Order("event_time, id").Find(&events); result.Error != nil {
+			return nil, result.Error
+		}
+		rsp.Data = append(rsp.Data, shipmentInfoResponse(shipment, shipmentGoods, events))
+	}
+	return &rsp, nil
+}
+
+// refreshTracking 通过快递公司的适配器拉取物流轨迹， 把还没有保存过的轨迹追加到轨迹表中
+func refreshTracking(ctx context.Context, shipment *model.Shipment) error {
+	if shipment.Status == model.SHIPMENT_SIGNED {
+		return nil
+	}
+	if shipment.TrackedAt != nil && time.Since(*shipment.TrackedAt) < trackingRefreshInterval {
+		return nil
+	}
+
+	carrier, err := logistics.Get(shipment.Carrier)
+	if err != nil {
+		return err
+	}
+	var shipTime time.Time
+	if shipment.ShipTime != nil {
+		shipTime = *shipment.ShipTime
+	}
+	events, err := carrier.Track(ctx, &logistics.TrackRequest{
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+		ShipTime:   shipTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	var saved []model.TrackingEvent
+	global.DB.Where(&model.TrackingEvent{Shipment: shipment.ID}).Find(&saved)
+	exists := make(map[string]bool)
+	for _, event := range saved {
+		exists[trackingEventKey(*event.EventTime, event.Status, event.Description)] = true
+	}
+
+	signed := false
+	var newEvents []*model.TrackingEvent
+	for _, event := range events {
+		if event.Status == logistics.StatusSigned {
+			signed = true
+		}
+		key := trackingEventKey(event.Time, event.Status, event.Description)
+		if exists[key] {
+			continue
+		}
+		exists[key] = true
+		eventTime := event.Time
+		newEvents = append(newEvents, &model.TrackingEvent{
+			Shipment:    shipment.ID,
+			Status:      event.Status,
+			Description: event.Description,
+			Location:    event.Location,
+			EventTime:   &eventTime,
+		})
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{"tracked_at": &now}
+	if signed {
+		updates["status"] = model.SHIPMENT_SIGNED
+	}
+	tx := global.DB.Begin()
+	if len(newEvents) > 0 {
+		if result := tx.CreateInBatches(newEvents, 100); result.Error != nil {
+			tx.Rollback()
+			return result.Error
+		}
+	}
+	if result := tx.Model(&model.Shipment{}).Where("id = ?", shipment.ID).Updates(updates); result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	tx.Commit()
+
+	shipment.TrackedAt = &now
+	if signed {
+		shipment.Status = model.SHIPMENT_SIGNED
+	}
+	return nil
+}
+
+// 数据库中的datetime只保存到秒(四舍五入)， 比较的时候也按秒比较
+func trackingEventKey(t time.Time, status, description string) string {
+	return fmt.Sprintf("%d|%s|%s", t.Round(time.Second).Unix(), status, description)
+}
+
+// unshippedGoodsNums 计算订单中每个商品行还需要发货的数量， 已经退款成功的商品不用再发货
+func unshippedGoodsNums(db *gorm.DB, orderId int32, orderGoods []model.OrderGoods) (map[int32]int32, error) {
+	var rows []struct {
+		OrderGoods int32
+		Nums       int32
+	}
+	result := db.Model(&model.ShipmentGoods{}).
+		Select("shipmentgoods.order_goods, sum(shipmentgoods.nums) as nums").
+		Joins("join shipment on shipment.id = shipmentgoods.shipment and shipment.deleted_at is null").
+		Where("shipment.`order` = ?", orderId).
+		Group("shipmentgoods.order_goods").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询已发货商品失败")
+	}
+	shipped := make(map[int32]int32)
+	for _, row := range rows {
+		shipped[row.OrderGoods] = row.Nums
+	}
+
+	refunded, err := refundedGoodsNums(db, orderId, []int32{model.REFUND_SUCCESS})
+	if err != nil {
+		return nil, err
+	}
+
+	remains := make(map[int32]int32)
+	for _, orderGood := range orderGoods {
+		remain := orderGood.Nums - shipped[orderGood.ID] - refunded[orderGood.ID]
+		if remain < 0 {
+			remain = 0
+		}
+		remains[orderGood.ID] = remain
+	}
+	return remains, nil
+}
+
+func newShipmentGoods(orderGood model.OrderGoods, nums int32) *model.ShipmentGoods {
+	return &model.ShipmentGoods{
+		OrderGoods: orderGood.ID,
+		Goods:      orderGood.Goods,
+		GoodsName:  orderGood.GoodsName,
+		Nums:       nums,
+	}
+}
+
+func shipmentInfoResponse(shipment *model.Shipment, shipmentGoods []*model.ShipmentGoods, events []model.TrackingEvent) *proto.ShipmentInfoResponse {
+	rsp := proto.ShipmentInfoResponse{
+		Id:         shipment.ID,
+		OrderId:    shipment.Order,
+		OrderSn:    shipment.OrderSn,
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+		Status:     shipment.Status,
+	}
+	if shipment.ShipTime != nil {
+		rsp.ShipTime = shipment.ShipTime.Format("2006-01-02 15:04:05")
+	}
+	for _, shipmentGood := range shipmentGoods {
+		rsp.Goods = append(rsp.Goods, &proto.ShipmentGoodsResponse{
+			OrderGoodsId: shipmentGood.OrderGoods,
+			GoodsId:      shipmentGood.Goods,
+			GoodsName:    shipmentGood.GoodsName,
+			Nums:         shipmentGood.Nums,
+		})
+	}
+	for _, event := range events {
+		rsp.Events = append(rsp.Events, &proto.TrackingEventResponse{
+			Status:      event.Status,
+			Description: event.Description,
+			Location:    event.Location,
+			EventTime:   event.EventTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &rsp
+}
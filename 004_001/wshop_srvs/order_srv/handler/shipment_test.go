@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/order_srv/logistics"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// fakeCarrier 快递公司适配器， 每次拉取返回当前设置的完整轨迹
+type fakeCarrier struct {
+	mu     sync.Mutex
+	events []logistics.Event
+	calls  int
+}
+
+func (f *fakeCarrier) set(events ...logistics.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = events
+}
+
+func (f *fakeCarrier) Track(ctx context.Context, req *logistics.TrackRequest) ([]logistics.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return append([]logistics.Event(nil), f.events...), nil
+}
+
+func TestShipOrder(t *testing.T) {
+	db := setupDB(t)
+	logistics.Register("SHIPTEST", &fakeCarrier{})
+	order, line1 := createPaidOrder(t, db, "s1", 2)
+	line2 := model.OrderGoods{Order: order.ID, Goods: 2, GoodsName: "商品2", GoodsPrice: money.FromCents(500), Nums: 3}
+	db.Create(&line2)
+	// 商品行2已经退款成功一件， 只需要发两件
+	refund := model.Refund{User: 1, Order: order.ID, OrderSn: order.OrderSn, RefundSn: "sr1", Status: model.REFUND_SUCCESS}
+	db.Create(&refund)
+	db.Create(&model.RefundGoods{Refund: refund.ID, OrderGoods: line2.ID, Goods: 2, Nums: 1})
+
+	s := &OrderServer{}
+	ctx := context.Background()
+	ship := func(trackingNo string, goods ...*proto.ShipGoodsItem) (*proto.ShipmentInfoResponse, error) {
+		return s.ShipOrder(ctx, &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST", TrackingNo: trackingNo, Goods: goods})
+	}
+	orderStatus := func() string {
+		t.Helper()
+		var o model.OrderInfo
+		db.First(&o, order.ID)
+		return o.Status
+	}
+
+	invalid := []struct {
+		name string
+		req  *proto.ShipOrderRequest
+		code codes.Code
+	}{
+		{"no tracking no", &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST"}, codes.InvalidArgument},
+		{"unknown carrier", &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "UNKNOWN", TrackingNo: "x"}, codes.InvalidArgument},
+		{"no order", &proto.ShipOrderRequest{OrderId: 999, Carrier: "SHIPTEST", TrackingNo: "x"}, codes.NotFound},
+		{"unknown line", &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST", TrackingNo: "x",
+			Goods: []*proto.ShipGoodsItem{{OrderGoodsId: 999, Nums: 1}}}, codes.InvalidArgument},
+		{"too many", &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST", TrackingNo: "x",
+			Goods: []*proto.ShipGoodsItem{{OrderGoodsId: line2.ID, Nums: 3}}}, codes.InvalidArgument},
+		{"zero nums", &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST", TrackingNo: "x",
+			Goods: []*proto.ShipGoodsItem{{OrderGoodsId: line1.ID, Nums: 0}}}, codes.InvalidArgument},
+	}
+	for _, c := range invalid {
+		if _, err := s.ShipOrder(ctx, c.req); status.Code(err) != c.code {
+			t.Errorf("%s: err = %v, want %s", c.name, err, c.code)
+		}
+	}
+
+	// 第一个包裹发出商品行1， 还有商品没有发货， 订单状态不变
+	rsp, err := ship("p1", &proto.ShipGoodsItem{OrderGoodsId: line1.ID, Nums: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rsp.Goods) != 1 || rsp.Goods[0].OrderGoodsId != line1.ID || rsp.Goods[0].Nums != 2 || rsp.Status != model.SHIPMENT_SHIPPED {
+		t.Errorf("shipment = %v", rsp)
+	}
+	if got := orderStatus(); got != proto.OrderStatusCode_TRADE_SUCCESS.String() {
+		t.Errorf("部分发货之后订单状态 = %s", got)
+	}
+
+	// 同一个商品行不能重复发货， 同一个请求中重复的商品行也要累计
+	if _, err := ship("p2", &proto.ShipGoodsItem{OrderGoodsId: line1.ID, Nums: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("重复发货 err = %v", err)
+	}
+	if _, err := ship("p2", &proto.ShipGoodsItem{OrderGoodsId: line2.ID, Nums: 1}, &proto.ShipGoodsItem{OrderGoodsId: line2.ID, Nums: 2}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("请求中重复的商品行 err = %v", err)
+	}
+
+	// 不指定商品的时候发出剩余的商品， 退款成功的商品不发货
+	rsp, err = ship("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rsp.Goods) != 1 || rsp.Goods[0].OrderGoodsId != line2.ID || rsp.Goods[0].Nums != 2 {
+		t.Errorf("shipment = %v", rsp)
+	}
+	// 全部发货之后订单从支付成功变为已发货， 并记录状态流水和发货时间
+	var shipped model.OrderInfo
+	db.First(&shipped, order.ID)
+	if shipped.Status != proto.OrderStatusCode_TRADE_SHIPPED.String() || shipped.ShipTime == nil {
+		t.Errorf("status = %s, ship time = %v", shipped.Status, shipped.ShipTime)
+	}
+	var histories []model.OrderStatusHistory
+	db.Where(&model.OrderStatusHistory{Order: order.ID}).Find(&histories)
+	if len(histories) != 1 || histories[0].FromStatus != proto.OrderStatusCode_TRADE_SUCCESS.String() ||
+		histories[0].ToStatus != proto.OrderStatusCode_TRADE_SHIPPED.String() {
+		t.Errorf("histories = %+v", histories)
+	}
+
+	// 已发货的订单不能再发货
+	if _, err := ship("p3"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("已发货的订单再次发货 err = %v", err)
+	}
+	var count int64
+	db.Model(&model.Shipment{}).Where(&model.Shipment{Order: order.ID}).Count(&count)
+	if count != 2 {
+		t.Errorf("shipments = %d", count)
+	}
+
+	// 没有支付的订单不能发货
+	unpaid := model.OrderInfo{User: 1, OrderSn: "s2", Status: proto.OrderStatusCode_WAIT_BUYER_PAY.String()}
+	db.Create(&unpaid)
+	db.Create(&model.OrderGoods{Order: unpaid.ID, Goods: 1, Nums: 1})
+	if _, err := s.ShipOrder(ctx, &proto.ShipOrderRequest{OrderId: unpaid.ID, Carrier: "SHIPTEST", TrackingNo: "x"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("没有支付的订单发货 err = %v", err)
+	}
+}
+
+func TestShipOrderStatusChanged(t *testing.T) {
+	db := setupDB(t)
+	logistics.Register("SHIPTEST", &fakeCarrier{})
+	order, _ := createPaidOrder(t, db, "s1", 1)
+
+	// 发货的时候订单已经被其他请求修改， CAS失败， 包裹不保存
+	var stale model.OrderInfo
+	db.First(&stale, order.ID)
+	db.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("status", proto.OrderStatusCode_TRADE_REFUNDED.String())
+	tx := db.Begin()
+	err := ChangeOrderStatus(tx, &stale, proto.OrderStatusCode_TRADE_SHIPPED, "订单发货")
+	tx.Rollback()
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("CAS err = %v", err)
+	}
+
+	if _, err := (&OrderServer{}).ShipOrder(context.Background(), &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "SHIPTEST", TrackingNo: "x"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("已退款的订单发货 err = %v", err)
+	}
+	var count int64
+	db.Model(&model.Shipment{}).Count(&count)
+	if count != 0 {
+		t.Errorf("shipments = %d", count)
+	}
+}
+
+func TestShipmentTracking(t *testing.T) {
+	db := setupDB(t)
+	carrier := &fakeCarrier{}
+	logistics.Register("TRACKTEST", carrier)
+	order, _ := createPaidOrder(t, db, "s1", 1)
+	s := &OrderServer{}
+	ctx := context.Background()
+	if _, err := s.ShipOrder(ctx, &proto.ShipOrderRequest{OrderId: order.ID, Carrier: "TRACKTEST", TrackingNo: "t1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Date(2021, 3, 1, 10, 0, 0, 0, time.Local)
+	collected := logistics.Event{Status: logistics.StatusCollected, Description: "已揽收", Location: "发货仓", Time: base}
+	transit := logistics.Event{Status: logistics.StatusInTransit, Description: "运输中", Location: "转运中心", Time: base.Add(time.Hour)}
+	delivering := logistics.Event{Status: logistics.StatusDelivering, Description: "派送中", Time: base.Add(24 * time.Hour)}
+	signed := logistics.Event{Status: logistics.StatusSigned, Description: "已签收", Time: base.Add(30 * time.Hour)}
+
+	list := func(userId int32) []*proto.TrackingEventResponse {
+		t.Helper()
+		// 距离上次拉取没有超过间隔的时候不会重新拉取， 测试中每次都清空拉取时间
+		db.Model(&model.Shipment{}).Where("`order` = ?", order.ID).Update("tracked_at", nil)
+		rsp, err := s.ShipmentList(ctx, &proto.OrderRequest{Id: order.ID, UserId: userId})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rsp.Total != 1 || len(rsp.Data) != 1 {
+			t.Fatalf("shipments = %v", rsp)
+		}
+		return rsp.Data[0].Events
+	}
+	descriptions := func(events []*proto.TrackingEventResponse) []string {
+		var got []string
+		for _, event := range events {
+			got = append(got, event.Description)
+		}
+		return got
+	}
+	check := func(name string, events []*proto.TrackingEventResponse, want ...string) {
+		t.Helper()
+		got := descriptions(events)
+		if len(got) != len(want) {
+			t.Fatalf("%s: events = %v, want %v", name, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s: events = %v, want %v", name, got, want)
+			}
+		}
+	}
+
+	// 适配器返回的轨迹顺序不固定， 按照时间先后返回
+	carrier.set(transit, collected)
+	check("first", list(1), "已揽收", "运输中")
+	// 适配器每次返回完整的轨迹， 已经保存的轨迹不重复追加
+	carrier.set(collected, delivering, transit)
+	check("append", list(1), "已揽收", "运输中", "派送中")
+	var count int64
+	db.Model(&model.TrackingEvent{}).Count(&count)
+	if count != 3 {
+		t.Errorf("saved events = %d", count)
+	}
+
+	// 拉取间隔内不重复请求快递公司
+	calls := carrier.calls
+	if _, err := s.ShipmentList(ctx, &proto.OrderRequest{Id: order.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if carrier.calls != calls {
+		t.Errorf("拉取间隔内请求了快递公司 %d 次", carrier.calls-calls)
+	}
+
+	// 签收之后包裹状态变为已签收， 不再拉取
+	carrier.set(collected, transit, delivering, signed)
+	check("signed", list(0), "已揽收", "运输中", "派送中", "已签收")
+	var shipment model.Shipment
+	db.Where(&model.Shipment{Order: order.ID}).First(&shipment)
+	if shipment.Status != model.SHIPMENT_SIGNED {
+		t.Errorf("shipment status = %d", shipment.Status)
+	}
+	calls = carrier.calls
+	carrier.set(collected, transit, delivering, signed, logistics.Event{Status: logistics.StatusInTransit, Description: "退回", Time: base.Add(48 * time.Hour)})
+	check("after signed", list(1), "已揽收", "运输中", "派送中", "已签收")
+	if carrier.calls != calls {
+		t.Error("签收之后又请求了快递公司")
+	}
+
+	// 普通用户只能查看自己订单的物流
+	if _, err := s.ShipmentList(ctx, &proto.OrderRequest{Id: order.ID, UserId: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("查看其他用户订单的物流 err = %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+// Package idempotency 客户端请求的幂等控制
+// 客户端超时之后使用相同的幂等key重试， 第一次成功的请求的响应会被直接返回， 不会重复执行
+// 相同的key还在处理中的时候等待一段时间， 仍然没有结束返回ErrInProgress
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/model"
+)
+
+const (
+	defaultLease        = time.Minute
+	defaultWait         = 3 * time.Second
+	defaultPollInterval = 100 * time.Millisecond
+)
+
+var (
+	ErrInProgress = errors.New("相同的请求正在处理中")
+	ErrKeyReused  = errors.New("幂等key已经被其他请求使用")
+)
+
+// Store 幂等记录的存储
+type Store interface {
+	// Insert 插入处理中的记录， 相同用户的相同key已经存在的时候返回false
+	Insert(r *model.IdempotencyRecord) (bool, error)
+	// Get 记录不存在的时候返回nil
+	Get(user int32, key string) (*model.IdempotencyRecord, error)
+	// TakeOver 租约过期之后接管处理中的记录， lease_until没有变化的时候才能接管
+	TakeOver(r *model.IdempotencyRecord, until time.Time) (bool, error)
+	// Succeed 保存处理成功的结果， 租约已经被其他请求接管的时候返回false
+	Succeed(r *model.IdempotencyRecord) (bool, error)
+	// Release 处理失败之后删除记录， 租约已经被其他请求接管的时候不删除
+	Release(r *model.IdempotencyRecord) error
+}
+
+// Result 第一次处理成功之后保存的结果
+type Result struct {
+	Order    int32  // 创建的资源id
+	Response []byte // 序列化之后的响应
+}
+
+type Guard struct {
+	Store Store
+
+	Lease        time.Duration // 处理一个请求的最长时间， 超过之后其他的重试可以接管
+	Wait         time.Duration // 相同的请求正在处理的时候等待的最长时间
+	PollInterval time.Duration
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func NewGuard(store Store) *Guard {
+	return &Guard{
+		Store:        store,
+		Lease:        defaultLease,
+		Wait:         defaultWait,
+		PollInterval: defaultPollInterval,
+		now:          time.Now,
+		sleep:        sleep,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// leaseUntil 数据库中的datetime只精确到秒， 租约的时间也只保留到秒， 否则按照lease_until比较的时候匹配不上
+func (g *Guard) leaseUntil(now time.Time) time.Time {
+	return now.Add(g.Lease).Truncate(time.Second)
+}
+
+// Fingerprint 请求参数的摘要
+func Fingerprint(request []byte) string {
+	sum := sha256.Sum256(request)
+	return hex.EncodeToString(sum[:])
+}
+
+// Do 同一个用户的同一个key只执行一次fn， 之前已经成功的直接返回保存的结果
+// fn返回错误的时候删除记录， 使用相同的key重试会重新执行
+func (g *Guard) Do(ctx context.Context, user int32, key, fingerprint string, fn func() (Result, error)) (Result, error) {
+	r, replay, err := g.acquire(ctx, user, key, fingerprint)
+	if err != nil {
+		return Result{}, err
+	}
+	if replay != nil {
+		return *replay, nil
+	}
+
+	result, err := fn()
+	if err != nil {
+		if releaseErr := g.Store.Release(r); releaseErr != nil {
+			zap.S().Errorf("删除幂等记录%d失败: %s", r.ID, releaseErr.Error())
+		}
+		return Result{}, err
+	}
+
+	r.Status = model.IDEMPOTENCY_SUCCEEDED
+	r.Order = result.Order
+	r.Response = string(result.Response)
+	if ok, err := g.Store.Succeed(r); err != nil {
+		zap.S().Errorf("保存幂等记录%d失败: %s", r.ID, err.Error())
+	} else if !ok {
+		// 处理的时间超过了租约， 另一个重试接管了这个key， 需要调大Lease
+		zap.S().Errorf("幂等记录%d的租约已经被接管， 请求可能被重复处理", r.ID)
+	}
+	return result, nil
+}
+
+// acquire 获取key的处理权， 之前已经成功的返回保存的结果
+func (g *Guard) acquire(ctx context.Context, user int32, key, fingerprint string) (*model.IdempotencyRecord, *Result, error) {
+	deadline := g.now().Add(g.Wait)
+	for {
+		r := &model.IdempotencyRecord{
+			User:        user,
+			RequestKey:  key,
+			Fingerprint: fingerprint,
+			Status:      model.IDEMPOTENCY_PROCESSING,
+			LeaseUntil:  g.leaseUntil(g.now()),
+		}
+		ok, err := g.Store.Insert(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			return r, nil, nil
+		}
+
+		existing, err := g.Store.Get(user, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing == nil {
+			// 之前的请求失败了， 记录刚被删除
+			continue
+		}
+		if existing.Fingerprint != fingerprint {
+			return nil, nil, ErrKeyReused
+		}
+		if existing.Status == model.IDEMPOTENCY_SUCCEEDED {
+			return nil, &Result{Order: existing.Order, Response: []byte(existing.Response)}, nil
+		}
+
+		now := g.now()
+		if now.After(existing.LeaseUntil) {
+			// 处理的进程崩溃了， 接管这个key
+			until := g.leaseUntil(now)
+			ok, err := g.Store.TakeOver(existing, until)
+			if err != nil {
+				return nil, nil, err
+			}
+			if ok {
+				existing.LeaseUntil = until
+				return existing, nil, nil
+			}
+			continue
+		}
+
+		if !now.Before(deadline) {
+			return nil, nil, ErrInProgress
+		}
+		if err := g.sleep(ctx, g.PollInterval); err != nil {
+			return nil, nil, err
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/model"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*model.IdempotencyRecord
+	nextId  int32
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[string]*model.IdempotencyRecord{}}
+}
+
+func recordKey(user int32, key string) string {
+	return fmt.Sprintf("%d/%s", user, key)
+}
+
+func (s *memoryStore) Insert(r *model.IdempotencyRecord) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := recordKey(r.User, r.RequestKey)
+	if _, ok := s.records[k]; ok {
+		return false, nil
+	}
+	s.nextId++
+	r.ID = s.nextId
+	copied := *r
+	s.records[k] = &copied
+	return true, nil
+}
+
+func (s *memoryStore) Get(user int32, key string) (*model.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.records[recordKey(user, key)]
+	if !ok {
+		return nil, nil
+	}
+	copied := *r
+	return &copied, nil
+}
+
+// find 按照和数据库一样的条件查找处理中的记录
+func (s *memoryStore) find(r *model.IdempotencyRecord) *model.IdempotencyRecord {
+	stored, ok := s.records[recordKey(r.User, r.RequestKey)]
+	if !ok || stored.ID != r.ID || stored.Status != model.IDEMPOTENCY_PROCESSING || !stored.LeaseUntil.Equal(r.LeaseUntil) {
+		return nil
+	}
+	return stored
+}
+
+func (s *memoryStore) TakeOver(r *model.IdempotencyRecord, until time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.find(r)
+	if stored == nil {
+		return false, nil
+	}
+	stored.LeaseUntil = until
+	return true, nil
+}
+
+func (s *memoryStore) Succeed(r *model.IdempotencyRecord) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.find(r)
+	if stored == nil {
+		return false, nil
+	}
+	stored.Status = r.Status
+	stored.Order = r.Order
+	stored.Response = r.Response
+	return true, nil
+}
+
+func (s *memoryStore) Release(r *model.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(r) != nil {
+		delete(s.records, recordKey(r.User, r.RequestKey))
+	}
+	return nil
+}
+
+type fakeClock struct {
+	now     time.Time
+	onSleep func()
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.now = c.now.Add(d)
+	if c.onSleep != nil {
+		c.onSleep()
+	}
+	return nil
+}
+
+func newTestGuard(store Store) (*Guard, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2021, 3, 8, 10, 0, 0, 0, time.Local)}
+	g := NewGuard(store)
+	g.now = clock.Now
+	g.sleep = clock.Sleep
+	return g, clock
+}
+
+func succeed(calls *int32, order int32) func() (Result, error) {
+	return func() (Result, error) {
+		atomic.AddInt32(calls, 1)
+		return Result{Order: order, Response: []byte("order")}, nil
+	}
+}
+
+func TestReplay(t *testing.T) {
+	g, _ := newTestGuard(newMemoryStore())
+	ctx := context.Background()
+	var calls int32
+
+	first, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	if second.Order != first.Order || string(second.Response) != "order" {
+		t.Errorf("replay = %+v, want %+v", second, first)
+	}
+
+	// 不同用户的相同key互不影响
+	if _, err := g.Do(ctx, 2, "key", "fp", succeed(&calls, 12)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestRetryAfterFailure(t *testing.T) {
+	store := newMemoryStore()
+	g, _ := newTestGuard(store)
+	ctx := context.Background()
+
+	failure := errors.New("库存不足")
+	_, err := g.Do(ctx, 1, "key", "fp", func() (Result, error) {
+		return Result{}, failure
+	})
+	if err != failure {
+		t.Fatalf("err = %v, want %v", err, failure)
+	}
+	if r, _ := store.Get(1, "key"); r != nil {
+		t.Fatalf("failed request should release the key: %+v", r)
+	}
+
+	var calls int32
+	if _, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestKeyReused(t *testing.T) {
+	g, _ := newTestGuard(newMemoryStore())
+	ctx := context.Background()
+	var calls int32
+
+	if _, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Do(ctx, 1, "key", "other", succeed(&calls, 11)); err != ErrKeyReused {
+		t.Errorf("err = %v, want %v", err, ErrKeyReused)
+	}
+}
+
+func TestInProgress(t *testing.T) {
+	store := newMemoryStore()
+	g, clock := newTestGuard(store)
+	ctx := context.Background()
+	_, _ = store.Insert(&model.IdempotencyRecord{User: 1, RequestKey: "key", Fingerprint: "fp",
+		Status: model.IDEMPOTENCY_PROCESSING, LeaseUntil: g.leaseUntil(clock.now)})
+
+	var calls int32
+	start := clock.now
+	if _, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 10)); err != ErrInProgress {
+		t.Fatalf("err = %v, want %v", err, ErrInProgress)
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+	if waited := clock.now.Sub(start); waited < g.Wait {
+		t.Errorf("waited %s, want %s", waited, g.Wait)
+	}
+}
+
+func TestWaitForInProgress(t *testing.T) {
+	store := newMemoryStore()
+	g, clock := newTestGuard(store)
+	ctx := context.Background()
+	processing := &model.IdempotencyRecord{User: 1, RequestKey: "key", Fingerprint: "fp",
+		Status: model.IDEMPOTENCY_PROCESSING, LeaseUntil: g.leaseUntil(clock.now)}
+	_, _ = store.Insert(processing)
+
+	// 等待的过程中第一个请求处理完成
+	clock.onSleep = func() {
+		done := *processing
+		done.Status = model.IDEMPOTENCY_SUCCEEDED
+		done.Order = 10
+		done.Response = "order"
+		_, _ = store.Succeed(&done)
+	}
+
+	var calls int32
+	result, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 0 || result.Order != 10 {
+		t.Errorf("calls = %d, result = %+v", calls, result)
+	}
+}
+
+func TestTakeOverExpiredLease(t *testing.T) {
+	store := newMemoryStore()
+	g, clock := newTestGuard(store)
+	ctx := context.Background()
+	crashed := &model.IdempotencyRecord{User: 1, RequestKey: "key", Fingerprint: "fp",
+		Status: model.IDEMPOTENCY_PROCESSING, LeaseUntil: g.leaseUntil(clock.now)}
+	_, _ = store.Insert(crashed)
+
+	clock.now = clock.now.Add(g.Lease + time.Second)
+	var calls int32
+	result, err := g.Do(ctx, 1, "key", "fp", succeed(&calls, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || result.Order != 10 {
+		t.Errorf("calls = %d, result = %+v", calls, result)
+	}
+
+	// 崩溃的请求恢复之后不能覆盖接管之后的结果
+	crashed.Status = model.IDEMPOTENCY_SUCCEEDED
+	if ok, _ := store.Succeed(crashed); ok {
+		t.Error("stale lease should not succeed")
+	}
+	if r, _ := store.Get(1, "key"); r.Order != 10 {
+		t.Errorf("record = %+v", r)
+	}
+}
+
+func TestConcurrentDuplicates(t *testing.T) {
+	g := NewGuard(newMemoryStore())
+	g.PollInterval = time.Millisecond
+	g.Wait = 5 * time.Second
+	ctx := context.Background()
+
+	var calls int32
+	var wg sync.WaitGroup
+	results := make([]Result, 10)
+	errs := make([]error, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = g.Do(ctx, 1, "key", "fp", func() (Result, error) {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(20 * time.Millisecond)
+				return Result{Order: 10}, nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	for i := range results {
+		if errs[i] != nil || results[i].Order != 10 {
+			t.Errorf("request %d: result = %+v, err = %v", i, results[i], errs[i])
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package idempotency
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormStore 使用idempotencyrecord表保存幂等记录， 依赖(user, request_key)的唯一索引
+type GormStore struct {
+	DB *gorm.DB
+}
+
+func (s GormStore) Insert(r *model.IdempotencyRecord) (bool, error) {
+	result := s.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(r)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s GormStore) Get(user int32, key string) (*model.IdempotencyRecord, error) {
+	var r model.IdempotencyRecord
+	result := s.DB.Where("user = ? and request_key = ?", user, key).Limit(1).Find(&r)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+func (s GormStore) TakeOver(r *model.IdempotencyRecord, until time.Time) (bool, error) {
+	result := s.DB.Model(&model.IdempotencyRecord{}).
+		Where("id = ? and status = ? and lease_until = ?", r.ID, model.IDEMPOTENCY_PROCESSING, r.LeaseUntil).
+		Update("lease_until", until)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s GormStore) Succeed(r *model.IdempotencyRecord) (bool, error) {
+	result := s.DB.Model(&model.IdempotencyRecord{}).
+		Where("id = ? and status = ? and lease_until = ?", r.ID, model.IDEMPOTENCY_PROCESSING, r.LeaseUntil).
+		Updates(map[string]interface{}{
+			"status":   r.Status,
+			"order":    r.Order,
+			"response": r.Response,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Release 直接删除记录， 软删除的记录仍然会占用唯一索引
+func (s GormStore) Release(r *model.IdempotencyRecord) error {
+	return s.DB.Unscoped().Where("id = ? and status = ? and lease_until = ?", r.ID, model.IDEMPOTENCY_PROCESSING, r.LeaseUntil).
+		Delete(&model.IdempotencyRecord{}).Error
+}
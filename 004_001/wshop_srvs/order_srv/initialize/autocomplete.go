@@ -0,0 +1,64 @@
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/go-redsync/redsync/v4"
+	"github.com/go-redsync/redsync/v4/redis/goredis/v8"
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/autocomplete"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/handler"
+)
+
+// InitAutoComplete 启动自动确认收货的定时任务， 返回的函数在退出的时候停止任务
+// 没有配置redis的时候不能在多个实例之间互斥， 只能部署一个实例
+func InitAutoComplete() func() {
+	c := global.ServerConfig.AutoCompleteInfo
+
+	var lease autocomplete.Lease = autocomplete.LocalLease{}
+	var client *redis.Client
+	if c.Redis.Host != "" {
+		client = redis.NewClient(&redis.Options{
+			Addr: fmt.Sprintf("%s:%d", c.Redis.Host, c.Redis.Port),
+		})
+		ttl := time.Minute
+		if c.LeaseTTL > 0 {
+			ttl = time.Duration(c.LeaseTTL) * time.Second
+		}
+		rs := redsync.New(goredis.NewPool(client))
+		lease = autocomplete.NewRedsyncLease(rs, "order_auto_complete", ttl)
+	} else {
+		zap.S().Warn("自动确认收货没有配置redis， 只能部署一个order_srv实例")
+	}
+
+	job := autocomplete.NewJob(lease, handler.AutoCompleteOrders)
+	if c.Days > 0 {
+		job.Days = c.Days
+	}
+	if c.BatchSize > 0 {
+		job.BatchSize = c.BatchSize
+	}
+	if c.Interval > 0 {
+		job.Interval = time.Duration(c.Interval) * time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		if client != nil {
+			_ = client.Close()
+		}
+	}
+}
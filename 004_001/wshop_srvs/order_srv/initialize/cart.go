@@ -0,0 +1,42 @@
+package initialize
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/cart"
+	"wshop_srvs/order_srv/global"
+)
+
+// InitCart 初始化购物车的存储， 返回的函数在退出的时候关闭redis连接
+func InitCart() func() {
+	c := global.ServerConfig.CartInfo
+	db := &cart.GormStore{DB: global.DB}
+	switch c.Adapter {
+	case "", "gorm":
+		global.CartStore = db
+		return func() {}
+	case "redis":
+	default:
+		zap.S().Fatalf("不支持的购物车存储: %s", c.Adapter)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf("%s:%d", c.Redis.Host, c.Redis.Port),
+	})
+	store := cart.NewRedisStore(client, db)
+	if c.GuestTTL > 0 {
+		store.GuestTTL = time.Duration(c.GuestTTL) * time.Hour
+	}
+	if c.UserTTL > 0 {
+		store.UserTTL = time.Duration(c.UserTTL) * time.Minute
+	}
+	global.CartStore = store
+
+	return func() {
+		_ = client.Close()
+	}
+}
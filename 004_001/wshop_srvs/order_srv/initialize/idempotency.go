@@ -0,0 +1,11 @@
+package initialize
+
+import (
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/idempotency"
+)
+
+// InitIdempotency 下单的幂等控制， 租约需要大于下单的最长时间
+func InitIdempotency() {
+	global.IdempotencyGuard = idempotency.NewGuard(idempotency.GormStore{DB: global.DB})
+}
@@ -0,0 +1,30 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/logistics"
+)
+
+func InitLogistics() {
+	c := global.ServerConfig.LogisticsInfo
+	carriers := c.Carriers
+	if len(carriers) == 0 {
+		carriers = []string{"SF", "YTO", "ZTO", "STO", "YD", "EMS", "JD"}
+	}
+
+	// 还没有对接真实的快递公司， 所有的快递公司都使用同一个适配器
+	var carrier logistics.Carrier
+	switch c.Adapter {
+	case "file":
+		carrier = logistics.FileCarrier{Dir: c.FileDir}
+	case "", "mock":
+		carrier = logistics.MockCarrier{}
+	default:
+		zap.S().Fatalf("不支持的物流适配器: %s", c.Adapter)
+	}
+	for _, code := range carriers {
+		logistics.Register(code, carrier)
+	}
+}
@@ -0,0 +1,55 @@
+package initialize
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/ordersn"
+)
+
+// InitOrderSn 初始化订单号生成器， 返回的函数在服务退出的时候调用， 释放从consul分配的worker id
+func InitOrderSn() func() {
+	c := global.ServerConfig.OrderSnInfo
+	segment := ordersn.NewSegment(&ordersn.GormSegmentStore{DB: global.DB}, "order_sn", c.Step)
+	if c.Mode == "segment" {
+		global.OrderSnGenerator = segment
+		return func() {}
+	}
+	if c.Mode != "" && c.Mode != "snowflake" {
+		zap.S().Fatalf("不支持的订单号生成方式: %s", c.Mode)
+	}
+
+	if c.WorkerId != nil {
+		snowflake, err := ordersn.NewSnowflake(*c.WorkerId)
+		if err != nil {
+			zap.S().Fatalf("初始化订单号生成器失败: %s", err.Error())
+		}
+		global.OrderSnGenerator = ordersn.WithFallback(snowflake, segment)
+		return func() {}
+	}
+
+	cfg := api.DefaultConfig()
+	cfg.Address = fmt.Sprintf("%s:%d", global.ServerConfig.ConsulInfo.Host, global.ServerConfig.ConsulInfo.Port)
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		panic(err)
+	}
+	lease, err := ordersn.AllocateWorkerId(client, fmt.Sprintf("%s/ordersn/worker", global.ServerConfig.Name))
+	if err != nil {
+		// consul不可用的时候只使用数据库号段
+		zap.S().Errorf("从consul分配worker id失败， 使用数据库号段生成订单号: %s", err.Error())
+		global.OrderSnGenerator = segment
+		return func() {}
+	}
+	zap.S().Infof("订单号生成器的worker id: %d", lease.WorkerId)
+
+	snowflake, err := ordersn.NewConsulSnowflake(lease)
+	if err != nil {
+		zap.S().Fatalf("初始化订单号生成器失败: %s", err.Error())
+	}
+	global.OrderSnGenerator = ordersn.WithFallback(snowflake, segment)
+	return lease.Release
+}
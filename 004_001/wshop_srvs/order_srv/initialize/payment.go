@@ -0,0 +1,58 @@
+package initialize
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/payment"
+)
+
+// InitPayment 注册配置了的支付渠道， 和order-web一样没有配置的支付方式不注册
+// 订单服务只负责原路退款和对账查询， 发起支付和支付通知由order-web处理
+func InitPayment() {
+	c := global.ServerConfig.PaymentInfo
+	if c.AliPayInfo.AppID != "" {
+		alipay, err := payment.NewAlipay(c.AliPayInfo.AppID, c.AliPayInfo.PrivateKey, c.AliPayInfo.AliPublicKey, c.AliPayInfo.IsProduction)
+		if err != nil {
+			zap.S().Fatalf("初始化支付宝失败: %s", err.Error())
+		}
+		payment.Register("alipay", alipay)
+	}
+
+	if c.WechatPayInfo.MchID != "" {
+		wechat, err := payment.NewWechatPay(c.WechatPayInfo.MchID, c.WechatPayInfo.SerialNo, c.WechatPayInfo.PrivateKey, c.WechatPayInfo.PlatformCert)
+		if err != nil {
+			zap.S().Fatalf("初始化微信支付失败: %s", err.Error())
+		}
+		payment.Register("wechat", wechat)
+	}
+
+	if c.Mock {
+		zap.S().Warn("启用了模拟支付， 不要在线上环境配置")
+		payment.Register("mock", payment.NewMockProvider().WithLookup(mockTrade))
+	}
+}
+
+// mockTrade 模拟支付的交易保存在order-web中， 订单服务以支付记录作为支付平台中的交易
+func mockTrade(ctx context.Context, orderSn string) (*payment.Trade, error) {
+	var payments []model.Payment
+	if result := global.DB.WithContext(ctx).Where(&model.Payment{OrderSn: orderSn, PayType: "mock"}).Order("id desc").Limit(1).Find(&payments); result.Error != nil {
+		return nil, result.Error
+	}
+	if len(payments) == 0 {
+		return nil, payment.ErrTradeNotExist
+	}
+	trade := &payment.Trade{OrderSn: orderSn, TradeNo: payments[0].TradeNo, Amount: payments[0].Amount, PayTime: payments[0].PayTime}
+	switch payments[0].Status {
+	case model.PAYMENT_SUCCESS:
+		trade.Status = "TRADE_SUCCESS"
+	case model.PAYMENT_CLOSED:
+		trade.Status = "TRADE_CLOSED"
+	default:
+		trade.Status = "WAIT_BUYER_PAY"
+	}
+	return trade, nil
+}
@@ -0,0 +1,38 @@
+package initialize
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/saga"
+)
+
+// InitSaga 初始化saga编排器并启动恢复任务， 返回的函数在退出的时候停止恢复任务
+// 和tcc一样， 不管order_flow的配置都会恢复之前没有完成的saga
+func InitSaga(definitions ...*saga.Definition) func() {
+	switch global.ServerConfig.OrderFlow {
+	case "", "saga", "message", "tcc":
+	default:
+		zap.S().Fatalf("不支持的下单方式: %s", global.ServerConfig.OrderFlow)
+	}
+
+	orchestrator := saga.NewOrchestrator(saga.GormStore{DB: global.DB})
+	for _, def := range definitions {
+		orchestrator.Register(def)
+	}
+	global.SagaOrchestrator = orchestrator
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		orchestrator.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
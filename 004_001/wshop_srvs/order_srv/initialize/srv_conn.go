@@ -37,4 +37,16 @@ func InitSrvConn() {
 	}
 
 	global.InventorySrvClient = proto.NewInventoryClient(invConn)
+
+	// 初始化营销服务连接
+	promotionConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.PromotionSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【营销服务失败】")
+	}
+
+	global.PromotionSrvClient = proto.NewPromotionClient(promotionConn)
 }
@@ -0,0 +1,63 @@
+package initialize
+
+import (
+	"context"
+	"encoding/json"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/proto"
+	"wshop_srvs/order_srv/tcc"
+)
+
+// inventoryParticipant 库存服务的TCC接口， 参数是下单时的SellInfo
+type inventoryParticipant struct{}
+
+func (inventoryParticipant) Name() string {
+	return "inventory"
+}
+
+func (inventoryParticipant) Try(ctx context.Context, xid string, payload []byte) error {
+	var sellInfo proto.SellInfo
+	if err := json.Unmarshal(payload, &sellInfo); err != nil {
+		return err
+	}
+	_, err := global.InventorySrvClient.TrySell(ctx, &sellInfo)
+	return err
+}
+
+func (inventoryParticipant) Confirm(ctx context.Context, xid string, payload []byte) error {
+	_, err := global.InventorySrvClient.ConfirmSell(ctx, &proto.SellInfo{OrderSn: xid})
+	return err
+}
+
+func (inventoryParticipant) Cancel(ctx context.Context, xid string, payload []byte) error {
+	_, err := global.InventorySrvClient.CancelSell(ctx, &proto.SellInfo{OrderSn: xid})
+	return err
+}
+
+// orderCommitted 订单已经保存说明下单的本地事务已经提交了
+func orderCommitted(orderSn string) (bool, error) {
+	var count int64
+	result := global.DB.Model(&model.OrderInfo{}).Where(&model.OrderInfo{OrderSn: orderSn}).Count(&count)
+	return count > 0, result.Error
+}
+
+// InitTcc 初始化TCC协调者并启动恢复任务， 返回的函数在退出的时候停止恢复任务
+// 切换回事务消息之后也要继续恢复之前没有完成的全局事务， 所以不管order_flow的配置都会启动
+func InitTcc() func() {
+	coordinator := tcc.NewCoordinator(tcc.GormStore{DB: global.DB}, orderCommitted, inventoryParticipant{})
+	global.TccCoordinator = coordinator
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		coordinator.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
@@ -0,0 +1,30 @@
+package initialize
+
+import (
+	"context"
+	"time"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/watch"
+)
+
+// InitWatch 启动订单状态变化的推送， 返回的函数在退出的时候断开所有的订阅
+func InitWatch() func() {
+	hub := watch.NewHub(watch.GormSource{DB: global.DB})
+	if c := global.ServerConfig.WatchInfo; c.Interval > 0 {
+		hub.Interval = time.Duration(c.Interval) * time.Millisecond
+	}
+	global.OrderWatcher = hub
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
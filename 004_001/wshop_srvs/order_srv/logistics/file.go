@@ -0,0 +1,31 @@
+package logistics
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FileCarrier 从本地文件读取物流轨迹， 用来在测试环境手工构造各种轨迹
+// 文件路径为 Dir/快递公司编码/运单号.json， 内容是Event的json数组， 文件不存在表示还没有轨迹
+type FileCarrier struct {
+	Dir string
+}
+
+func (f FileCarrier) Track(ctx context.Context, req *TrackRequest) ([]Event, error) {
+	data, err := ioutil.ReadFile(filepath.Join(f.Dir, req.Carrier, req.TrackingNo+".json"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	if err = json.Unmarshal(data, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
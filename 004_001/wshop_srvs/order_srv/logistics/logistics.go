@@ -0,0 +1,56 @@
+package logistics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// 物流轨迹的状态， 各个快递公司的状态都要转换成这几种
+const (
+	StatusCollected  = "COLLECTED"  // 已揽收
+	StatusInTransit  = "IN_TRANSIT" // 运输中
+	StatusDelivering = "DELIVERING" // 派送中
+	StatusSigned     = "SIGNED"     // 已签收
+)
+
+type Event struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	Time        time.Time `json:"time"`
+}
+
+type TrackRequest struct {
+	Carrier    string
+	TrackingNo string
+	ShipTime   time.Time
+}
+
+// Carrier 快递公司的适配器， 返回包裹当前完整的物流轨迹， 重复的轨迹由调用方去重
+type Carrier interface {
+	Track(ctx context.Context, req *TrackRequest) ([]Event, error)
+}
+
+var (
+	mu       sync.RWMutex
+	carriers = make(map[string]Carrier)
+)
+
+// Register 注册快递公司， code是快递公司编码， 比如 SF、YTO
+func Register(code string, c Carrier) {
+	mu.Lock()
+	defer mu.Unlock()
+	carriers[code] = c
+}
+
+func Get(code string) (Carrier, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	c, ok := carriers[code]
+	if !ok {
+		return nil, fmt.Errorf("不支持的快递公司: %s", code)
+	}
+	return c, nil
+}
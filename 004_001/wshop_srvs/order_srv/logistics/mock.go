@@ -0,0 +1,39 @@
+package logistics
+
+import (
+	"context"
+	"time"
+)
+
+// MockCarrier 根据发货时间模拟物流轨迹， 本地开发的时候不需要对接快递公司
+type MockCarrier struct{}
+
+var mockTimeline = []struct {
+	after       time.Duration
+	status      string
+	description string
+	location    string
+}{
+	{0, StatusCollected, "快件已被揽收", "发货仓"},
+	{time.Hour, StatusInTransit, "快件已发往转运中心", "转运中心"},
+	{24 * time.Hour, StatusDelivering, "快件正在派送中", "收货地营业点"},
+	{30 * time.Hour, StatusSigned, "快件已签收", "收货地址"},
+}
+
+func (MockCarrier) Track(ctx context.Context, req *TrackRequest) ([]Event, error) {
+	var events []Event
+	now := time.Now()
+	for _, item := range mockTimeline {
+		t := req.ShipTime.Add(item.after)
+		if t.After(now) {
+			break
+		}
+		events = append(events, Event{
+			Status:      item.status,
+			Description: item.description,
+			Location:    item.location,
+			Time:        t,
+		})
+	}
+	return events, nil
+}
@@ -3,8 +3,6 @@ package main
 import (
 	"flag"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2"
-	"github.com/apache/rocketmq-client-go/v2/consumer"
 	"github.com/satori/go.uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -37,6 +35,17 @@ func main() {
 	initialize.InitConfig()
 	initialize.InitDB()
 	initialize.InitSrvConn()
+	initialize.InitPayment()
+	initialize.InitLogistics()
+	initialize.InitIdempotency()
+	releaseOrderSn := initialize.InitOrderSn()
+	closeCart := initialize.InitCart()
+	shutdownMQ := initialize.InitMQ()
+	stopOutbox := initialize.InitOutbox()
+	stopTcc := initialize.InitTcc()
+	stopSaga := initialize.InitSaga(handler.NewCreateOrderSaga(handler.NewOrderBackend()))
+	stopAutoComplete := initialize.InitAutoComplete()
+	stopWatch := initialize.InitWatch()
 	zap.S().Info(global.ServerConfig)
 
 	flag.Parse()
@@ -93,12 +102,16 @@ func main() {
 	zap.S().Debugf("启动服务器, 端口： %d", *Port)
 
 	// 监听订单超时topic
-	c, _ := rocketmq.NewPushConsumer(
-		consumer.WithNameServer([]string{"192.168.0.249:9876"}),
-		consumer.WithGroupName("wshop-order"),
-	)
+	c, err := global.MQBroker.NewPushConsumer("wshop-order")
+	if err != nil {
+		zap.S().Panic("生成consumer失败:", err.Error())
+	}
 
-	if err := c.Subscribe("order_timeout", consumer.MessageSelector{}, handler.OrderTimeout); err != nil {
+	if err := c.Subscribe("order_timeout", handler.OrderTimeout); err != nil {
+		fmt.Println("读取消息失败")
+	}
+	// 订单关闭之后释放锁定的优惠券
+	if err := c.Subscribe("order_coupon_release", handler.ReleaseCoupons); err != nil {
 		fmt.Println("读取消息失败")
 	}
 	_ = c.Start()
@@ -110,6 +123,14 @@ func main() {
 	<-quit
 	_ = c.Shutdown()
 	_ = closer.Close()
+	stopWatch()
+	stopAutoComplete()
+	stopSaga()
+	stopTcc()
+	stopOutbox()
+	shutdownMQ()
+	releaseOrderSn()
+	closeCart()
 	if err = register_client.DeRegister(serviceId); err != nil {
 		zap.S().Info("注销失败:", err.Error())
 	} else {
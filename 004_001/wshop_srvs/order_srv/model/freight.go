@@ -0,0 +1,43 @@
+package model
+
+import "wshop_srvs/order_srv/utils/money"
+
+// 运费模板的计费方式
+const (
+	FREIGHT_BY_PIECE  = iota + 1 // 按件数计费
+	FREIGHT_BY_WEIGHT            // 按重量计费， 单位为克
+)
+
+// FreightTemplate 运费模板， 下单的时候使用默认的模板计算运费
+type FreightTemplate struct {
+	BaseModel
+
+	Name          string      `gorm:"type:varchar(50)"`
+	ChargeType    int32       `gorm:"type:int comment '计费方式: 1(按件数),2(按重量)'"`
+	FreeThreshold money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 商品金额满多少包邮， 0表示不包邮
+	IsDefault     bool        `gorm:"default:false;not null"`
+
+	Rules []FreightRule `gorm:"foreignKey:Template"`
+}
+
+func (FreightTemplate) TableName() string {
+	return "freighttemplate"
+}
+
+// FreightRule 运费模板中一个地区的运费， 按照城市、省份、默认地区的顺序匹配收货地址
+type FreightRule struct {
+	BaseModel
+
+	Template int32  `gorm:"type:int;index"`
+	Province string `gorm:"type:varchar(10)"` // 为空表示没有单独配置的地区
+	City     string `gorm:"type:varchar(10)"` // 为空表示整个省份
+
+	FirstUnit      int32       `gorm:"type:int"` // 首件的件数或者首重的克数
+	FirstFee       money.Money `gorm:"type:decimal(10,2);not null;default:0"`
+	AdditionalUnit int32       `gorm:"type:int"` // 每续多少件或者多少克加收一次续费， 0表示不收续费
+	AdditionalFee  money.Money `gorm:"type:decimal(10,2);not null;default:0"`
+}
+
+func (FreightRule) TableName() string {
+	return "freightrule"
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+const (
+	IDEMPOTENCY_PROCESSING = iota + 1 // 正在处理
+	IDEMPOTENCY_SUCCEEDED             // 处理成功， 重复的请求直接返回保存的结果
+)
+
+// IdempotencyRecord 客户端请求的幂等key， 同一个用户的同一个key只会处理一次
+// 处理失败的请求会删除记录， 客户端可以使用相同的key重试
+type IdempotencyRecord struct {
+	BaseModel
+
+	User        int32     `gorm:"type:int;index:idx_idempotency_key,unique;not null"`
+	RequestKey  string    `gorm:"type:varchar(64);index:idx_idempotency_key,unique;not null"` // 客户端生成的幂等key
+	Fingerprint string    `gorm:"type:varchar(64);not null"`                                  // 请求参数的摘要， 相同的key不能用于不同的请求
+	Status      int32     `gorm:"type:int comment '状态: 1(处理中),2(成功)';not null"`
+	Order       int32     `gorm:"type:int;not null;default:0"` // 请求创建的订单id
+	Response    string    `gorm:"type:text"`                   // 第一次处理成功之后的响应
+	LeaseUntil  time.Time `gorm:"type:datetime;not null"`      // 处理中的请求超过这个时间还没有结束， 认为处理的进程已经崩溃， 可以被重试接管
+}
+
+func (IdempotencyRecord) TableName() string {
+	return "idempotencyrecord"
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"fmt"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -10,6 +11,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 	"time"
 	"wshop_srvs/order_srv/model"
 )
@@ -43,6 +45,55 @@ func main() {
 		panic(err)
 	}
 
-	_ = db.AutoMigrate(&model.ShoppingCart{}, &model.OrderInfo{}, &model.OrderGoods{})
+	migrateMoney(db)
+	_ = db.AutoMigrate(&model.ShoppingCart{}, &model.OrderInfo{}, &model.OrderGoods{}, &model.OrderStatusHistory{},
+		&model.Refund{}, &model.RefundGoods{},
+		&model.Shipment{}, &model.ShipmentGoods{}, &model.TrackingEvent{},
+		&model.OrderSnSegment{}, &model.OutboxMessage{}, &model.TccTransaction{}, &model.SagaInstance{}, &model.IdempotencyRecord{},
+		&model.FreightTemplate{}, &model.FreightRule{}, &model.Payment{})
 
 }
+
+// migrateMoney 把金额字段从float改成DECIMAL(10,2)， 可以重复执行
+// 商品单价是两位小数， mysql转换的时候四舍五入就是正确的值
+// 订单总额是用float32累加出来的， 金额大的时候误差可能超过一分钱， 所以用转换之后的商品单价重新计算
+func migrateMoney(db *gorm.DB) {
+	recomputeOrderMount := false
+	if db.Migrator().HasTable(&model.OrderInfo{}) {
+		columnTypes, _ := db.Migrator().ColumnTypes(&model.OrderInfo{})
+		for _, columnType := range columnTypes {
+			if columnType.Name() == "order_mount" && strings.EqualFold(columnType.DatabaseTypeName(), "float") {
+				recomputeOrderMount = true
+			}
+		}
+	}
+
+	columns := []struct {
+		table  interface{}
+		column string
+	}{
+		{&model.OrderGoods{}, "goods_price"},
+		{&model.OrderInfo{}, "order_mount"},
+		{&model.Refund{}, "amount"},
+		{&model.RefundGoods{}, "goods_price"},
+	}
+	for _, c := range columns {
+		if !db.Migrator().HasTable(c.table) {
+			continue
+		}
+		stmt := &gorm.Statement{DB: db}
+		_ = stmt.Parse(c.table)
+		if err := db.Exec(fmt.Sprintf("ALTER TABLE %s MODIFY %s DECIMAL(10,2)", stmt.Table, c.column)).Error; err != nil {
+			panic(err)
+		}
+	}
+
+	if recomputeOrderMount {
+		err := db.Exec("UPDATE orderinfo SET order_mount = " +
+			"(SELECT SUM(goods_price * nums) FROM ordergoods WHERE ordergoods.`order` = orderinfo.id) " +
+			"WHERE EXISTS (SELECT 1 FROM ordergoods WHERE ordergoods.`order` = orderinfo.id)").Error
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -1,13 +1,20 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
 
 type ShoppingCart struct {
 	BaseModel
-	User    int32 `gorm:"type:int;index"` // 在购物车列表中我们需要查询当前用户的购物车记录
-	Goods   int32 `gorm:"type:int;index"` // 加索引：我们需要查询时候， 1. 会影响插入性能 2. 会占用磁盘
-	Nums    int32 `gorm:"type:int"`
-	Checked bool  // 是否选中
+	User    int32  `gorm:"type:int;index"`         // 在购物车列表中我们需要查询当前用户的购物车记录
+	Device  string `gorm:"type:varchar(64);index"` // 游客的设备token， 登录用户的记录为空
+	Goods   int32  `gorm:"type:int;index"`         // 加索引：我们需要查询时候， 1. 会影响插入性能 2. 会占用磁盘
+	Nums    int32  `gorm:"type:int"`
+	Checked bool   // 是否选中
+
+	Price money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 加入购物车时的价格， 结算的时候提示价格变化
 }
 
 func (ShoppingCart) TableName() string {
@@ -19,14 +26,22 @@ type OrderInfo struct {
 
 	User    int32  `gorm:"type:int;index"`
 	OrderSn string `gorm:"type:varchar(30);index"` // 订单号，我们平台自己生成的订单号
-	PayType string `gorm:"type:varchar(20) comment 'alipay(支付宝)， wechat(微信)'"`
+	PayType string `gorm:"type:varchar(20) comment 'alipay(支付宝)， wechat(微信)， mock(模拟支付)'"`
 
 	// status大家可以考虑使用iota来做
-	Status     string `gorm:"type:varchar(20)  comment 'PAYING(待支付), TRADE_SUCCESS(成功)， TRADE_CLOSED(超时关闭), WAIT_BUYER_PAY(交易创建), TRADE_FINISHED(交易结束)'"`
-	TradeNo    string `gorm:"type:varchar(100) comment '交易号'"` // 交易号就是支付宝的订单号 查账
-	OrderMount float32
-	PayTime    *time.Time `gorm:"type:datetime"`
+	Status     string      `gorm:"type:varchar(20)  comment 'PAYING(待支付), TRADE_SUCCESS(成功)， TRADE_CLOSED(超时关闭), WAIT_BUYER_PAY(交易创建), TRADE_FINISHED(交易结束), TRADE_REFUNDED(全部退款), TRADE_SHIPPED(已发货)'"`
+	TradeNo    string      `gorm:"type:varchar(100) comment '交易号'"` // 交易号就是支付宝的订单号 查账
+	OrderMount money.Money `gorm:"type:decimal(10,2)"`              // 实付金额， 商品总额减去优惠金额加上运费
+	PayTime    *time.Time  `gorm:"type:datetime"`
+	ExpireAt   *time.Time  `gorm:"type:datetime"` // 支付截止时间， 超过之后没有支付的订单自动关闭
+	ShipTime   *time.Time  `gorm:"type:datetime"` // 所有商品都发货的时间
+	FinishTime *time.Time  `gorm:"type:datetime"` // 确认收货的时间， 超时自动确认的时候也会设置
+
+	DiscountAmount money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 优惠券的优惠金额
+	Freight        money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 运费， 已经计入实付金额
 
+	Province     string `gorm:"type:varchar(10)"` // 收货地址的省份和城市， 计算运费的时候使用
+	City         string `gorm:"type:varchar(10)"`
 	Address      string `gorm:"type:varchar(100)"`
 	SignerName   string `gorm:"type:varchar(20)"`
 	SingerMobile string `gorm:"type:varchar(11)"`
@@ -44,12 +59,28 @@ type OrderGoods struct {
 	Goods int32 `gorm:"type:int;index"`
 
 	// 把商品的信息保存下来了 ， 字段冗余， 高并发系统中我们一般都不会遵循 三范式  做镜像 记录
-	GoodsName  string `gorm:"type:varchar(100);index"`
-	GoodsImage string `gorm:"type:varchar(200)"`
-	GoodsPrice float32
-	Nums       int32 `gorm:"type:int"`
+	GoodsName  string      `gorm:"type:varchar(100);index"`
+	GoodsImage string      `gorm:"type:varchar(200)"`
+	GoodsPrice money.Money `gorm:"type:decimal(10,2)"`
+	Nums       int32       `gorm:"type:int"`
 }
 
 func (OrderGoods) TableName() string {
 	return "ordergoods"
 }
+
+// OrderStatusHistory 订单状态流水，每一次状态变更都会记录一条
+type OrderStatusHistory struct {
+	BaseModel
+
+	Order      int32  `gorm:"type:int;index"`
+	OrderSn    string `gorm:"type:varchar(30);index"`
+	User       int32  `gorm:"type:int"` // 订阅订单状态的时候按照用户过滤
+	FromStatus string `gorm:"type:varchar(20)"`
+	ToStatus   string `gorm:"type:varchar(20)"`
+	Remark     string `gorm:"type:varchar(100)"`
+}
+
+func (OrderStatusHistory) TableName() string {
+	return "orderstatushistory"
+}
@@ -0,0 +1,13 @@
+package model
+
+// OrderSnSegment 订单号号段， 每次申请号段的时候把max_id加上号段长度
+type OrderSnSegment struct {
+	BaseModel
+
+	BizTag string `gorm:"type:varchar(32);uniqueIndex"`
+	MaxId  int64  `gorm:"type:bigint"`
+}
+
+func (OrderSnSegment) TableName() string {
+	return "ordersnsegment"
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+const (
+	OUTBOX_PENDING = iota + 1 // 等待发送
+	OUTBOX_SENT               // 已经发送
+	OUTBOX_FAILED             // 超过重试次数， 需要人工处理
+)
+
+// OutboxMessage 本地消息表， 和业务数据在同一个事务中写入， 由relay异步发送到消息队列
+type OutboxMessage struct {
+	BaseModel
+
+	Topic       string     `gorm:"type:varchar(100);not null"`
+	Body        string     `gorm:"type:text"`
+	DelayLevel  int32      `gorm:"type:int;not null;default:0"` // 延时消息的等级， 0表示普通消息
+	Status      int32      `gorm:"type:int comment '状态: 1(待发送),2(已发送),3(发送失败)';not null;index:idx_outbox_status"`
+	Attempts    int32      `gorm:"type:int;not null;default:0"`
+	NextRetryAt time.Time  `gorm:"type:datetime;not null;index:idx_outbox_status"` // 下一次可以发送的时间
+	SentAt      *time.Time `gorm:"type:datetime"`
+	LastError   string     `gorm:"type:varchar(200)"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outboxmessage"
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// 支付记录的状态
+const (
+	PAYMENT_PENDING = iota + 1 // 等待支付
+	PAYMENT_SUCCESS            // 支付成功
+	PAYMENT_CLOSED             // 切换了支付方式或者订单已经关闭
+)
+
+// Payment 订单的一次支付尝试， 用户每次切换支付方式都会关闭之前的记录并新建一条
+type Payment struct {
+	BaseModel
+
+	Order   int32       `gorm:"type:int;index"`
+	OrderSn string      `gorm:"type:varchar(30);index"`
+	PayType string      `gorm:"type:varchar(20) comment 'alipay(支付宝), wechat(微信), mock(模拟支付)'"`
+	Amount  money.Money `gorm:"type:decimal(10,2)"`
+	Status  int32       `gorm:"type:int comment '1(等待支付),2(支付成功),3(已关闭)'"`
+	TradeNo string      `gorm:"type:varchar(100) comment '支付平台的交易号'"`
+	PayTime *time.Time  `gorm:"type:datetime"`
+}
+
+func (Payment) TableName() string {
+	return "payment"
+}
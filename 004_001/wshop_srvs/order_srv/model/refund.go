@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// 退款类型
+const (
+	REFUND_TYPE_FULL    = iota + 1 // 全额退款， 退掉订单中所有还没有退过的商品
+	REFUND_TYPE_PARTIAL            // 部分退款， 按照订单商品行和数量退款
+)
+
+// 退款单状态
+const (
+	REFUND_PENDING  = iota + 1 // 待审核
+	REFUND_APPROVED            // 审核通过， 正在归还库存和原路退款
+	REFUND_REJECTED            // 审核拒绝
+	REFUND_SUCCESS             // 退款成功
+	REFUND_FAILED              // 退款失败， 可以重新审核通过再次发起退款
+)
+
+type Refund struct {
+	BaseModel
+
+	User     int32  `gorm:"type:int;index"`
+	Order    int32  `gorm:"type:int;index"`
+	OrderSn  string `gorm:"type:varchar(30);index"`
+	RefundSn string `gorm:"type:varchar(30);uniqueIndex"` // 退款单号， 同时作为支付宝部分退款的out_request_no
+
+	RefundType int32       `gorm:"type:int comment '1(全额退款),2(部分退款)'"`
+	Status     int32       `gorm:"type:int comment '1(待审核),2(审核通过),3(审核拒绝),4(退款成功),5(退款失败)'"`
+	Reason     string      `gorm:"type:varchar(200)"`
+	Images     GormList    `gorm:"type:varchar(1000)"` // 凭证图片， 前端上传到oss之后的url
+	Amount     money.Money `gorm:"type:decimal(10,2)"`
+
+	AdminRemark   string     `gorm:"type:varchar(200)"`
+	FailReason    string     `gorm:"type:varchar(200)"`
+	StockReturned bool       // 库存是否已经归还， 重试退款的时候不能重复归还
+	RefundTradeNo string     `gorm:"type:varchar(100) comment '支付平台的退款流水号'"`
+	RefundTime    *time.Time `gorm:"type:datetime"`
+}
+
+func (Refund) TableName() string {
+	return "refund"
+}
+
+// RefundGoods 退款的商品行， 和OrderGoods一样把商品信息冗余下来
+type RefundGoods struct {
+	BaseModel
+
+	Refund     int32 `gorm:"type:int;index"`
+	OrderGoods int32 `gorm:"type:int;index"`
+	Goods      int32 `gorm:"type:int"`
+
+	GoodsName  string      `gorm:"type:varchar(100)"`
+	GoodsPrice money.Money `gorm:"type:decimal(10,2)"`
+	Nums       int32       `gorm:"type:int"`
+}
+
+func (RefundGoods) TableName() string {
+	return "refundgoods"
+}
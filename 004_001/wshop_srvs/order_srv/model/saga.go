@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+const (
+	SAGA_RUNNING      = iota + 1 // 正在执行
+	SAGA_COMPENSATING            // 有步骤失败了， 正在补偿
+	SAGA_SUCCEEDED               // 所有步骤都执行成功
+	SAGA_COMPENSATED             // 补偿完成
+	SAGA_FAILED                  // 补偿超过重试次数， 需要人工处理
+)
+
+// SagaInstance 一次saga的执行状态， 进程重启之后根据这张表继续执行或者补偿
+type SagaInstance struct {
+	BaseModel
+
+	Name        string    `gorm:"type:varchar(50);index:idx_saga_biz_key,unique;not null"`  // saga的名称， 比如create_order
+	BizKey      string    `gorm:"type:varchar(100);index:idx_saga_biz_key,unique;not null"` // 业务上的唯一标识， 比如订单号
+	Status      int32     `gorm:"type:int comment '状态: 1(执行中),2(补偿中),3(成功),4(已补偿),5(失败)';not null;index:idx_saga_status"`
+	Step        int32     `gorm:"type:int;not null;default:0"` // 执行中是下一个要执行的步骤， 补偿中是下一个要补偿的步骤
+	Data        string    `gorm:"type:text"`                   // 步骤之间传递的数据
+	Attempts    int32     `gorm:"type:int;not null;default:0"`
+	NextRetryAt time.Time `gorm:"type:datetime;not null;index:idx_saga_status"` // 执行中是租约到期的时间， 补偿失败之后是下一次重试的时间
+	LastError   string    `gorm:"type:varchar(200)"`
+}
+
+func (SagaInstance) TableName() string {
+	return "saga_instance"
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// 包裹状态
+const (
+	SHIPMENT_SHIPPED = iota + 1 // 已发货
+	SHIPMENT_SIGNED             // 已签收， 签收之后不再拉取物流轨迹
+)
+
+// Shipment 发货的包裹， 一个订单可以拆分成多个包裹发货
+type Shipment struct {
+	BaseModel
+
+	Order      int32  `gorm:"type:int;index"`
+	OrderSn    string `gorm:"type:varchar(30);index"`
+	Carrier    string `gorm:"type:varchar(20) comment '快递公司编码'"`
+	TrackingNo string `gorm:"type:varchar(50);index"`
+	Status     int32  `gorm:"type:int comment '1(已发货),2(已签收)'"`
+
+	ShipTime  *time.Time `gorm:"type:datetime"`
+	TrackedAt *time.Time `gorm:"type:datetime"` // 最后一次从快递公司拉取物流轨迹的时间
+}
+
+func (Shipment) TableName() string {
+	return "shipment"
+}
+
+// ShipmentGoods 包裹中的商品， 对应订单中的商品行
+type ShipmentGoods struct {
+	BaseModel
+
+	Shipment   int32 `gorm:"type:int;index"`
+	OrderGoods int32 `gorm:"type:int;index"`
+	Goods      int32 `gorm:"type:int"`
+
+	GoodsName string `gorm:"type:varchar(100)"`
+	Nums      int32  `gorm:"type:int"`
+}
+
+func (ShipmentGoods) TableName() string {
+	return "shipmentgoods"
+}
+
+// TrackingEvent 包裹的物流轨迹， 由快递公司的适配器拉取之后追加
+type TrackingEvent struct {
+	BaseModel
+
+	Shipment    int32      `gorm:"type:int;index"`
+	Status      string     `gorm:"type:varchar(20)"`
+	Description string     `gorm:"type:varchar(200)"`
+	Location    string     `gorm:"type:varchar(100)"`
+	EventTime   *time.Time `gorm:"type:datetime"`
+}
+
+func (TrackingEvent) TableName() string {
+	return "trackingevent"
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+const (
+	TCC_TRYING     = iota + 1 // 正在执行try， 超时之后根据本地事务的结果确认或者取消
+	TCC_CONFIRMING            // 本地事务已经提交， 需要确认所有的参与者
+	TCC_CANCELING             // 需要取消所有的参与者
+	TCC_CONFIRMED             // 已经确认
+	TCC_CANCELED              // 已经取消
+)
+
+// TccTransaction TCC全局事务， 记录事务的状态， 协调者重启之后根据这张表继续确认或者取消
+type TccTransaction struct {
+	BaseModel
+
+	Xid         string    `gorm:"type:varchar(50);index:idx_tcc_xid,unique;not null"` // 全局事务id， 使用订单号
+	Status      int32     `gorm:"type:int comment '状态: 1(try),2(confirming),3(canceling),4(confirmed),5(canceled)';not null;index:idx_tcc_status"`
+	Payload     string    `gorm:"type:text"` // 调用参与者的参数
+	Attempts    int32     `gorm:"type:int;not null;default:0"`
+	NextRetryAt time.Time `gorm:"type:datetime;not null;index:idx_tcc_status"` // 下一次恢复的时间
+	LastError   string    `gorm:"type:varchar(200)"`
+}
+
+func (TccTransaction) TableName() string {
+	return "tcctransaction"
+}
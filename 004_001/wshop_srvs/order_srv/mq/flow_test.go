@@ -0,0 +1,336 @@
+package mq_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	invglobal "wshop_srvs/inventory_srv/global"
+	invhandler "wshop_srvs/inventory_srv/handler"
+	invmodel "wshop_srvs/inventory_srv/model"
+	invmq "wshop_srvs/inventory_srv/mq"
+	invoutbox "wshop_srvs/inventory_srv/outbox"
+	invproto "wshop_srvs/inventory_srv/proto"
+	"wshop_srvs/order_srv/cart"
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/handler"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/mq"
+	"wshop_srvs/order_srv/outbox"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 用进程内的消息队列把下单、超时关闭、归还库存的流程串起来
+// 订单服务的OrderListener、OrderTimeout和库存服务的AutoReback都是真实的实现， 两个服务各自使用一个sqlite数据库
+// 本地消息表中的消息通过relay的RunOnce发送
+
+var columnComment = regexp.MustCompile(`(?i)\s+comment\s+'[^']*'`)
+
+// sqliteConn model中的类型带有mysql的列注释， sqlite不支持， 建表之前去掉
+type sqliteConn struct {
+	*sql.DB
+}
+
+func (c sqliteConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.DB.ExecContext(ctx, columnComment.ReplaceAllString(query, ""), args...)
+}
+
+func openDB(t *testing.T, name string, models ...interface{}) *gorm.DB {
+	t.Helper()
+	sqlDB, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), name)+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqliteConn{sqlDB}}, &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// fakeGoods 商品服务， 每个商品10元
+type fakeGoods struct {
+	proto.GoodsClient
+}
+
+func (fakeGoods) BatchGetGoods(ctx context.Context, in *proto.BatchGoodsIdInfo, opts ...grpc.CallOption) (*proto.GoodsListResponse, error) {
+	var rsp proto.GoodsListResponse
+	for _, id := range in.Id {
+		rsp.Data = append(rsp.Data, &proto.GoodsInfoResponse{Id: id, Name: "商品", ShopPriceCents: 1000})
+	}
+	return &rsp, nil
+}
+
+// inventoryClient 直接调用库存服务的handler
+type inventoryClient struct {
+	proto.InventoryClient
+}
+
+func (inventoryClient) Sell(ctx context.Context, in *proto.SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	req := &invproto.SellInfo{OrderSn: in.OrderSn, Province: in.Province}
+	for _, goods := range in.GoodsInfo {
+		req.GoodsInfo = append(req.GoodsInfo, &invproto.GoodsInvInfo{GoodsId: goods.GoodsId, Num: goods.Num})
+	}
+	return (&invhandler.InventoryServer{}).Sell(ctx, req)
+}
+
+// autoReback 把订单服务的消息转换成库存服务的消息交给AutoReback
+func autoReback(ctx context.Context, msgs ...*mq.Message) (mq.ConsumeResult, error) {
+	var invMsgs []*invmq.Message
+	for _, msg := range msgs {
+		invMsgs = append(invMsgs, &invmq.Message{Topic: msg.Topic, Body: msg.Body, MsgId: msg.MsgId})
+	}
+	if result, err := invhandler.AutoReback(ctx, invMsgs...); result != invmq.ConsumeSuccess {
+		return mq.ConsumeRetryLater, err
+	}
+	return mq.ConsumeSuccess, nil
+}
+
+// crashListener 本地事务执行完之后进程退出， 没有返回结果， 等待回查
+type crashListener struct {
+	*handler.OrderListener
+}
+
+func (l crashListener) ExecuteLocalTransaction(msg *mq.Message) mq.LocalTransactionState {
+	l.OrderListener.ExecuteLocalTransaction(msg)
+	return mq.UnknowState
+}
+
+type orderFlow struct {
+	broker  *mq.MemoryBroker
+	orderDB *gorm.DB
+	invDB   *gorm.DB
+	relay   *outbox.Relay
+}
+
+func newOrderFlow(t *testing.T) *orderFlow {
+	t.Helper()
+	levels := make([]time.Duration, len(mq.DefaultDelayLevels))
+	for i := range levels {
+		levels[i] = 10 * time.Millisecond
+	}
+	b := mq.NewMemoryBroker(mq.WithDelayLevels(levels...), mq.WithRetryDelay(time.Millisecond), mq.WithCheckInterval(0))
+	t.Cleanup(b.Close)
+	producer, _ := b.NewProducer()
+	if err := producer.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &orderFlow{
+		broker: b,
+		orderDB: openDB(t, "order.db", &model.ShoppingCart{}, &model.OrderInfo{}, &model.OrderGoods{}, &model.OrderStatusHistory{},
+			&model.OutboxMessage{}, &model.Payment{}, &model.FreightTemplate{}, &model.FreightRule{}),
+		invDB: openDB(t, "inventory.db", &invmodel.InventoryNew{}, &invmodel.StockSellDetail{}, &invmodel.StockRebackRecord{},
+			&invmodel.StockTccRecord{}, &invmodel.Warehouse{}, &invmodel.WarehouseStock{}, &invmodel.InventoryHistory{}, &invmodel.OutboxMessage{}),
+	}
+	f.relay = outbox.NewRelay(outbox.GormStore{DB: f.orderDB}, producer)
+
+	oldDB, oldRelay, oldGoods, oldInventory, oldCart := global.DB, global.OutboxRelay, global.GoodsSrvClient, global.InventorySrvClient, global.CartStore
+	oldInvDB, oldInvRelay := invglobal.DB, invglobal.OutboxRelay
+	t.Cleanup(func() {
+		global.DB, global.OutboxRelay, global.GoodsSrvClient, global.InventorySrvClient, global.CartStore = oldDB, oldRelay, oldGoods, oldInventory, oldCart
+		invglobal.DB, invglobal.OutboxRelay = oldInvDB, oldInvRelay
+	})
+	global.DB = f.orderDB
+	global.OutboxRelay = f.relay
+	global.GoodsSrvClient = fakeGoods{}
+	global.InventorySrvClient = inventoryClient{}
+	global.CartStore = &cart.GormStore{DB: f.orderDB}
+	invglobal.DB = f.invDB
+	invglobal.OutboxRelay = invoutbox.NewRelay(invoutbox.GormStore{DB: f.invDB}, nil)
+
+	warehouse := invmodel.Warehouse{Name: "默认仓库"}
+	f.invDB.Create(&warehouse)
+	for _, goods := range []int32{1, 2} {
+		if _, err := (&invhandler.InventoryServer{}).SetInv(context.Background(), &invproto.GoodsInvInfo{GoodsId: goods, Num: 10, WarehouseId: warehouse.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, c := range []struct {
+		group, topic string
+		handler      mq.MessageHandler
+	}{
+		{"wshop-order", "order_timeout", handler.OrderTimeout},
+		{"wshop-inventory", "order_reback", autoReback},
+	} {
+		consumer, _ := b.NewPushConsumer(c.group)
+		if err := consumer.Subscribe(c.topic, c.handler); err != nil {
+			t.Fatal(err)
+		}
+		if err := consumer.Start(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f
+}
+
+// createOrder 把商品1和商品2加入购物车之后通过事务消息下单
+func (f *orderFlow) createOrder(t *testing.T, orderSn string, listener mq.TransactionListener) mq.LocalTransactionState {
+	t.Helper()
+	for goods, nums := range map[int32]int32{1: 2, 2: 3} {
+		if _, err := global.CartStore.Save(context.Background(), cart.UserOwner(1), cart.Item{Goods: goods, Nums: nums, Checked: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, _ := f.broker.NewTransactionProducer(listener)
+	_ = p.Start()
+	body, _ := json.Marshal(model.OrderInfo{OrderSn: orderSn, User: 1})
+	state, err := p.SendMessageInTransaction(context.Background(), mq.NewMessage("order_reback", body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// settle 发送本地消息表中的消息， 直到所有的消息都消费完
+func (f *orderFlow) settle(t *testing.T) {
+	t.Helper()
+	for i := 0; i < 5; i++ {
+		f.broker.Wait()
+		sent, err := f.relay.RunOnce(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sent == 0 {
+			return
+		}
+	}
+	t.Fatal("本地消息表中的消息一直没有发送完")
+}
+
+// expire 把订单的支付截止时间改到现在之前， 超时消息到达的时候直接关闭订单
+func (f *orderFlow) expire(orderSn string) {
+	f.orderDB.Model(&model.OrderInfo{}).Where("order_sn = ?", orderSn).Update("expire_at", time.Now().Add(-time.Second))
+}
+
+func (f *orderFlow) status(orderSn string) string {
+	var orders []model.OrderInfo
+	f.orderDB.Where(&model.OrderInfo{OrderSn: orderSn}).Find(&orders)
+	if len(orders) == 0 {
+		return ""
+	}
+	return orders[0].Status
+}
+
+// cartGoods 用户购物车中剩下的商品数
+func (f *orderFlow) cartGoods(t *testing.T) int {
+	t.Helper()
+	items, err := global.CartStore.List(context.Background(), cart.UserOwner(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(items)
+}
+
+func (f *orderFlow) assertStock(t *testing.T, first, second int32) {
+	t.Helper()
+	var stocks []invmodel.InventoryNew
+	f.invDB.Order("goods").Find(&stocks)
+	if len(stocks) != 2 || stocks[0].Stocks != first || stocks[1].Stocks != second {
+		t.Fatalf("库存应该是%d/%d， 实际: %v", first, second, stocks)
+	}
+}
+
+func newListener() *handler.OrderListener {
+	ctx := opentracing.ContextWithSpan(context.Background(), opentracing.NoopTracer{}.StartSpan("test"))
+	return &handler.OrderListener{Ctx: ctx}
+}
+
+func TestOrderFlowTimeoutRebacksStock(t *testing.T) {
+	f := newOrderFlow(t)
+	if state := f.createOrder(t, "sn1", newListener()); state != mq.RollbackMessageState {
+		t.Fatalf("订单创建成功的时候应该回滚归还库存的消息， 实际: %d", state)
+	}
+	f.assertStock(t, 8, 7)
+	if n := f.cartGoods(t); n != 0 {
+		t.Fatalf("下单之后购物车应该被清空， 剩下%d件商品", n)
+	}
+
+	f.expire("sn1")
+	f.settle(t)
+	if status := f.status("sn1"); status != "TRADE_CLOSED" {
+		t.Fatalf("超时没有支付的订单应该被关闭， 实际: %s", status)
+	}
+	f.assertStock(t, 10, 10)
+}
+
+func TestOrderFlowPaidBeforeTimeout(t *testing.T) {
+	f := newOrderFlow(t)
+	f.createOrder(t, "sn1", newListener())
+	f.orderDB.Model(&model.OrderInfo{}).Where("order_sn = ?", "sn1").Update("status", "TRADE_SUCCESS")
+
+	f.expire("sn1")
+	f.settle(t)
+	if status := f.status("sn1"); status != "TRADE_SUCCESS" {
+		t.Fatalf("已经支付的订单不能被关闭， 实际: %s", status)
+	}
+	f.assertStock(t, 8, 7)
+}
+
+func TestOrderFlowSaveFailedRebacksStock(t *testing.T) {
+	f := newOrderFlow(t)
+	// 扣减库存之后保存订单商品失败
+	if err := f.orderDB.Migrator().DropTable(&model.OrderGoods{}); err != nil {
+		t.Fatal(err)
+	}
+	if state := f.createOrder(t, "sn1", newListener()); state != mq.CommitMessageState {
+		t.Fatalf("扣减库存之后失败应该提交归还库存的消息， 实际: %d", state)
+	}
+
+	f.settle(t)
+	if status := f.status("sn1"); status != "" {
+		t.Fatalf("订单不应该被创建， 实际: %s", status)
+	}
+	f.assertStock(t, 10, 10)
+	// 订单没有创建， 购物车保持不变
+	if n := f.cartGoods(t); n != 2 {
+		t.Fatalf("下单失败之后购物车应该还有2件商品， 实际: %d", n)
+	}
+}
+
+func TestOrderFlowCheckBackAfterCrash(t *testing.T) {
+	f := newOrderFlow(t)
+
+	// 订单已经创建之后进程退出， 回查发现订单存在， 不能归还库存
+	f.createOrder(t, "created", crashListener{newListener()})
+	// 扣减库存之后保存订单失败并且进程退出， 回查发现订单不存在， 需要归还库存
+	if err := f.orderDB.Migrator().DropTable(&model.OrderGoods{}); err != nil {
+		t.Fatal(err)
+	}
+	f.createOrder(t, "lost", crashListener{newListener()})
+	if err := f.orderDB.AutoMigrate(&model.OrderGoods{}); err != nil {
+		t.Fatal(err)
+	}
+	f.assertStock(t, 6, 4)
+
+	f.broker.CheckTransactions()
+	f.expire("created")
+	// 等待回查提交的归还消息和超时消息都处理完
+	f.settle(t)
+
+	if status := f.status("created"); status != "TRADE_CLOSED" {
+		t.Fatalf("回查之后订单仍然会超时关闭， 实际: %s", status)
+	}
+	// 两个订单的库存都只归还了一次
+	f.assertStock(t, 10, 10)
+}
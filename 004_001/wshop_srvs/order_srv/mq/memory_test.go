@@ -0,0 +1,342 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recorder 记录消费者收到的消息
+type recorder struct {
+	mu   sync.Mutex
+	msgs []*Message
+}
+
+func (r *recorder) handle(ctx context.Context, msgs ...*Message) (ConsumeResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs = append(r.msgs, msgs...)
+	return ConsumeSuccess, nil
+}
+
+func (r *recorder) bodies() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var bodies []string
+	for _, msg := range r.msgs {
+		bodies = append(bodies, string(msg.Body))
+	}
+	return bodies
+}
+
+type stateListener struct {
+	execute LocalTransactionState
+	check   LocalTransactionState
+	checked int32
+}
+
+func (l *stateListener) ExecuteLocalTransaction(msg *Message) LocalTransactionState {
+	return l.execute
+}
+
+func (l *stateListener) CheckLocalTransaction(msg *Message) LocalTransactionState {
+	atomic.AddInt32(&l.checked, 1)
+	return l.check
+}
+
+func newTestBroker(t *testing.T, opts ...MemoryOption) *MemoryBroker {
+	t.Helper()
+	opts = append([]MemoryOption{
+		WithDelayLevels(20*time.Millisecond, 50*time.Millisecond),
+		WithRetryDelay(time.Millisecond),
+		WithCheckInterval(0),
+	}, opts...)
+	b := NewMemoryBroker(opts...)
+	t.Cleanup(b.Close)
+	return b
+}
+
+func startProducer(t *testing.T, b Broker) DelayProducer {
+	t.Helper()
+	p, _ := b.NewProducer()
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func startConsumer(t *testing.T, b Broker, group, topic string, handler MessageHandler) PushConsumer {
+	t.Helper()
+	c, _ := b.NewPushConsumer(group)
+	if err := c.Subscribe(topic, handler); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestMemoryEveryGroupReceives(t *testing.T) {
+	b := newTestBroker(t)
+	var order, inventory recorder
+	startConsumer(t, b, "order", "topic", order.handle)
+	startConsumer(t, b, "inventory", "topic", inventory.handle)
+
+	p := startProducer(t, b)
+	for _, body := range []string{"a", "b", "c"} {
+		if err := p.SendSync(context.Background(), NewMessage("topic", []byte(body))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b.Wait()
+
+	if len(order.bodies()) != 3 || len(inventory.bodies()) != 3 {
+		t.Fatalf("每个消费者组都应该收到3条消息， 实际: %v %v", order.bodies(), inventory.bodies())
+	}
+}
+
+func TestMemoryConsumersInGroupShareMessages(t *testing.T) {
+	b := newTestBroker(t)
+	var first, second recorder
+	startConsumer(t, b, "order", "topic", first.handle)
+	startConsumer(t, b, "order", "topic", second.handle)
+
+	p := startProducer(t, b)
+	for i := 0; i < 10; i++ {
+		_ = p.SendSync(context.Background(), NewMessage("topic", []byte("x")))
+	}
+	b.Wait()
+
+	if n := len(first.bodies()) + len(second.bodies()); n != 10 {
+		t.Fatalf("同一个组内的消息只能被消费一次， 实际消费了%d次", n)
+	}
+	if len(first.bodies()) == 0 || len(second.bodies()) == 0 {
+		t.Fatal("同一个组内的消费者应该轮流消费")
+	}
+}
+
+func TestMemoryBacklogBeforeStart(t *testing.T) {
+	b := newTestBroker(t)
+	p := startProducer(t, b)
+	_ = p.SendSync(context.Background(), NewMessage("topic", []byte("early")))
+
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+	b.Wait()
+
+	if got := r.bodies(); len(got) != 1 || got[0] != "early" {
+		t.Fatalf("消费者启动之前的消息也应该被消费， 实际: %v", got)
+	}
+}
+
+func TestMemoryProducerNotStarted(t *testing.T) {
+	b := newTestBroker(t)
+	p, _ := b.NewProducer()
+	if err := p.SendSync(context.Background(), NewMessage("topic", nil)); err != ErrProducerNotStarted {
+		t.Fatalf("没有启动的producer应该返回ErrProducerNotStarted， 实际: %v", err)
+	}
+}
+
+func TestMemoryDelayLevel(t *testing.T) {
+	b := newTestBroker(t)
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+
+	p := startProducer(t, b)
+	start := time.Now()
+	if err := p.SendDelay(context.Background(), NewMessage("topic", []byte("delay")), 2); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if len(r.bodies()) != 0 {
+		t.Fatal("延时消息不应该立即被消费")
+	}
+
+	b.Wait()
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("延时等级2应该延时50ms， 实际: %s", elapsed)
+	}
+	if len(r.bodies()) != 1 {
+		t.Fatal("延时消息到期之后应该被消费")
+	}
+
+	for _, level := range []int{0, 3} {
+		if err := p.SendDelay(context.Background(), NewMessage("topic", nil), level); err != ErrInvalidDelayLevel {
+			t.Fatalf("延时等级%d应该返回ErrInvalidDelayLevel， 实际: %v", level, err)
+		}
+	}
+}
+
+func TestMemoryRetryLater(t *testing.T) {
+	b := newTestBroker(t)
+	var attempts []int32
+	var mu sync.Mutex
+	startConsumer(t, b, "order", "topic", func(ctx context.Context, msgs ...*Message) (ConsumeResult, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, msgs[0].ReconsumeTimes)
+		if len(attempts) < 3 {
+			return ConsumeRetryLater, nil
+		}
+		return ConsumeSuccess, nil
+	})
+
+	p := startProducer(t, b)
+	_ = p.SendSync(context.Background(), NewMessage("topic", nil))
+	b.Wait()
+
+	if len(attempts) != 3 || attempts[0] != 0 || attempts[2] != 2 {
+		t.Fatalf("消费失败之后应该重新投递并增加重试次数， 实际: %v", attempts)
+	}
+}
+
+func TestMemoryDeadLetter(t *testing.T) {
+	b := newTestBroker(t, WithMaxReconsumeTimes(2))
+	var calls int32
+	startConsumer(t, b, "order", "topic", func(ctx context.Context, msgs ...*Message) (ConsumeResult, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			panic("消费者panic")
+		}
+		return ConsumeSuccess, errors.New("处理失败")
+	})
+
+	p := startProducer(t, b)
+	_ = p.SendSync(context.Background(), NewMessage("topic", []byte("dead")))
+	b.Wait()
+
+	if calls != 3 {
+		t.Fatalf("最多重试2次， 实际消费了%d次", calls)
+	}
+	if dead := b.DeadLetters(); len(dead) != 1 || string(dead[0].Body) != "dead" {
+		t.Fatalf("超过重试次数的消息应该进入死信队列， 实际: %v", dead)
+	}
+}
+
+func TestMemoryTransactionCommitAndRollback(t *testing.T) {
+	b := newTestBroker(t)
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+
+	for _, tc := range []struct {
+		state LocalTransactionState
+		body  string
+	}{{CommitMessageState, "commit"}, {RollbackMessageState, "rollback"}} {
+		p, _ := b.NewTransactionProducer(&stateListener{execute: tc.state})
+		_ = p.Start()
+		state, err := p.SendMessageInTransaction(context.Background(), NewMessage("topic", []byte(tc.body)))
+		if err != nil || state != tc.state {
+			t.Fatalf("本地事务的结果应该是%d， 实际: %d %v", tc.state, state, err)
+		}
+	}
+	b.Wait()
+
+	if got := r.bodies(); len(got) != 1 || got[0] != "commit" {
+		t.Fatalf("只有提交的事务消息可以被消费， 实际: %v", got)
+	}
+}
+
+func TestMemoryTransactionCheckBack(t *testing.T) {
+	b := newTestBroker(t)
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+
+	listener := &stateListener{execute: UnknowState, check: UnknowState}
+	p, _ := b.NewTransactionProducer(listener)
+	_ = p.Start()
+	_, _ = p.SendMessageInTransaction(context.Background(), NewMessage("topic", []byte("half")))
+
+	b.CheckTransactions()
+	b.Wait()
+	if len(r.bodies()) != 0 {
+		t.Fatal("回查结果未知的时候不能投递")
+	}
+
+	listener.check = CommitMessageState
+	b.CheckTransactions()
+	b.Wait()
+	if got := r.bodies(); len(got) != 1 || got[0] != "half" {
+		t.Fatalf("回查提交之后应该投递， 实际: %v", got)
+	}
+
+	// 已经提交的半消息不会再回查
+	checked := atomic.LoadInt32(&listener.checked)
+	b.CheckTransactions()
+	if atomic.LoadInt32(&listener.checked) != checked {
+		t.Fatal("已经提交的事务消息不应该再回查")
+	}
+}
+
+func TestMemoryTransactionCheckTimesExceeded(t *testing.T) {
+	b := newTestBroker(t)
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+
+	listener := &stateListener{execute: UnknowState, check: UnknowState}
+	p, _ := b.NewTransactionProducer(listener)
+	_ = p.Start()
+	_, _ = p.SendMessageInTransaction(context.Background(), NewMessage("topic", nil))
+
+	for i := 0; i < defaultMaxCheckTimes+5; i++ {
+		b.CheckTransactions()
+	}
+	if n := atomic.LoadInt32(&listener.checked); n != defaultMaxCheckTimes {
+		t.Fatalf("最多回查%d次， 实际回查了%d次", defaultMaxCheckTimes, n)
+	}
+	b.Wait()
+	if len(r.bodies()) != 0 {
+		t.Fatal("超过回查次数的半消息应该被丢弃")
+	}
+}
+
+func TestMemoryTransactionCheckLoop(t *testing.T) {
+	b := newTestBroker(t, WithCheckInterval(5*time.Millisecond))
+	var r recorder
+	startConsumer(t, b, "order", "topic", r.handle)
+
+	p, _ := b.NewTransactionProducer(&stateListener{execute: UnknowState, check: CommitMessageState})
+	_ = p.Start()
+	_, _ = p.SendMessageInTransaction(context.Background(), NewMessage("topic", nil))
+
+	deadline := time.Now().Add(time.Second)
+	for len(r.bodies()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("定时回查之后应该投递消息")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMemoryCloseDropsPending(t *testing.T) {
+	b := NewMemoryBroker(WithCheckInterval(0))
+	p := startProducer(t, b)
+	_ = p.SendDelay(context.Background(), NewMessage("topic", nil), 18)
+
+	b.Close()
+	b.Wait() // 不能一直阻塞在还没有到期的延时消息上
+	if err := p.SendSync(context.Background(), NewMessage("topic", nil)); err != ErrBrokerClosed {
+		t.Fatalf("关闭之后发送消息应该返回ErrBrokerClosed， 实际: %v", err)
+	}
+}
+
+func TestDelayLevelFor(t *testing.T) {
+	cases := []struct {
+		d    time.Duration
+		want int
+	}{
+		{0, 1},
+		{time.Second, 1},
+		{15 * time.Second, 3},
+		{30 * time.Minute, 16},
+		{90 * time.Minute, 17},
+		{24 * time.Hour, 18},
+	}
+	for _, c := range cases {
+		if level := DelayLevelFor(c.d); level != c.want {
+			t.Errorf("DelayLevelFor(%s) = %d, want %d", c.d, level, c.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package mq 消息队列的抽象
+// 业务代码只依赖这里定义的接口， 线上使用rocketmq， 单元测试和本地调试可以使用进程内的实现
+package mq
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// LocalTransactionState 本地事务的执行结果， 和rocketmq的含义一致
+type LocalTransactionState int
+
+const (
+	CommitMessageState   LocalTransactionState = iota + 1 // 提交半消息， 消费者可以消费到
+	RollbackMessageState                                  // 回滚半消息， 消费者不会消费到
+	UnknowState                                           // 状态未知， 稍后回查
+)
+
+// ConsumeResult 消费结果
+type ConsumeResult int
+
+const (
+	ConsumeSuccess    ConsumeResult = iota // 消费成功
+	ConsumeRetryLater                      // 消费失败， 稍后重新投递
+)
+
+var ErrInvalidDelayLevel = errors.New("延时等级不合法")
+
+// DelayLevelFor 不超过d的最大的延时等级， 比最小的等级还短的时候使用等级1
+// 延时等级只有固定的几个时间， 更长的延时需要消费者收到之后判断时间还没有到， 再按照剩余的时间发送一次
+func DelayLevelFor(d time.Duration) int {
+	level := 1
+	for i, delay := range DefaultDelayLevels {
+		if delay > d {
+			break
+		}
+		level = i + 1
+	}
+	return level
+}
+
+type Message struct {
+	Topic string
+	Body  []byte
+
+	MsgId          string // 消费的时候才有值
+	TransactionId  string // 事务消息的id
+	ReconsumeTimes int32  // 重新投递的次数
+}
+
+func NewMessage(topic string, body []byte) *Message {
+	return &Message{Topic: topic, Body: body}
+}
+
+// TransactionListener 事务消息的本地事务和回查
+type TransactionListener interface {
+	// ExecuteLocalTransaction 半消息发送成功之后执行本地事务
+	ExecuteLocalTransaction(msg *Message) LocalTransactionState
+	// CheckLocalTransaction 本地事务的状态未知的时候， broker会回查本地事务的状态
+	CheckLocalTransaction(msg *Message) LocalTransactionState
+}
+
+// MessageHandler 消费者处理消息的函数
+type MessageHandler func(ctx context.Context, msgs ...*Message) (ConsumeResult, error)
+
+type Producer interface {
+	Start() error
+	Shutdown() error
+	SendSync(ctx context.Context, msg *Message) error
+}
+
+// DelayProducer 可以发送延时消息的生产者
+type DelayProducer interface {
+	Producer
+	// SendDelay 按照延时等级发送消息， 等级和rocketmq一致: 1s 5s 10s 30s 1m 2m 3m ...
+	SendDelay(ctx context.Context, msg *Message, level int) error
+}
+
+type TransactionProducer interface {
+	Start() error
+	Shutdown() error
+	// SendMessageInTransaction 发送半消息并执行本地事务， 返回本地事务的执行结果
+	SendMessageInTransaction(ctx context.Context, msg *Message) (LocalTransactionState, error)
+}
+
+type PushConsumer interface {
+	// Subscribe 需要在Start之前调用
+	Subscribe(topic string, handler MessageHandler) error
+	Start() error
+	Shutdown() error
+}
+
+// Broker 创建生产者和消费者
+type Broker interface {
+	NewProducer() (DelayProducer, error)
+	NewTransactionProducer(listener TransactionListener) (TransactionProducer, error)
+	NewPushConsumer(group string) (PushConsumer, error)
+}
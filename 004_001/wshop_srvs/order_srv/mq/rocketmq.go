@@ -0,0 +1,166 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+)
+
+// RocketMQ 基于rocketmq的实现
+type RocketMQ struct {
+	NameServers []string
+}
+
+func NewRocketMQ(nameServers ...string) *RocketMQ {
+	return &RocketMQ{NameServers: nameServers}
+}
+
+func (r *RocketMQ) NewProducer() (DelayProducer, error) {
+	p, err := rocketmq.NewProducer(producer.WithNameServer(r.NameServers))
+	if err != nil {
+		return nil, err
+	}
+	return &rocketProducer{p: p}, nil
+}
+
+func (r *RocketMQ) NewTransactionProducer(listener TransactionListener) (TransactionProducer, error) {
+	p, err := rocketmq.NewTransactionProducer(&rocketListener{listener: listener}, producer.WithNameServer(r.NameServers))
+	if err != nil {
+		return nil, err
+	}
+	return &rocketTransactionProducer{p: p}, nil
+}
+
+func (r *RocketMQ) NewPushConsumer(group string) (PushConsumer, error) {
+	c, err := rocketmq.NewPushConsumer(
+		consumer.WithNameServer(r.NameServers),
+		consumer.WithGroupName(group),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rocketConsumer{c: c}, nil
+}
+
+type rocketProducer struct {
+	p rocketmq.Producer
+}
+
+func (r *rocketProducer) Start() error {
+	return r.p.Start()
+}
+
+func (r *rocketProducer) Shutdown() error {
+	return r.p.Shutdown()
+}
+
+func (r *rocketProducer) SendSync(ctx context.Context, msg *Message) error {
+	_, err := r.p.SendSync(ctx, primitive.NewMessage(msg.Topic, msg.Body))
+	return err
+}
+
+func (r *rocketProducer) SendDelay(ctx context.Context, msg *Message, level int) error {
+	if level <= 0 {
+		return ErrInvalidDelayLevel
+	}
+	_, err := r.p.SendSync(ctx, primitive.NewMessage(msg.Topic, msg.Body).WithDelayTimeLevel(level))
+	return err
+}
+
+type rocketTransactionProducer struct {
+	p rocketmq.TransactionProducer
+}
+
+func (r *rocketTransactionProducer) Start() error {
+	return r.p.Start()
+}
+
+func (r *rocketTransactionProducer) Shutdown() error {
+	return r.p.Shutdown()
+}
+
+func (r *rocketTransactionProducer) SendMessageInTransaction(ctx context.Context, msg *Message) (LocalTransactionState, error) {
+	result, err := r.p.SendMessageInTransaction(ctx, primitive.NewMessage(msg.Topic, msg.Body))
+	if err != nil {
+		return UnknowState, err
+	}
+	return fromRocketState(result.State), nil
+}
+
+// rocketListener 把rocketmq的回调转换成TransactionListener
+type rocketListener struct {
+	listener TransactionListener
+}
+
+func (r *rocketListener) ExecuteLocalTransaction(msg *primitive.Message) primitive.LocalTransactionState {
+	return toRocketState(r.listener.ExecuteLocalTransaction(&Message{
+		Topic:         msg.Topic,
+		Body:          msg.Body,
+		TransactionId: msg.TransactionId,
+	}))
+}
+
+func (r *rocketListener) CheckLocalTransaction(msg *primitive.MessageExt) primitive.LocalTransactionState {
+	return toRocketState(r.listener.CheckLocalTransaction(fromMessageExt(msg)))
+}
+
+type rocketConsumer struct {
+	c rocketmq.PushConsumer
+}
+
+func (r *rocketConsumer) Subscribe(topic string, handler MessageHandler) error {
+	return r.c.Subscribe(topic, consumer.MessageSelector{}, func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+		messages := make([]*Message, 0, len(msgs))
+		for _, msg := range msgs {
+			messages = append(messages, fromMessageExt(msg))
+		}
+		result, err := handler(ctx, messages...)
+		if result == ConsumeRetryLater {
+			return consumer.ConsumeRetryLater, err
+		}
+		return consumer.ConsumeSuccess, err
+	})
+}
+
+func (r *rocketConsumer) Start() error {
+	return r.c.Start()
+}
+
+func (r *rocketConsumer) Shutdown() error {
+	return r.c.Shutdown()
+}
+
+func fromMessageExt(msg *primitive.MessageExt) *Message {
+	return &Message{
+		Topic:          msg.Topic,
+		Body:           msg.Body,
+		MsgId:          msg.MsgId,
+		TransactionId:  msg.TransactionId,
+		ReconsumeTimes: msg.ReconsumeTimes,
+	}
+}
+
+func toRocketState(state LocalTransactionState) primitive.LocalTransactionState {
+	switch state {
+	case CommitMessageState:
+		return primitive.CommitMessageState
+	case RollbackMessageState:
+		return primitive.RollbackMessageState
+	default:
+		return primitive.UnknowState
+	}
+}
+
+func fromRocketState(state primitive.LocalTransactionState) LocalTransactionState {
+	switch state {
+	case primitive.CommitMessageState:
+		return CommitMessageState
+	case primitive.RollbackMessageState:
+		return RollbackMessageState
+	default:
+		return UnknowState
+	}
+}
@@ -0,0 +1,96 @@
+package ordersn
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+)
+
+const workerSessionTTL = "10s"
+
+// WorkerLease 从consul中分配到的worker id
+// 每个worker id对应consul中的一个key， 通过session锁住， 服务退出或者session过期之后key会被删除
+// consul默认的lock-delay为15s， session失效之后这段时间内其他实例拿不到这个key， 本实例在这之前就会停止使用这个worker id
+type WorkerLease struct {
+	WorkerId int64
+
+	client    *api.Client
+	sessionId string
+	doneCh    chan struct{}
+	lost      int32
+}
+
+// AllocateWorkerId 依次尝试锁住 keyPrefix/0 到 keyPrefix/1023， 拿到的第一个就是本实例的worker id
+func AllocateWorkerId(client *api.Client, keyPrefix string) (*WorkerLease, error) {
+	session := client.Session()
+	sessionId, _, err := session.Create(&api.SessionEntry{
+		Name:     keyPrefix,
+		TTL:      workerSessionTTL,
+		Behavior: api.SessionBehaviorDelete,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建consul session失败: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	kv := client.KV()
+	for workerId := int64(0); workerId <= MaxWorkerId; workerId++ {
+		acquired, _, err := kv.Acquire(&api.KVPair{
+			Key:     fmt.Sprintf("%s/%d", keyPrefix, workerId),
+			Value:   []byte(fmt.Sprintf("%s %s", hostname, time.Now().Format("2006-01-02 15:04:05"))),
+			Session: sessionId,
+		}, nil)
+		if err != nil {
+			_, _ = session.Destroy(sessionId, nil)
+			return nil, fmt.Errorf("分配worker id失败: %w", err)
+		}
+		if !acquired {
+			continue
+		}
+
+		lease := &WorkerLease{
+			WorkerId:  workerId,
+			client:    client,
+			sessionId: sessionId,
+			doneCh:    make(chan struct{}),
+		}
+		go lease.renew()
+		return lease, nil
+	}
+
+	_, _ = session.Destroy(sessionId, nil)
+	return nil, fmt.Errorf("worker id已经分配完了")
+}
+
+func (l *WorkerLease) renew() {
+	// RenewPeriodic在doneCh关闭的时候返回nil， session过期的时候返回错误
+	if err := l.client.Session().RenewPeriodic(workerSessionTTL, l.sessionId, nil, l.doneCh); err != nil {
+		atomic.StoreInt32(&l.lost, 1)
+		zap.S().Errorf("worker id %d 的consul session已经失效: %s", l.WorkerId, err.Error())
+	}
+}
+
+func (l *WorkerLease) Alive() bool {
+	return atomic.LoadInt32(&l.lost) == 0
+}
+
+// Release 服务退出的时候释放worker id
+func (l *WorkerLease) Release() {
+	atomic.StoreInt32(&l.lost, 1)
+	close(l.doneCh)
+	_, _ = l.client.Session().Destroy(l.sessionId, nil)
+}
+
+// NewConsulSnowflake 使用从consul分配的worker id， worker id失效之后Next会返回ErrWorkerLost
+func NewConsulSnowflake(lease *WorkerLease) (*Snowflake, error) {
+	s, err := NewSnowflake(lease.WorkerId)
+	if err != nil {
+		return nil, err
+	}
+	s.alive = lease.Alive
+	return s, nil
+}
@@ -0,0 +1,91 @@
+package ordersn
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// 订单号的格式， 一共21位数字(1位生成方式 + 19位编号 + 1位校验位)， 所有的订单号长度相同
+// 生成方式： 1 snowflake， 2 数据库号段
+// 同一种生成方式生成的订单号按照时间递增， 字符串比较和数字比较的结果一致
+// 校验位使用Luhn算法， 用户输入订单号查询的时候可以先校验， 不用去查数据库
+const (
+	Length = 21
+
+	prefixSnowflake = '1'
+	prefixSegment   = '2'
+)
+
+var ErrInvalidOrderSn = errors.New("订单号格式不正确")
+
+// Generator 订单号生成器， 实现必须是并发安全的
+type Generator interface {
+	Next() (string, error)
+}
+
+func format(prefix byte, body string) string {
+	digits := string(prefix) + body
+	return digits + string(checkDigit(digits))
+}
+
+// checkDigit 计算Luhn校验位
+func checkDigit(digits string) byte {
+	sum := 0
+	double := true // 从右往左数， 校验位左边的第一位需要乘2
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Validate 校验订单号的长度、生成方式和校验位
+func Validate(sn string) error {
+	if len(sn) != Length {
+		return ErrInvalidOrderSn
+	}
+	for i := 0; i < len(sn); i++ {
+		if sn[i] < '0' || sn[i] > '9' {
+			return ErrInvalidOrderSn
+		}
+	}
+	if sn[0] != prefixSnowflake && sn[0] != prefixSegment {
+		return ErrInvalidOrderSn
+	}
+	if checkDigit(sn[:Length-1]) != sn[Length-1] {
+		return ErrInvalidOrderSn
+	}
+	return nil
+}
+
+type fallbackGenerator struct {
+	primary  Generator
+	fallback Generator
+}
+
+// WithFallback primary生成失败(时钟回拨、worker id丢失)的时候使用fallback生成
+func WithFallback(primary, fallback Generator) Generator {
+	return &fallbackGenerator{primary: primary, fallback: fallback}
+}
+
+func (g *fallbackGenerator) Next() (string, error) {
+	sn, err := g.primary.Next()
+	if err == nil {
+		return sn, nil
+	}
+	zap.S().Warnf("订单号生成失败， 使用备用的生成方式: %s", err.Error())
+	sn, err = g.fallback.Next()
+	if err != nil {
+		return "", fmt.Errorf("备用的订单号生成方式也失败了: %w", err)
+	}
+	return sn, nil
+}
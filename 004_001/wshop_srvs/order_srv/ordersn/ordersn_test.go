@@ -0,0 +1,250 @@
+package ordersn
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memorySegmentStore 内存中的号段存储， 模拟多个实例共享同一张号段表
+type memorySegmentStore struct {
+	mu    sync.Mutex
+	maxId map[string]int64
+	fail  bool
+}
+
+func newMemorySegmentStore() *memorySegmentStore {
+	return &memorySegmentStore{maxId: make(map[string]int64)}
+}
+
+func (m *memorySegmentStore) NextSegment(bizTag string, step int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail {
+		return 0, errors.New("数据库不可用")
+	}
+	m.maxId[bizTag] += step
+	return m.maxId[bizTag], nil
+}
+
+type failGenerator struct{}
+
+func (failGenerator) Next() (string, error) {
+	return "", ErrClockBackwards
+}
+
+// generateConcurrently 每个生成器启动goroutines个协程， 每个协程生成perGoroutine个订单号
+func generateConcurrently(t *testing.T, generators []Generator, goroutines, perGoroutine int) []string {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	results := make(chan string, len(generators)*goroutines*perGoroutine)
+	errs := make(chan error, len(generators)*goroutines)
+	for _, g := range generators {
+		for i := 0; i < goroutines; i++ {
+			wg.Add(1)
+			go func(g Generator) {
+				defer wg.Done()
+				for j := 0; j < perGoroutine; j++ {
+					sn, err := g.Next()
+					if err != nil {
+						errs <- err
+						return
+					}
+					results <- sn
+				}
+			}(g)
+		}
+	}
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("生成订单号失败: %v", err)
+	}
+	var sns []string
+	for sn := range results {
+		sns = append(sns, sn)
+	}
+	return sns
+}
+
+func assertUniqueAndValid(t *testing.T, sns []string, expected int) {
+	t.Helper()
+
+	if len(sns) != expected {
+		t.Fatalf("期望生成%d个订单号， 实际生成了%d个", expected, len(sns))
+	}
+	seen := make(map[string]bool, len(sns))
+	for _, sn := range sns {
+		if err := Validate(sn); err != nil {
+			t.Fatalf("订单号 %s 校验失败: %v", sn, err)
+		}
+		if seen[sn] {
+			t.Fatalf("订单号重复: %s", sn)
+		}
+		seen[sn] = true
+	}
+}
+
+func TestSnowflakeConcurrentUnique(t *testing.T) {
+	// 模拟4个实例， 每个实例100个协程同时下单
+	var generators []Generator
+	for workerId := int64(0); workerId < 4; workerId++ {
+		s, err := NewSnowflake(workerId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		generators = append(generators, s)
+	}
+
+	sns := generateConcurrently(t, generators, 100, 500)
+	assertUniqueAndValid(t, sns, 4*100*500)
+}
+
+func TestSnowflakeSortable(t *testing.T) {
+	s, _ := NewSnowflake(1)
+	var prev string
+	for i := 0; i < 10000; i++ {
+		sn, err := s.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sn) != Length {
+			t.Fatalf("订单号长度不正确: %s", sn)
+		}
+		if sn <= prev {
+			t.Fatalf("订单号没有递增: %s <= %s", sn, prev)
+		}
+		prev = sn
+	}
+}
+
+func TestSnowflakeSequenceOverflow(t *testing.T) {
+	// 时钟停在同一毫秒， 序号用完之后必须等到下一毫秒
+	s, _ := NewSnowflake(1)
+	base := time.Now()
+	calls := 0
+	s.now = func() time.Time {
+		calls++
+		if calls > maxSequence+2 {
+			return base.Add(time.Millisecond)
+		}
+		return base
+	}
+
+	ids := make(map[int64]bool)
+	for i := 0; i < maxSequence+2; i++ {
+		id, err := s.NextId()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids[id] {
+			t.Fatalf("编号重复: %d", id)
+		}
+		ids[id] = true
+	}
+}
+
+func TestSnowflakeClockBackwards(t *testing.T) {
+	s, _ := NewSnowflake(1)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	if _, err := s.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(-time.Second)
+	if _, err := s.Next(); err != ErrClockBackwards {
+		t.Fatalf("时钟回拨应该返回ErrClockBackwards， 实际: %v", err)
+	}
+}
+
+func TestSnowflakeWorkerLost(t *testing.T) {
+	s, _ := NewSnowflake(1)
+	s.alive = func() bool { return false }
+	if _, err := s.Next(); err != ErrWorkerLost {
+		t.Fatalf("worker id失效应该返回ErrWorkerLost， 实际: %v", err)
+	}
+}
+
+func TestNewSnowflakeInvalidWorkerId(t *testing.T) {
+	if _, err := NewSnowflake(MaxWorkerId + 1); err == nil {
+		t.Fatal("worker id超出范围应该返回错误")
+	}
+	if _, err := NewSnowflake(-1); err == nil {
+		t.Fatal("worker id为负数应该返回错误")
+	}
+}
+
+func TestSegmentConcurrentUnique(t *testing.T) {
+	// 模拟4个实例共享同一张号段表， 号段长度比较小可以覆盖频繁申请号段的情况
+	store := newMemorySegmentStore()
+	var generators []Generator
+	for i := 0; i < 4; i++ {
+		generators = append(generators, NewSegment(store, "order_sn", 50))
+	}
+
+	sns := generateConcurrently(t, generators, 100, 200)
+	assertUniqueAndValid(t, sns, 4*100*200)
+}
+
+func TestSegmentSortable(t *testing.T) {
+	s := NewSegment(newMemorySegmentStore(), "order_sn", 10)
+	var sns []string
+	for i := 0; i < 100; i++ {
+		sn, err := s.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sns = append(sns, sn)
+	}
+	if !sort.StringsAreSorted(sns) {
+		t.Fatal("同一个实例生成的订单号没有递增")
+	}
+}
+
+func TestSegmentStoreError(t *testing.T) {
+	store := newMemorySegmentStore()
+	store.fail = true
+	if _, err := NewSegment(store, "order_sn", 10).Next(); err == nil {
+		t.Fatal("号段申请失败应该返回错误")
+	}
+}
+
+func TestFallback(t *testing.T) {
+	g := WithFallback(failGenerator{}, NewSegment(newMemorySegmentStore(), "order_sn", 10))
+	sn, err := g.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sn[0] != prefixSegment {
+		t.Fatalf("应该使用号段模式生成， 实际: %s", sn)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s, _ := NewSnowflake(7)
+	sn, _ := s.Next()
+	if err := Validate(sn); err != nil {
+		t.Fatalf("订单号 %s 校验失败: %v", sn, err)
+	}
+
+	// 改动任意一位数字都应该校验失败
+	for i := 0; i < len(sn); i++ {
+		b := []byte(sn)
+		b[i] = '0' + (b[i]-'0'+1)%10
+		if Validate(string(b)) == nil {
+			t.Fatalf("修改第%d位之后的订单号 %s 不应该通过校验", i, b)
+		}
+	}
+
+	for _, invalid := range []string{"", "123", sn[:Length-1], sn + "0", "a" + sn[1:]} {
+		if Validate(invalid) == nil {
+			t.Fatalf("%q 不应该通过校验", invalid)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package ordersn
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// 号段模式编号 19位： 6位日期(yyMMdd) + 13位号段中的id
+const maxSegmentId = 1e13
+
+// SegmentStore 号段的存储， 多个实例共享同一个存储
+type SegmentStore interface {
+	// NextSegment 申请一个长度为step的号段， 返回号段的上限(不包含)， 号段为[maxId-step, maxId)
+	NextSegment(bizTag string, step int64) (maxId int64, err error)
+}
+
+// Segment 数据库号段模式， 每次从数据库申请一段id缓存在内存中， 用完了再申请
+// 不依赖时钟和worker id， 作为snowflake的备用方式
+type Segment struct {
+	mu     sync.Mutex
+	store  SegmentStore
+	bizTag string
+	step   int64
+	cur    int64
+	max    int64
+
+	now func() time.Time
+}
+
+func NewSegment(store SegmentStore, bizTag string, step int64) *Segment {
+	if step <= 0 {
+		step = 1000
+	}
+	return &Segment{store: store, bizTag: bizTag, step: step, now: time.Now}
+}
+
+func (s *Segment) Next() (string, error) {
+	id, err := s.NextId()
+	if err != nil {
+		return "", err
+	}
+	return format(prefixSegment, s.now().Format("060102")+fmt.Sprintf("%013d", id)), nil
+}
+
+func (s *Segment) NextId() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cur >= s.max {
+		maxId, err := s.store.NextSegment(s.bizTag, s.step)
+		if err != nil {
+			return 0, fmt.Errorf("申请号段失败: %w", err)
+		}
+		s.cur, s.max = maxId-s.step, maxId
+	}
+	if s.cur >= maxSegmentId {
+		return 0, fmt.Errorf("号段已经用完了")
+	}
+
+	id := s.cur
+	s.cur++
+	return id, nil
+}
@@ -0,0 +1,36 @@
+package ordersn
+
+import (
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormSegmentStore 使用数据库中的ordersnsegment表保存号段
+type GormSegmentStore struct {
+	DB *gorm.DB
+}
+
+func (g *GormSegmentStore) NextSegment(bizTag string, step int64) (int64, error) {
+	var maxId int64
+	err := g.DB.Transaction(func(tx *gorm.DB) error {
+		// update会锁住这一行， 并发申请的实例会依次拿到不同的号段
+		result := tx.Model(&model.OrderSnSegment{}).Where("biz_tag = ?", bizTag).Update("max_id", gorm.Expr("max_id + ?", step))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 第一次使用， 并发创建的时候唯一索引会让其中一个失败， 下一次申请就正常了
+			maxId = step
+			return tx.Create(&model.OrderSnSegment{BizTag: bizTag, MaxId: maxId}).Error
+		}
+
+		var segment model.OrderSnSegment
+		if result := tx.Where("biz_tag = ?", bizTag).First(&segment); result.Error != nil {
+			return result.Error
+		}
+		maxId = segment.MaxId
+		return nil
+	})
+	return maxId, err
+}
@@ -0,0 +1,93 @@
+package ordersn
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// snowflake编号 63位： 41位毫秒时间戳(从epoch开始， 可以使用69年) + 10位worker id + 12位序号
+// 每个worker每毫秒最多生成4096个编号， 用完之后等到下一毫秒
+const (
+	workerIdBits = 10
+	sequenceBits = 12
+
+	MaxWorkerId = 1<<workerIdBits - 1
+	maxSequence = 1<<sequenceBits - 1
+
+	// 时钟回拨在这个时间以内的等待时钟追上来， 超过了直接报错
+	maxBackwardWait = 5 * time.Millisecond
+)
+
+var (
+	epoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ErrClockBackwards = errors.New("时钟回拨， 拒绝生成订单号")
+	ErrWorkerLost     = errors.New("worker id已经失效")
+)
+
+type Snowflake struct {
+	mu       sync.Mutex
+	workerId int64
+	lastMs   int64
+	sequence int64
+
+	now   func() time.Time
+	alive func() bool // worker id是否还有效， 从consul分配的worker id在session失效之后不能再使用
+}
+
+func NewSnowflake(workerId int64) (*Snowflake, error) {
+	if workerId < 0 || workerId > MaxWorkerId {
+		return nil, fmt.Errorf("worker id必须在0到%d之间", MaxWorkerId)
+	}
+	return &Snowflake{workerId: workerId, lastMs: -1, now: time.Now}, nil
+}
+
+func (s *Snowflake) Next() (string, error) {
+	id, err := s.NextId()
+	if err != nil {
+		return "", err
+	}
+	return format(prefixSnowflake, fmt.Sprintf("%019d", id)), nil
+}
+
+func (s *Snowflake) NextId() (int64, error) {
+	if s.alive != nil && !s.alive() {
+		return 0, ErrWorkerLost
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ms := s.millis()
+	if ms < s.lastMs {
+		backward := time.Duration(s.lastMs-ms) * time.Millisecond
+		if backward > maxBackwardWait {
+			return 0, ErrClockBackwards
+		}
+		time.Sleep(backward)
+		if ms = s.millis(); ms < s.lastMs {
+			return 0, ErrClockBackwards
+		}
+	}
+
+	if ms == s.lastMs {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			// 这一毫秒的序号用完了
+			for ms <= s.lastMs {
+				ms = s.millis()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastMs = ms
+
+	return ms<<(workerIdBits+sequenceBits) | s.workerId<<sequenceBits | s.sequence, nil
+}
+
+func (s *Snowflake) millis() int64 {
+	return s.now().Sub(epoch).Milliseconds()
+}
@@ -0,0 +1,584 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/mq"
+)
+
+// memoryStore 内存中的本地消息表， 语义和GormStore一致
+type memoryStore struct {
+	mu           sync.Mutex
+	seq          int32
+	rows         map[int32]*model.OutboxMessage
+	failMarkSent bool
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{rows: make(map[int32]*model.OutboxMessage)}
+}
+
+// commit 模拟业务事务提交， 提交之后消息就已经持久化了
+func (s *memoryStore) commit(topic string, body string, delayLevel int32, now time.Time) int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	msg := &model.OutboxMessage{Topic: topic, Body: body, DelayLevel: delayLevel, Status: model.OUTBOX_PENDING, NextRetryAt: now}
+	msg.ID = s.seq
+	msg.CreatedAt = now
+	s.rows[msg.ID] = msg
+	return msg.ID
+}
+
+func (s *memoryStore) get(id int32) model.OutboxMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.rows[id]
+}
+
+func (s *memoryStore) Pending(now time.Time, limit int) ([]*model.OutboxMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var msgs []*model.OutboxMessage
+	for _, row := range s.rows {
+		if row.Status == model.OUTBOX_PENDING && !row.NextRetryAt.After(now) {
+			msg := *row
+			msgs = append(msgs, &msg)
+		}
+	}
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+	if len(msgs) > limit {
+		msgs = msgs[:limit]
+	}
+	return msgs, nil
+}
+
+func (s *memoryStore) Claim(msg *model.OutboxMessage, until time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := s.rows[msg.ID]
+	if row.Status != model.OUTBOX_PENDING || !row.NextRetryAt.Equal(msg.NextRetryAt) {
+		return false, nil
+	}
+	row.NextRetryAt = until
+	msg.NextRetryAt = until
+	return true, nil
+}
+
+func (s *memoryStore) MarkSent(msg *model.OutboxMessage, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failMarkSent {
+		return errors.New("数据库不可用")
+	}
+	row := s.rows[msg.ID]
+	row.Status = model.OUTBOX_SENT
+	row.SentAt = &at
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(msg *model.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := s.rows[msg.ID]
+	row.Status = msg.Status
+	row.Attempts = msg.Attempts
+	row.NextRetryAt = msg.NextRetryAt
+	row.LastError = msg.LastError
+	return nil
+}
+
+func (s *memoryStore) PendingStats() (int64, *time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	var oldest *time.Time
+	for _, row := range s.rows {
+		if row.Status != model.OUTBOX_PENDING {
+			continue
+		}
+		count++
+		if oldest == nil || row.CreatedAt.Before(*oldest) {
+			t := row.CreatedAt
+			oldest = &t
+		}
+	}
+	return count, oldest, nil
+}
+
+// conflictStore 抢占消息之前， 另一个实例先抢占了steal中的消息
+type conflictStore struct {
+	*memoryStore
+	steal map[int32]bool
+	until time.Time
+}
+
+func (s *conflictStore) Claim(msg *model.OutboxMessage, until time.Time) (bool, error) {
+	if s.steal[msg.ID] {
+		other := *msg
+		if ok, err := s.memoryStore.Claim(&other, s.until); err != nil || !ok {
+			return ok, err
+		}
+	}
+	return s.memoryStore.Claim(msg, until)
+}
+
+// fakeProducer 前fails次发送失败， 记录发送成功的消息和延时等级
+type fakeProducer struct {
+	mu     sync.Mutex
+	fails  int
+	err    error
+	calls  int
+	sent   []string
+	levels []int
+}
+
+func (p *fakeProducer) Start() error    { return nil }
+func (p *fakeProducer) Shutdown() error { return nil }
+
+func (p *fakeProducer) SendSync(ctx context.Context, msg *mq.Message) error {
+	return p.SendDelay(ctx, msg, 0)
+}
+
+func (p *fakeProducer) SendDelay(ctx context.Context, msg *mq.Message, level int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.fails != 0 {
+		p.fails--
+		return p.err
+	}
+	p.sent = append(p.sent, string(msg.Body))
+	p.levels = append(p.levels, level)
+	return nil
+}
+
+// fakeClock 手动推进的时钟
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// received 记录消费者收到的消息
+type received struct {
+	mu     sync.Mutex
+	bodies []string
+}
+
+func (r *received) handle(ctx context.Context, msgs ...*mq.Message) (mq.ConsumeResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, msg := range msgs {
+		r.bodies = append(r.bodies, string(msg.Body))
+	}
+	return mq.ConsumeSuccess, nil
+}
+
+func (r *received) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.bodies...)
+}
+
+type testEnv struct {
+	store    *memoryStore
+	clock    *fakeClock
+	broker   *mq.MemoryBroker
+	producer mq.DelayProducer
+	got      *received
+}
+
+func newTestEnv(t *testing.T, topics ...string) *testEnv {
+	t.Helper()
+
+	broker := mq.NewMemoryBroker(mq.WithDelayLevels(time.Millisecond, 2*time.Millisecond, 5*time.Millisecond), mq.WithCheckInterval(0))
+	t.Cleanup(broker.Close)
+
+	got := &received{}
+	consumer, _ := broker.NewPushConsumer("test")
+	for _, topic := range topics {
+		if err := consumer.Subscribe(topic, got.handle); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := consumer.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	producer, _ := broker.NewProducer()
+	if err := producer.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	return &testEnv{
+		store:    newMemoryStore(),
+		clock:    &fakeClock{now: time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)},
+		broker:   broker,
+		producer: producer,
+		got:      got,
+	}
+}
+
+func (e *testEnv) newRelay() *Relay {
+	r := NewRelay(e.store, e.producer)
+	r.now = e.clock.Now
+	return r
+}
+
+func (e *testEnv) runOnce(t *testing.T, r *Relay) int {
+	t.Helper()
+	sent, err := r.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.broker.Wait()
+	return sent
+}
+
+func TestCrashAfterCommitBeforePublish(t *testing.T) {
+	// 订单事务已经提交， 进程在发送延时消息之前退出， 重启之后的relay要把消息补发出去
+	env := newTestEnv(t, "order_timeout")
+	id := env.store.commit("order_timeout", `{"OrderSn":"1"}`, 3, env.clock.Now())
+
+	if got := env.got.list(); len(got) != 0 {
+		t.Fatalf("relay运行之前不应该收到消息， 实际: %v", got)
+	}
+
+	restarted := env.newRelay()
+	if sent := env.runOnce(t, restarted); sent != 1 {
+		t.Fatalf("期望发送1条消息， 实际: %d", sent)
+	}
+	if got := env.got.list(); len(got) != 1 || got[0] != `{"OrderSn":"1"}` {
+		t.Fatalf("消费者收到的消息不正确: %v", got)
+	}
+	if row := env.store.get(id); row.Status != model.OUTBOX_SENT || row.SentAt == nil {
+		t.Fatalf("消息应该被标记为已发送， 实际状态: %d", row.Status)
+	}
+
+	// 已经发送的消息不会再发送
+	if sent := env.runOnce(t, restarted); sent != 0 {
+		t.Fatalf("不应该重复发送， 实际发送了%d条", sent)
+	}
+}
+
+func TestCrashAfterClaimBeforePublish(t *testing.T) {
+	// relay抢占了消息之后进程退出， 抢占过期之前其他relay不能发送， 过期之后继续发送
+	env := newTestEnv(t, "order_reback")
+	id := env.store.commit("order_reback", `{"OrderSn":"2"}`, 0, env.clock.Now())
+
+	crashed := env.newRelay()
+	msgs, _ := env.store.Pending(env.clock.Now(), 10)
+	if ok, _ := env.store.Claim(msgs[0], env.clock.Now().Add(crashed.ClaimTimeout)); !ok {
+		t.Fatal("抢占消息失败")
+	}
+
+	other := env.newRelay()
+	if sent := env.runOnce(t, other); sent != 0 {
+		t.Fatalf("抢占过期之前不应该发送， 实际发送了%d条", sent)
+	}
+
+	env.clock.Advance(other.ClaimTimeout)
+	if sent := env.runOnce(t, other); sent != 1 {
+		t.Fatalf("抢占过期之后应该发送1条消息， 实际: %d", sent)
+	}
+	if got := env.got.list(); len(got) != 1 {
+		t.Fatalf("期望收到1条消息， 实际: %v", got)
+	}
+	if row := env.store.get(id); row.Status != model.OUTBOX_SENT {
+		t.Fatalf("消息应该被标记为已发送， 实际状态: %d", row.Status)
+	}
+}
+
+func TestCrashAfterPublishBeforeMarkSent(t *testing.T) {
+	// 消息发送成功但是没有来得及标记， 抢占过期之后会再发送一次， 至少一次的语义需要消费者幂等
+	env := newTestEnv(t, "order_reback")
+	id := env.store.commit("order_reback", `{"OrderSn":"3"}`, 0, env.clock.Now())
+
+	relay := env.newRelay()
+	env.store.failMarkSent = true
+	if _, err := relay.RunOnce(context.Background()); err == nil {
+		t.Fatal("标记失败应该返回错误")
+	}
+	env.broker.Wait()
+
+	env.store.failMarkSent = false
+	if sent := env.runOnce(t, relay); sent != 0 {
+		t.Fatalf("抢占过期之前不应该重复发送， 实际发送了%d条", sent)
+	}
+	env.clock.Advance(relay.ClaimTimeout)
+	if sent := env.runOnce(t, relay); sent != 1 {
+		t.Fatalf("抢占过期之后应该重新发送， 实际发送了%d条", sent)
+	}
+	if got := env.got.list(); len(got) != 2 {
+		t.Fatalf("期望收到2次消息， 实际: %v", got)
+	}
+	if row := env.store.get(id); row.Status != model.OUTBOX_SENT {
+		t.Fatalf("消息应该被标记为已发送， 实际状态: %d", row.Status)
+	}
+}
+
+func TestRelayRetryWithBackoff(t *testing.T) {
+	env := newTestEnv(t, "order_reback")
+	id := env.store.commit("order_reback", `{"OrderSn":"4"}`, 0, env.clock.Now())
+
+	// 消息队列不可用
+	_ = env.producer.Shutdown()
+	relay := env.newRelay()
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}
+	for i, backoff := range expected {
+		start := env.clock.Now()
+		if sent := env.runOnce(t, relay); sent != 0 {
+			t.Fatalf("消息队列不可用的时候不应该发送成功")
+		}
+		row := env.store.get(id)
+		if row.Attempts != int32(i+1) || row.Status != model.OUTBOX_PENDING || row.LastError == "" {
+			t.Fatalf("第%d次失败之后的状态不正确: %+v", i+1, row)
+		}
+		if !row.NextRetryAt.Equal(start.Add(backoff)) {
+			t.Fatalf("第%d次失败之后应该等待%s， 实际: %s", i+1, backoff, row.NextRetryAt.Sub(start))
+		}
+
+		// 没有到重试时间不会发送
+		env.clock.Advance(backoff - time.Millisecond)
+		if sent := env.runOnce(t, relay); sent != 0 {
+			t.Fatal("没有到重试时间不应该发送")
+		}
+		env.clock.Advance(time.Millisecond)
+	}
+
+	_ = env.producer.Start()
+	if sent := env.runOnce(t, relay); sent != 1 {
+		t.Fatalf("消息队列恢复之后应该发送成功， 实际发送了%d条", sent)
+	}
+	if stats := relay.Stats(); stats.Retried != 3 || stats.Sent != 1 || stats.Pending != 0 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+}
+
+func TestRelayBackoffCapped(t *testing.T) {
+	relay := NewRelay(newMemoryStore(), nil)
+	if d := relay.backoff(100); d != relay.MaxBackoff {
+		t.Fatalf("重试间隔不应该超过%s， 实际: %s", relay.MaxBackoff, d)
+	}
+}
+
+func TestRelayGiveUpAfterMaxAttempts(t *testing.T) {
+	env := newTestEnv(t, "order_reback")
+	// 超出范围的延时等级， 永远发送失败
+	id := env.store.commit("order_reback", `{"OrderSn":"5"}`, 99, env.clock.Now())
+
+	relay := env.newRelay()
+	relay.MaxAttempts = 3
+	for i := 0; i < 5; i++ {
+		env.runOnce(t, relay)
+		env.clock.Advance(relay.MaxBackoff)
+	}
+
+	row := env.store.get(id)
+	if row.Status != model.OUTBOX_FAILED || row.Attempts != 3 {
+		t.Fatalf("超过重试次数之后应该标记为失败: %+v", row)
+	}
+	if stats := relay.Stats(); stats.Failed != 1 || stats.Pending != 0 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+}
+
+func TestRelayLag(t *testing.T) {
+	env := newTestEnv(t, "order_reback")
+	_ = env.producer.Shutdown()
+
+	env.store.commit("order_reback", `{"OrderSn":"6"}`, 0, env.clock.Now())
+	env.clock.Advance(10 * time.Second)
+	env.store.commit("order_reback", `{"OrderSn":"7"}`, 0, env.clock.Now())
+	env.clock.Advance(5 * time.Second)
+
+	relay := env.newRelay()
+	env.runOnce(t, relay)
+	if stats := relay.Stats(); stats.Pending != 2 || stats.LagSeconds != 15 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+
+	_ = env.producer.Start()
+	env.clock.Advance(relay.MaxBackoff)
+	env.runOnce(t, relay)
+	if stats := relay.Stats(); stats.Pending != 0 || stats.LagSeconds != 0 || stats.Sent != 2 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+}
+
+func TestConcurrentRelaysSendOnce(t *testing.T) {
+	// 多个实例同时运行relay， 每条消息只会被发送一次
+	env := newTestEnv(t, "order_reback")
+	for i := 0; i < 200; i++ {
+		env.store.commit("order_reback", "body", 0, env.clock.Now())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(r *Relay) {
+			defer wg.Done()
+			r.BatchSize = 10
+			for {
+				sent, err := r.RunOnce(context.Background())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if sent == 0 {
+					if count, _, _ := env.store.PendingStats(); count == 0 {
+						return
+					}
+				}
+			}
+		}(env.newRelay())
+	}
+	wg.Wait()
+	env.broker.Wait()
+
+	if got := env.got.list(); len(got) != 200 {
+		t.Fatalf("期望收到200条消息， 实际: %d", len(got))
+	}
+}
+
+func TestRelayNotify(t *testing.T) {
+	env := newTestEnv(t, "order_reback")
+	relay := env.newRelay()
+	relay.Interval = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	env.store.commit("order_reback", `{"OrderSn":"8"}`, 0, env.clock.Now())
+	relay.Notify()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for relay.Stats().Sent != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("Notify之后应该立即发送")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRelayClaimConflict(t *testing.T) {
+	// 另一个实例已经抢占了消息， CAS失败之后跳过， 不发送也不记录失败
+	clock := &fakeClock{now: time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)}
+	store := &conflictStore{memoryStore: newMemoryStore(), until: clock.Now().Add(time.Minute)}
+	stolen := store.commit("order_reback", `{"OrderSn":"9"}`, 0, clock.Now())
+	own := store.commit("order_reback", `{"OrderSn":"10"}`, 0, clock.Now())
+	store.steal = map[int32]bool{stolen: true}
+
+	producer := &fakeProducer{}
+	relay := NewRelay(store, producer)
+	relay.now = clock.Now
+	sent, err := relay.RunOnce(context.Background())
+	if err != nil || sent != 1 {
+		t.Fatalf("RunOnce = %d, %v, 期望发送1条", sent, err)
+	}
+	if producer.calls != 1 || producer.sent[0] != `{"OrderSn":"10"}` {
+		t.Fatalf("只应该发送自己抢占到的消息， 实际: %v", producer.sent)
+	}
+
+	row := store.get(stolen)
+	if row.Status != model.OUTBOX_PENDING || row.Attempts != 0 || !row.NextRetryAt.Equal(store.until) {
+		t.Fatalf("被其他实例抢占的消息不应该被修改: %+v", row)
+	}
+	if row := store.get(own); row.Status != model.OUTBOX_SENT {
+		t.Fatalf("消息应该被标记为已发送， 实际状态: %d", row.Status)
+	}
+	if stats := relay.Stats(); stats.Sent != 1 || stats.Retried != 0 || stats.Pending != 1 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+}
+
+func TestRelayFailingProducer(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)}
+	store := newMemoryStore()
+	id := store.commit("order_timeout", `{"OrderSn":"11"}`, 3, clock.Now())
+
+	producer := &fakeProducer{fails: 2, err: errors.New(strings.Repeat("x", 300))}
+	relay := NewRelay(store, producer)
+	relay.now = clock.Now
+	for i, backoff := range []time.Duration{time.Second, 2 * time.Second} {
+		start := clock.Now()
+		if sent, err := relay.RunOnce(context.Background()); err != nil || sent != 0 {
+			t.Fatalf("第%d次发送应该失败: %d, %v", i+1, sent, err)
+		}
+		row := store.get(id)
+		if row.Attempts != int32(i+1) || row.Status != model.OUTBOX_PENDING || !row.NextRetryAt.Equal(start.Add(backoff)) {
+			t.Fatalf("第%d次失败之后的状态不正确: %+v", i+1, row)
+		}
+		// 错误信息按照字段长度截断
+		if len(row.LastError) != 200 {
+			t.Fatalf("错误信息的长度: %d", len(row.LastError))
+		}
+		clock.Advance(backoff)
+	}
+
+	if sent, err := relay.RunOnce(context.Background()); err != nil || sent != 1 {
+		t.Fatalf("恢复之后应该发送成功: %d, %v", sent, err)
+	}
+	if producer.calls != 3 || len(producer.levels) != 1 || producer.levels[0] != 3 {
+		t.Fatalf("发送了%d次， 延时等级: %v", producer.calls, producer.levels)
+	}
+	if row := store.get(id); row.Status != model.OUTBOX_SENT || row.Attempts != 2 {
+		t.Fatalf("消息应该被标记为已发送: %+v", row)
+	}
+}
+
+func TestRelayFailingProducerGiveUp(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)}
+	store := newMemoryStore()
+	id := store.commit("order_reback", `{"OrderSn":"12"}`, 0, clock.Now())
+
+	producer := &fakeProducer{fails: -1, err: errors.New("消息队列不可用")}
+	relay := NewRelay(store, producer)
+	relay.now = clock.Now
+	relay.MaxAttempts = 2
+	for i := 0; i < 4; i++ {
+		if _, err := relay.RunOnce(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		clock.Advance(relay.MaxBackoff)
+	}
+
+	// 失败的消息不再发送
+	if producer.calls != 2 {
+		t.Fatalf("超过重试次数之后不应该再发送， 实际发送了%d次", producer.calls)
+	}
+	if row := store.get(id); row.Status != model.OUTBOX_FAILED || row.Attempts != 2 || row.LastError != "消息队列不可用" {
+		t.Fatalf("超过重试次数之后应该标记为失败: %+v", row)
+	}
+	if stats := relay.Stats(); stats.Failed != 1 || stats.Retried != 1 || stats.Pending != 0 {
+		t.Fatalf("监控指标不正确: %+v", stats)
+	}
+}
@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormStore 使用outboxmessage表保存消息
+type GormStore struct {
+	DB *gorm.DB
+}
+
+func (s GormStore) Pending(now time.Time, limit int) ([]*model.OutboxMessage, error) {
+	var msgs []*model.OutboxMessage
+	result := s.DB.Where("status = ? and next_retry_at <= ?", model.OUTBOX_PENDING, now).Order("id").Limit(limit).Find(&msgs)
+	return msgs, result.Error
+}
+
+func (s GormStore) Claim(msg *model.OutboxMessage, until time.Time) (bool, error) {
+	// 带上查询时的next_retry_at作为条件， 多个实例同时抢占的时候只有一个能成功
+	result := s.DB.Model(&model.OutboxMessage{}).
+		Where("id = ? and status = ? and next_retry_at = ?", msg.ID, model.OUTBOX_PENDING, msg.NextRetryAt).
+		Update("next_retry_at", until)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	msg.NextRetryAt = until
+	return true, nil
+}
+
+func (s GormStore) MarkSent(msg *model.OutboxMessage, at time.Time) error {
+	return s.DB.Model(&model.OutboxMessage{}).Where("id = ?", msg.ID).Updates(map[string]interface{}{
+		"status":  model.OUTBOX_SENT,
+		"sent_at": at,
+	}).Error
+}
+
+func (s GormStore) MarkFailed(msg *model.OutboxMessage) error {
+	return s.DB.Model(&model.OutboxMessage{}).Where("id = ?", msg.ID).Updates(map[string]interface{}{
+		"status":        msg.Status,
+		"attempts":      msg.Attempts,
+		"next_retry_at": msg.NextRetryAt,
+		"last_error":    msg.LastError,
+	}).Error
+}
+
+func (s GormStore) PendingStats() (int64, *time.Time, error) {
+	var stats struct {
+		Count  int64
+		Oldest *time.Time
+	}
+	result := s.DB.Model(&model.OutboxMessage{}).Select("count(*) as count, min(add_time) as oldest").
+		Where("status = ?", model.OUTBOX_PENDING).Scan(&stats)
+	return stats.Count, stats.Oldest, result.Error
+}
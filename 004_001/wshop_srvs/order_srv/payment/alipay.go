@@ -0,0 +1,289 @@
+package payment
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+const (
+	alipaySandboxGateway    = "https://openapi.alipaydev.com/gateway.do"
+	alipayProductionGateway = "https://openapi.alipay.com/gateway.do"
+	alipaySuccessCode       = "10000"
+)
+
+// Alipay 支付宝开放平台的客户端， 只实现了订单服务需要用到的接口
+// 签名方式为RSA2， 密钥和order-web中配置的是同一套
+type Alipay struct {
+	appId      string
+	gateway    string
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey // 支付宝公钥， 用来验证返回结果的签名， 没有配置的时候不验签
+	client     *http.Client
+}
+
+func NewAlipay(appId, privateKey, aliPublicKey string, isProduction bool) (*Alipay, error) {
+	priKey, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	a := &Alipay{
+		appId:      appId,
+		gateway:    alipaySandboxGateway,
+		privateKey: priKey,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+	if isProduction {
+		a.gateway = alipayProductionGateway
+	}
+	if aliPublicKey != "" {
+		if a.publicKey, err = parsePublicKey(aliPublicKey); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+type alipayRefundResponse struct {
+	Code       string `json:"code"`
+	Msg        string `json:"msg"`
+	SubCode    string `json:"sub_code"`
+	SubMsg     string `json:"sub_msg"`
+	TradeNo    string `json:"trade_no"`
+	OutTradeNo string `json:"out_trade_no"`
+	RefundFee  string `json:"refund_fee"`
+}
+
+// Refund 调用alipay.trade.refund， out_request_no使用退款单号， 支付宝保证同一个退款请求号只退一次
+func (a *Alipay) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	biz := map[string]string{
+		"out_trade_no":   req.OrderSn,
+		"trade_no":       req.TradeNo,
+		"refund_amount":  req.Amount.String(),
+		"out_request_no": req.RefundSn,
+		"refund_reason":  req.Reason,
+	}
+	var rsp alipayRefundResponse
+	if err := a.call(ctx, "alipay.trade.refund", biz, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.Code != alipaySuccessCode {
+		return nil, fmt.Errorf("支付宝退款失败: %s %s %s", rsp.Code, rsp.SubCode, rsp.SubMsg)
+	}
+
+	refundFee, err := money.Parse(rsp.RefundFee)
+	if err != nil {
+		return nil, err
+	}
+	return &RefundResult{
+		RefundTradeNo: rsp.TradeNo,
+		RefundAmount:  refundFee,
+	}, nil
+}
+
+type alipayQueryResponse struct {
+	Code        string `json:"code"`
+	Msg         string `json:"msg"`
+	SubCode     string `json:"sub_code"`
+	SubMsg      string `json:"sub_msg"`
+	TradeNo     string `json:"trade_no"`
+	OutTradeNo  string `json:"out_trade_no"`
+	TradeStatus string `json:"trade_status"`
+	TotalAmount string `json:"total_amount"`
+	SendPayDate string `json:"send_pay_date"`
+}
+
+// QueryTrade 调用alipay.trade.query， 支付宝的交易状态和订单状态使用相同的名称
+func (a *Alipay) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	var rsp alipayQueryResponse
+	if err := a.call(ctx, "alipay.trade.query", map[string]string{"out_trade_no": orderSn}, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.SubCode == "ACQ.TRADE_NOT_EXIST" {
+		return nil, ErrTradeNotExist
+	}
+	if rsp.Code != alipaySuccessCode {
+		return nil, fmt.Errorf("查询支付宝交易失败: %s %s %s", rsp.Code, rsp.SubCode, rsp.SubMsg)
+	}
+
+	amount, err := money.Parse(rsp.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	trade := &Trade{
+		OrderSn: rsp.OutTradeNo,
+		TradeNo: rsp.TradeNo,
+		Status:  rsp.TradeStatus,
+		Amount:  amount,
+	}
+	if rsp.SendPayDate != "" {
+		if t, err := time.ParseInLocation("2006-01-02 15:04:05", rsp.SendPayDate, time.Local); err == nil {
+			trade.PayTime = &t
+		}
+	}
+	return trade, nil
+}
+
+// call 发起一次开放平台请求并把 xxx_response 节点解析到result中
+func (a *Alipay) call(ctx context.Context, method string, biz map[string]string, result interface{}) error {
+	for k, v := range biz {
+		if v == "" {
+			delete(biz, k)
+		}
+	}
+	bizContent, err := json.Marshal(biz)
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("app_id", a.appId)
+	params.Set("method", method)
+	params.Set("format", "JSON")
+	params.Set("charset", "utf-8")
+	params.Set("sign_type", "RSA2")
+	params.Set("timestamp", time.Now().Format("2006-01-02 15:04:05"))
+	params.Set("version", "1.0")
+	params.Set("biz_content", string(bizContent))
+	sign, err := a.sign(params)
+	if err != nil {
+		return err
+	}
+	params.Set("sign", sign)
+
+	httpReq, err := http.NewRequest(http.MethodPost, a.gateway, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+	httpRsp, err := a.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpRsp.Body.Close()
+	body, err := ioutil.ReadAll(httpRsp.Body)
+	if err != nil {
+		return err
+	}
+
+	// 返回的格式为 {"alipay_trade_refund_response": {...}, "sign": "..."}
+	// 签名是对response节点的原始字符串做的， 所以这里用RawMessage保留原始内容
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("解析支付宝返回结果失败: %w", err)
+	}
+	node := raw[strings.Replace(method, ".", "_", -1)+"_response"]
+	if node == nil {
+		node = raw["error_response"]
+	}
+	if node == nil {
+		return fmt.Errorf("支付宝返回结果格式不正确: %s", body)
+	}
+	if a.publicKey != nil {
+		var sign string
+		_ = json.Unmarshal(raw["sign"], &sign)
+		if err = a.verify(node, sign); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(node, result)
+}
+
+// sign 参数按照key排序后拼接成 k1=v1&k2=v2 的格式， 使用SHA256WithRSA签名
+func (a *Alipay) sign(params url.Values) (string, error) {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "sign" || params.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+params.Get(k))
+	}
+	hashed := sha256.Sum256([]byte(strings.Join(pairs, "&")))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func (a *Alipay) verify(content []byte, sign string) error {
+	sig, err := base64.StdEncoding.DecodeString(sign)
+	if err != nil {
+		return fmt.Errorf("支付宝返回的签名格式不正确: %w", err)
+	}
+	hashed := sha256.Sum256(content)
+	if err = rsa.VerifyPKCS1v15(a.publicKey, crypto.SHA256, hashed[:], sig); err != nil {
+		return errors.New("支付宝返回结果验签失败")
+	}
+	return nil
+}
+
+// 支付宝密钥工具生成的密钥是没有PEM头的base64字符串， 这里两种格式都支持
+func decodeKey(key string) ([]byte, error) {
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		return block.Bytes, nil
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, errors.New("密钥格式不正确")
+	}
+	return der, nil
+}
+
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if priKey, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return priKey, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("解析应用私钥失败: %w", err)
+	}
+	priKey, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("应用私钥不是RSA密钥")
+	}
+	return priKey, nil
+}
+
+func parsePublicKey(key string) (*rsa.PublicKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	k, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("解析支付宝公钥失败: %w", err)
+	}
+	pubKey, ok := k.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("支付宝公钥不是RSA密钥")
+	}
+	return pubKey, nil
+}
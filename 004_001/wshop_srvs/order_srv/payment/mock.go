@@ -0,0 +1,73 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TradeFunc 查询交易， 模拟支付没有支付平台， 由调用方决定交易从哪里来
+type TradeFunc func(ctx context.Context, orderSn string) (*Trade, error)
+
+// MockProvider 开发和测试环境使用的支付渠道， 退款记录只保存在内存中
+// 模拟支付的交易由order-web的模拟收银台创建， 订单服务看不到， 查询交易的时候使用lookup
+type MockProvider struct {
+	mu      sync.Mutex
+	trades  map[string]*Trade
+	refunds map[string]*RefundResult
+	lookup  TradeFunc
+}
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{
+		trades:  make(map[string]*Trade),
+		refunds: make(map[string]*RefundResult),
+	}
+}
+
+// WithLookup 没有通过SetTrade设置的交易使用lookup查询
+func (m *MockProvider) WithLookup(lookup TradeFunc) *MockProvider {
+	m.lookup = lookup
+	return m
+}
+
+// SetTrade 设置支付平台中的交易， 测试对账的时候模拟通知丢失等情况
+func (m *MockProvider) SetTrade(trade Trade) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.trades[trade.OrderSn] = &trade
+}
+
+func (m *MockProvider) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	m.mu.Lock()
+	trade, ok := m.trades[orderSn]
+	m.mu.Unlock()
+	if ok {
+		t := *trade
+		return &t, nil
+	}
+	if m.lookup != nil {
+		return m.lookup(ctx, orderSn)
+	}
+	return nil, ErrTradeNotExist
+}
+
+func (m *MockProvider) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("退款金额不合法: %s", req.Amount)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// 和真实的支付平台一样， 同一个退款单号重复请求返回第一次的结果
+	if result, ok := m.refunds[req.RefundSn]; ok {
+		return result, nil
+	}
+	result := &RefundResult{
+		RefundTradeNo: fmt.Sprintf("MOCK%d", time.Now().UnixNano()),
+		RefundAmount:  req.Amount,
+	}
+	m.refunds[req.RefundSn] = result
+	return result, nil
+}
@@ -0,0 +1,71 @@
+// Package payment 支付渠道中订单服务使用的部分: 原路退款和查询交易
+//
+// 发起支付、验证回调通知、应答通知和关闭交易只在order-web(wshop-api/order-web/payment)中实现，
+// 回调通知验证之后通过PayOrder接口交给订单服务， 订单服务不直接接收支付平台的请求
+// 新增支付平台的时候两边都要实现并且使用同一个pay_type注册
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// Provider 支付渠道， 订单服务在售后的时候原路退款， 对账的时候查询交易状态
+type Provider interface {
+	Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error)
+	// QueryTrade 查询支付平台中的交易， 还没有创建交易的时候返回ErrTradeNotExist
+	QueryTrade(ctx context.Context, orderSn string) (*Trade, error)
+}
+
+// Trade 支付平台中的交易， Status和订单服务的状态一致: TRADE_SUCCESS, TRADE_FINISHED, TRADE_CLOSED, WAIT_BUYER_PAY
+type Trade struct {
+	OrderSn string
+	TradeNo string
+	Status  string
+	Amount  money.Money
+	PayTime *time.Time
+}
+
+// ErrTradeNotExist 用户还没有打开支付页面的时候， 支付平台中还没有这笔交易
+var ErrTradeNotExist = errors.New("交易不存在")
+
+type RefundRequest struct {
+	OrderSn  string // 我们平台的订单号， 也就是支付时候的out_trade_no
+	TradeNo  string // 支付平台的交易号
+	RefundSn string // 退款单号， 同一个退款单号重复请求只会退款一次
+	Amount   money.Money
+	Total    money.Money // 订单的支付金额， 微信支付退款的时候需要传递
+	Reason   string
+}
+
+type RefundResult struct {
+	RefundTradeNo string // 支付平台的退款流水号
+	RefundAmount  money.Money
+}
+
+var (
+	mu        sync.RWMutex
+	providers = make(map[string]Provider)
+)
+
+// Register 注册支付渠道， payType和订单表中的pay_type一致
+func Register(payType string, p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[payType] = p
+}
+
+func Get(payType string) (Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[payType]
+	if !ok {
+		return nil, fmt.Errorf("不支持的支付方式: %s", payType)
+	}
+	return p, nil
+}
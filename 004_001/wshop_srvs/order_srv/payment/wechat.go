@@ -0,0 +1,239 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"wshop_srvs/order_srv/utils/money"
+)
+
+const wechatPayGateway = "https://api.mch.weixin.qq.com"
+
+// WechatPay 微信支付v3接口的客户端， 只实现了订单服务需要用到的退款和查询
+// 请求使用商户API证书的私钥签名， 应答使用微信支付平台证书验签
+type WechatPay struct {
+	mchId        string
+	serialNo     string // 商户API证书的序列号
+	privateKey   *rsa.PrivateKey
+	platformCert *x509.Certificate
+	client       *http.Client
+}
+
+func NewWechatPay(mchId, serialNo, privateKey, platformCert string) (*WechatPay, error) {
+	priKey, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	// 应答必须验签， 没有平台证书的时候不能初始化
+	block, _ := pem.Decode([]byte(platformCert))
+	if block == nil {
+		return nil, errors.New("微信支付平台证书格式不正确")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("解析微信支付平台证书失败: %w", err)
+	}
+	if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+		return nil, errors.New("微信支付平台证书不是RSA证书")
+	}
+	return &WechatPay{
+		mchId:        mchId,
+		serialNo:     serialNo,
+		privateKey:   priKey,
+		platformCert: cert,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type wechatRefundAmount struct {
+	Refund   int64  `json:"refund"`
+	Total    int64  `json:"total"`
+	Currency string `json:"currency"`
+}
+
+type wechatRefundResponse struct {
+	RefundId    string             `json:"refund_id"`
+	OutRefundNo string             `json:"out_refund_no"`
+	Status      string             `json:"status"`
+	Amount      wechatRefundAmount `json:"amount"`
+}
+
+// Refund 调用申请退款接口， out_refund_no使用退款单号， 微信支付保证同一个退款单号只退一次
+// 微信支付的退款是异步的， 受理成功就认为退款成功， 金额以分为单位
+func (w *WechatPay) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	body := map[string]interface{}{
+		"out_trade_no":  req.OrderSn,
+		"out_refund_no": req.RefundSn,
+		"reason":        req.Reason,
+		"amount": wechatRefundAmount{
+			Refund:   req.Amount.Cents(),
+			Total:    req.Total.Cents(),
+			Currency: "CNY",
+		},
+	}
+	var rsp wechatRefundResponse
+	if err := w.call(ctx, http.MethodPost, "/v3/refund/domestic/refunds", body, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.Status == "ABNORMAL" || rsp.Status == "CLOSED" {
+		return nil, fmt.Errorf("微信支付退款失败: %s", rsp.Status)
+	}
+	return &RefundResult{
+		RefundTradeNo: rsp.RefundId,
+		RefundAmount:  money.FromCents(rsp.Amount.Refund),
+	}, nil
+}
+
+// wechatError 微信支付返回的错误， 4xx和5xx的时候应答主体中有错误码
+type wechatError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *wechatError) Error() string {
+	return fmt.Sprintf("微信支付请求失败: %d %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+type wechatTransaction struct {
+	OutTradeNo    string `json:"out_trade_no"`
+	TransactionId string `json:"transaction_id"`
+	TradeState    string `json:"trade_state"`
+	SuccessTime   string `json:"success_time"`
+	Amount        struct {
+		Total int64 `json:"total"`
+	} `json:"amount"`
+}
+
+// wechatTradeStatus 微信支付的交易状态转换成订单的状态
+func wechatTradeStatus(tradeState string) string {
+	switch tradeState {
+	case "SUCCESS", "REFUND": // 转入退款的交易也是已经支付过的
+		return "TRADE_SUCCESS"
+	case "CLOSED", "REVOKED", "PAYERROR":
+		return "TRADE_CLOSED"
+	default: // NOTPAY, USERPAYING
+		return "WAIT_BUYER_PAY"
+	}
+}
+
+// QueryTrade 调用商户订单号查询订单接口
+func (w *WechatPay) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	path := "/v3/pay/transactions/out-trade-no/" + url.PathEscape(orderSn) + "?mchid=" + url.QueryEscape(w.mchId)
+	var rsp wechatTransaction
+	if err := w.call(ctx, http.MethodGet, path, nil, &rsp); err != nil {
+		var e *wechatError
+		if errors.As(err, &e) && e.Code == "ORDER_NOT_EXIST" {
+			return nil, ErrTradeNotExist
+		}
+		return nil, err
+	}
+	trade := &Trade{
+		OrderSn: rsp.OutTradeNo,
+		TradeNo: rsp.TransactionId,
+		Status:  wechatTradeStatus(rsp.TradeState),
+		Amount:  money.FromCents(rsp.Amount.Total),
+	}
+	if rsp.SuccessTime != "" {
+		if t, err := time.Parse(time.RFC3339, rsp.SuccessTime); err == nil {
+			t = t.Local()
+			trade.PayTime = &t
+		}
+	}
+	return trade, nil
+}
+
+// call 发起一次v3接口请求， 返回2xx以外的状态码的时候返回错误
+func (w *WechatPay) call(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	authorization, err := w.authorization(method, path, payload)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequest(method, wechatPayGateway+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Authorization", authorization)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	httpRsp, err := w.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpRsp.Body.Close()
+	rspBody, err := ioutil.ReadAll(httpRsp.Body)
+	if err != nil {
+		return err
+	}
+	if httpRsp.StatusCode/100 != 2 {
+		e := &wechatError{StatusCode: httpRsp.StatusCode}
+		_ = json.Unmarshal(rspBody, e)
+		return e
+	}
+	if err := w.verify(httpRsp.Header, rspBody); err != nil {
+		return err
+	}
+	if result == nil || len(rspBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(rspBody, result)
+}
+
+// authorization 签名串为 请求方法\nURL\n时间戳\n随机串\n请求报文主体\n， 使用SHA256 with RSA签名
+func (w *WechatPay) authorization(method, path string, body []byte) (string, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	nonceStr := hex.EncodeToString(nonce)
+
+	message := method + "\n" + path + "\n" + timestamp + "\n" + nonceStr + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, w.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`WECHATPAY2-SHA256-RSA2048 mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		w.mchId, nonceStr, base64.StdEncoding.EncodeToString(sig), timestamp, w.serialNo), nil
+}
+
+// verify 应答的签名串为 时间戳\n随机串\n应答主体\n
+func (w *WechatPay) verify(header http.Header, body []byte) error {
+	publicKey := w.platformCert.PublicKey.(*rsa.PublicKey)
+	sig, err := base64.StdEncoding.DecodeString(header.Get("Wechatpay-Signature"))
+	if err != nil {
+		return fmt.Errorf("微信支付返回的签名格式不正确: %w", err)
+	}
+	message := header.Get("Wechatpay-Timestamp") + "\n" + header.Get("Wechatpay-Nonce") + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hashed[:], sig); err != nil {
+		return errors.New("微信支付返回结果验签失败")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// 支付对账的命令行， 在wshop_srvs目录下执行， 和order_srv使用相同的配置
+//
+//	按时间窗口查询支付平台:  go run order_srv/reconcile/main/main.go -from "2021-10-10" -to "2021-10-11"
+//	导入对账单:            go run order_srv/reconcile/main/main.go -pay_type alipay -statement alipay-20211010.csv -from "2021-10-10" -to "2021-10-11"
+//
+// 有需要人工处理的差异的时候退出码是1， 可以在定时任务中报警
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"wshop_srvs/order_srv/global"
+	"wshop_srvs/order_srv/initialize"
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/reconcile"
+)
+
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", s, time.Local)
+}
+
+func main() {
+	// 默认检查最近一天， 最近几分钟的订单可能还在等待支付通知
+	now := time.Now()
+	From := flag.String("from", now.Add(-24*time.Hour).Format("2006-01-02 15:04:05"), "开始时间")
+	To := flag.String("to", now.Add(-10*time.Minute).Format("2006-01-02 15:04:05"), "结束时间")
+	Statement := flag.String("statement", "", "对账单文件， 为空的时候查询支付平台")
+	PayType := flag.String("pay_type", "alipay", "对账单的支付方式")
+	DryRun := flag.Bool("dry_run", false, "只生成报告， 不修复订单")
+	flag.Parse()
+
+	from, err := parseTime(*From)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "开始时间格式错误: %s\n", err.Error())
+		os.Exit(2)
+	}
+	to, err := parseTime(*To)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "结束时间格式错误: %s\n", err.Error())
+		os.Exit(2)
+	}
+
+	initialize.InitLogger()
+	initialize.InitConfig()
+	initialize.InitDB()
+	initialize.InitSrvConn() // 补单的时候需要核销优惠券
+	initialize.InitPayment()
+
+	r := reconcile.New(reconcile.NewDBStore(global.DB), payment.Get)
+	r.Repair = !*DryRun
+
+	var report *reconcile.Report
+	if *Statement == "" {
+		report, err = r.Run(context.Background(), from, to)
+	} else {
+		var f *os.File
+		if f, err = os.Open(*Statement); err == nil {
+			var trades []*payment.Trade
+			if trades, err = reconcile.ParseStatement(f); err == nil {
+				report, err = r.Statement(context.Background(), *PayType, trades, from, to)
+			}
+			f.Close()
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "对账失败: %s\n", err.Error())
+		os.Exit(2)
+	}
+
+	_ = report.Write(os.Stdout)
+	if report.Unresolved() > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,242 @@
+// Package reconcile 支付对账
+// 按时间窗口查询支付平台中的交易状态， 或者导入支付平台的对账单， 和本地订单比较之后生成差异报告
+// 支付平台已经支付而本地还是待支付(比如通知丢失)这种可以安全修复的差异， 按照订单状态机自动补单， 其他差异需要人工处理
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// Order 对账需要的本地订单信息
+type Order struct {
+	OrderSn  string
+	Status   string
+	PayType  string   // 支付成功的订单是实际支付的方式
+	PayTypes []string // 发起过支付的所有支付方式， 待支付的订单每种支付方式都要查询
+	TradeNo  string
+	Amount   money.Money
+}
+
+// Paid 本地订单已经支付， 退款之后的订单也算
+func (o *Order) Paid() bool {
+	switch o.Status {
+	case "TRADE_SUCCESS", "TRADE_SHIPPED", "TRADE_FINISHED", "TRADE_REFUNDED":
+		return true
+	}
+	return false
+}
+
+// queryPayTypes 需要查询的支付方式， 已经支付的订单只查询实际支付的方式
+func (o *Order) queryPayTypes() []string {
+	if o.Paid() {
+		return []string{o.PayType}
+	}
+	payTypes := []string{o.PayType}
+	for _, payType := range o.PayTypes {
+		if payType != o.PayType {
+			payTypes = append(payTypes, payType)
+		}
+	}
+	return payTypes
+}
+
+func tradePaid(t *payment.Trade) bool {
+	return t != nil && (t.Status == "TRADE_SUCCESS" || t.Status == "TRADE_FINISHED")
+}
+
+type Kind string
+
+const (
+	KindPaidNotRecorded Kind = "PAID_NOT_RECORDED" // 支付平台已经支付， 本地订单还是待支付， 可以自动补单
+	KindTradeNoMissing  Kind = "TRADE_NO_MISSING"  // 本地已经支付但是没有保存交易号， 可以自动补上
+	KindPaidOnClosed    Kind = "PAID_ON_CLOSED"    // 已经关闭的订单在支付平台支付成功， 需要退款
+	KindDuplicatePaid   Kind = "DUPLICATE_PAID"    // 订单使用多种支付方式重复支付， 需要退款
+	KindNotPaid         Kind = "NOT_PAID"          // 本地已经支付， 支付平台中没有支付成功的交易
+	KindAmountMismatch  Kind = "AMOUNT_MISMATCH"   // 支付金额和订单金额不一致
+	KindTradeNoMismatch Kind = "TRADE_NO_MISMATCH" // 交易号和本地保存的不一致
+	KindUnknownOrder    Kind = "UNKNOWN_ORDER"     // 对账单中的交易在本地没有订单
+	KindQueryFailed     Kind = "QUERY_FAILED"      // 查询支付平台或者本地订单失败， 下次对账的时候重新检查
+)
+
+// Safe 可以自动修复的差异
+func (k Kind) Safe() bool {
+	return k == KindPaidNotRecorded || k == KindTradeNoMissing
+}
+
+// Discrepancy 本地订单和支付平台交易之间的差异
+type Discrepancy struct {
+	Kind         Kind
+	OrderSn      string
+	PayType      string
+	LocalStatus  string
+	RemoteStatus string
+	LocalAmount  money.Money
+	RemoteAmount money.Money
+	TradeNo      string // 支付平台的交易号
+	Repaired     bool
+	Error        string // 查询失败或者修复失败的原因
+}
+
+// Compare 比较本地订单和支付平台中的交易， 没有差异的时候返回nil， trade为nil表示支付平台中没有这笔交易
+func Compare(o *Order, payType string, trade *payment.Trade) *Discrepancy {
+	d := &Discrepancy{
+		OrderSn:     o.OrderSn,
+		PayType:     payType,
+		LocalStatus: o.Status,
+		LocalAmount: o.Amount,
+	}
+	if trade != nil {
+		d.RemoteStatus = trade.Status
+		d.RemoteAmount = trade.Amount
+		d.TradeNo = trade.TradeNo
+	}
+
+	switch {
+	case tradePaid(trade) && !o.Paid():
+		if o.Status == "TRADE_CLOSED" {
+			d.Kind = KindPaidOnClosed
+		} else if trade.Amount != o.Amount {
+			d.Kind = KindAmountMismatch
+		} else {
+			d.Kind = KindPaidNotRecorded
+		}
+	case tradePaid(trade) && o.Paid():
+		if o.PayType != payType {
+			d.Kind = KindDuplicatePaid
+		} else if trade.Amount != o.Amount {
+			d.Kind = KindAmountMismatch
+		} else if o.TradeNo == "" {
+			d.Kind = KindTradeNoMissing
+		} else if o.TradeNo != trade.TradeNo {
+			d.Kind = KindTradeNoMismatch
+		} else {
+			return nil
+		}
+	case o.Paid() && o.Status != "TRADE_REFUNDED":
+		// 全额退款之后支付宝的交易会关闭， 退款的订单不检查
+		d.Kind = KindNotPaid
+	default:
+		return nil
+	}
+	return d
+}
+
+// Store 本地订单
+type Store interface {
+	// Orders 创建时间在[from, to)之间还没有结束的订单
+	Orders(ctx context.Context, from, to time.Time) ([]*Order, error)
+	// PaidOrders 支付时间在[from, to)之间使用payType支付的订单， 用来和对账单比较
+	PaidOrders(ctx context.Context, payType string, from, to time.Time) ([]*Order, error)
+	// Order 订单不存在的时候返回nil
+	Order(ctx context.Context, orderSn string) (*Order, error)
+	// Repair 按照支付平台中的交易修复订单
+	Repair(ctx context.Context, payType string, trade *payment.Trade) error
+}
+
+// ProviderFunc 按照支付方式获取支付渠道
+type ProviderFunc func(payType string) (payment.Provider, error)
+
+type Reconciler struct {
+	store     Store
+	providers ProviderFunc
+
+	Repair bool // 自动修复可以安全修复的差异， 关闭的时候只生成报告
+}
+
+func New(store Store, providers ProviderFunc) *Reconciler {
+	return &Reconciler{store: store, providers: providers, Repair: true}
+}
+
+// Run 查询时间窗口内的订单在支付平台中的交易状态
+func (r *Reconciler) Run(ctx context.Context, from, to time.Time) (*Report, error) {
+	orders, err := r.store.Orders(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{From: from, To: to}
+	for _, o := range orders {
+		report.Checked++
+		for _, payType := range o.queryPayTypes() {
+			trade, err := r.queryTrade(ctx, payType, o.OrderSn)
+			if err != nil {
+				report.add(&Discrepancy{Kind: KindQueryFailed, OrderSn: o.OrderSn, PayType: payType, LocalStatus: o.Status, LocalAmount: o.Amount, Error: err.Error()})
+				continue
+			}
+			if d := Compare(o, payType, trade); d != nil {
+				r.repair(ctx, d, trade)
+				report.add(d)
+			}
+		}
+	}
+	return report, nil
+}
+
+func (r *Reconciler) queryTrade(ctx context.Context, payType, orderSn string) (*payment.Trade, error) {
+	provider, err := r.providers(payType)
+	if err != nil {
+		return nil, err
+	}
+	trade, err := provider.QueryTrade(ctx, orderSn)
+	if errors.Is(err, payment.ErrTradeNotExist) {
+		return nil, nil
+	}
+	return trade, err
+}
+
+// Statement 和支付平台的对账单比较， 对账单中只有支付成功的交易
+// 支付时间在[from, to)之间的本地订单没有出现在对账单中的时候也是差异
+func (r *Reconciler) Statement(ctx context.Context, payType string, trades []*payment.Trade, from, to time.Time) (*Report, error) {
+	report := &Report{From: from, To: to}
+	seen := make(map[string]bool, len(trades))
+	for _, trade := range trades {
+		report.Checked++
+		seen[trade.OrderSn] = true
+
+		o, err := r.store.Order(ctx, trade.OrderSn)
+		if err != nil {
+			report.add(&Discrepancy{Kind: KindQueryFailed, OrderSn: trade.OrderSn, PayType: payType, Error: err.Error()})
+			continue
+		}
+		if o == nil {
+			report.add(&Discrepancy{Kind: KindUnknownOrder, OrderSn: trade.OrderSn, PayType: payType,
+				RemoteStatus: trade.Status, RemoteAmount: trade.Amount, TradeNo: trade.TradeNo})
+			continue
+		}
+		if d := Compare(o, payType, trade); d != nil {
+			r.repair(ctx, d, trade)
+			report.add(d)
+		}
+	}
+
+	orders, err := r.store.PaidOrders(ctx, payType, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		if seen[o.OrderSn] {
+			continue
+		}
+		report.Checked++
+		if d := Compare(o, payType, nil); d != nil {
+			report.add(d)
+		}
+	}
+	return report, nil
+}
+
+func (r *Reconciler) repair(ctx context.Context, d *Discrepancy, trade *payment.Trade) {
+	if !r.Repair || !d.Kind.Safe() {
+		return
+	}
+	if err := r.store.Repair(ctx, d.PayType, trade); err != nil {
+		d.Error = err.Error()
+		return
+	}
+	d.Repaired = true
+}
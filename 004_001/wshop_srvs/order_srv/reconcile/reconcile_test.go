@@ -0,0 +1,194 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// memoryStore 内存中的订单， 修复的时候直接改成支付成功
+type memoryStore struct {
+	orders   []*Order
+	repaired []string
+}
+
+func (s *memoryStore) Orders(ctx context.Context, from, to time.Time) ([]*Order, error) {
+	var list []*Order
+	for _, o := range s.orders {
+		if !o.Paid() || o.Status == "TRADE_SUCCESS" {
+			list = append(list, o)
+		}
+	}
+	return list, nil
+}
+
+func (s *memoryStore) PaidOrders(ctx context.Context, payType string, from, to time.Time) ([]*Order, error) {
+	var list []*Order
+	for _, o := range s.orders {
+		if o.Paid() && o.PayType == payType {
+			list = append(list, o)
+		}
+	}
+	return list, nil
+}
+
+func (s *memoryStore) Order(ctx context.Context, orderSn string) (*Order, error) {
+	for _, o := range s.orders {
+		if o.OrderSn == orderSn {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryStore) Repair(ctx context.Context, payType string, trade *payment.Trade) error {
+	o, _ := s.Order(ctx, trade.OrderSn)
+	o.Status = "TRADE_SUCCESS"
+	o.PayType = payType
+	o.TradeNo = trade.TradeNo
+	s.repaired = append(s.repaired, trade.OrderSn)
+	return nil
+}
+
+func kinds(report *Report) map[string]Kind {
+	m := make(map[string]Kind)
+	for _, d := range report.Discrepancies {
+		m[d.OrderSn] = d.Kind
+	}
+	return m
+}
+
+func TestRun(t *testing.T) {
+	yuan := money.FromCents(10000)
+	store := &memoryStore{orders: []*Order{
+		{OrderSn: "lost", Status: "WAIT_BUYER_PAY", PayType: "mock", Amount: yuan},                // 通知丢失
+		{OrderSn: "closed", Status: "TRADE_CLOSED", PayType: "mock", Amount: yuan},                // 超时关闭之后支付
+		{OrderSn: "unpaid", Status: "WAIT_BUYER_PAY", PayType: "mock", Amount: yuan},              // 还没有打开支付页面
+		{OrderSn: "ghost", Status: "TRADE_SUCCESS", PayType: "mock", TradeNo: "T4", Amount: yuan}, // 本地支付成功， 支付平台没有交易
+		{OrderSn: "ok", Status: "TRADE_SUCCESS", PayType: "mock", TradeNo: "T5", Amount: yuan},
+		{OrderSn: "cheap", Status: "PAYING", PayType: "mock", Amount: yuan}, // 支付金额不一致
+		{OrderSn: "switched", Status: "WAIT_BUYER_PAY", PayType: "mock", PayTypes: []string{"mock", "other"}, Amount: yuan},
+	}}
+	provider := payment.NewMockProvider()
+	provider.SetTrade(payment.Trade{OrderSn: "lost", TradeNo: "T1", Status: "TRADE_SUCCESS", Amount: yuan})
+	provider.SetTrade(payment.Trade{OrderSn: "closed", TradeNo: "T2", Status: "TRADE_SUCCESS", Amount: yuan})
+	provider.SetTrade(payment.Trade{OrderSn: "ok", TradeNo: "T5", Status: "TRADE_SUCCESS", Amount: yuan})
+	provider.SetTrade(payment.Trade{OrderSn: "cheap", TradeNo: "T6", Status: "TRADE_SUCCESS", Amount: money.FromCents(1)})
+	provider.SetTrade(payment.Trade{OrderSn: "switched", Status: "TRADE_CLOSED", Amount: yuan})
+	providers := func(payType string) (payment.Provider, error) {
+		if payType == "mock" {
+			return provider, nil
+		}
+		return nil, fmt.Errorf("不支持的支付方式: %s", payType)
+	}
+
+	report, err := New(store, providers).Run(context.Background(), time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Kind{
+		"lost":     KindPaidNotRecorded,
+		"closed":   KindPaidOnClosed,
+		"ghost":    KindNotPaid,
+		"cheap":    KindAmountMismatch,
+		"switched": KindQueryFailed,
+	}
+	got := kinds(report)
+	for sn, kind := range want {
+		if got[sn] != kind {
+			t.Errorf("%s: kind = %q, want %q", sn, got[sn], kind)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("discrepancies = %v", got)
+	}
+	if report.Checked != len(store.orders) {
+		t.Errorf("checked = %d", report.Checked)
+	}
+	// 只有通知丢失的订单会自动修复
+	if len(store.repaired) != 1 || store.repaired[0] != "lost" || report.Unresolved() != 4 {
+		t.Errorf("repaired = %v, unresolved = %d", store.repaired, report.Unresolved())
+	}
+
+	// 修复之后再次对账没有这个差异
+	report, _ = New(store, providers).Run(context.Background(), time.Time{}, time.Now())
+	if _, ok := kinds(report)["lost"]; ok {
+		t.Errorf("lost order still reported after repair")
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	store := &memoryStore{orders: []*Order{{OrderSn: "lost", Status: "WAIT_BUYER_PAY", PayType: "mock", Amount: money.FromCents(100)}}}
+	provider := payment.NewMockProvider()
+	provider.SetTrade(payment.Trade{OrderSn: "lost", TradeNo: "T1", Status: "TRADE_SUCCESS", Amount: money.FromCents(100)})
+
+	r := New(store, func(string) (payment.Provider, error) { return provider, nil })
+	r.Repair = false
+	report, _ := r.Run(context.Background(), time.Time{}, time.Now())
+	if len(report.Discrepancies) != 1 || report.Discrepancies[0].Repaired || len(store.repaired) != 0 {
+		t.Errorf("dry run repaired orders: %v", store.repaired)
+	}
+}
+
+func TestStatement(t *testing.T) {
+	store := &memoryStore{orders: []*Order{
+		{OrderSn: "lost", Status: "PAYING", PayType: "alipay", Amount: money.FromCents(9900)},
+		{OrderSn: "legacy", Status: "TRADE_FINISHED", PayType: "alipay", Amount: money.FromCents(500)}, // 之前的版本没有保存交易号
+		{OrderSn: "missing", Status: "TRADE_SHIPPED", PayType: "alipay", TradeNo: "T3", Amount: money.FromCents(100)},
+		{OrderSn: "refunded", Status: "TRADE_REFUNDED", PayType: "alipay", TradeNo: "T4", Amount: money.FromCents(100)},
+	}}
+	trades, err := ParseStatement(strings.NewReader(`# 支付宝账务明细
+out_trade_no,trade_no,total_amount,pay_time
+lost,T1,99.00,2021-10-10 10:12:00
+legacy,T2,5.00,
+stranger,T9,1.00,
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 3 || trades[0].Status != "TRADE_SUCCESS" || trades[0].PayTime == nil {
+		t.Fatalf("trades = %+v", trades[0])
+	}
+
+	r := New(store, func(string) (payment.Provider, error) { return nil, payment.ErrTradeNotExist })
+	report, err := r.Statement(context.Background(), "alipay", trades, time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Kind{
+		"lost":     KindPaidNotRecorded,
+		"legacy":   KindTradeNoMissing,
+		"stranger": KindUnknownOrder,
+		"missing":  KindNotPaid,
+	}
+	got := kinds(report)
+	for sn, kind := range want {
+		if got[sn] != kind {
+			t.Errorf("%s: kind = %q, want %q", sn, got[sn], kind)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("discrepancies = %v", got)
+	}
+	if len(store.repaired) != 2 {
+		t.Errorf("repaired = %v", store.repaired)
+	}
+}
+
+func TestParseStatementErrors(t *testing.T) {
+	cases := []string{
+		"trade_no,total_amount\nT1,1.00\n",               // 缺少订单号列
+		"out_trade_no,trade_no,total_amount\nA,T1,abc\n", // 金额格式错误
+		"out_trade_no,trade_no,total_amount,pay_time\nA,T1,1.00,10/10/2021\n",
+	}
+	for _, c := range cases {
+		if _, err := ParseStatement(strings.NewReader(c)); err == nil {
+			t.Errorf("expected error for %q", c)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package reconcile
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Report 对账报告
+type Report struct {
+	From          time.Time
+	To            time.Time
+	Checked       int // 检查的订单或者对账单中的交易数量
+	Discrepancies []*Discrepancy
+}
+
+func (r *Report) add(d *Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, d)
+}
+
+// Unresolved 没有自动修复的差异数量， 需要人工处理
+func (r *Report) Unresolved() int {
+	n := 0
+	for _, d := range r.Discrepancies {
+		if !d.Repaired {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Report) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "对账时间: %s ~ %s 检查: %d 差异: %d 需要人工处理: %d\n",
+		r.From.Format("2006-01-02 15:04:05"), r.To.Format("2006-01-02 15:04:05"),
+		r.Checked, len(r.Discrepancies), r.Unresolved())
+	if err != nil || len(r.Discrepancies) == 0 {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "类型\t订单号\t支付方式\t本地状态\t支付平台状态\t本地金额\t支付平台金额\t交易号\t处理结果")
+	for _, d := range r.Discrepancies {
+		result := "需要人工处理"
+		if d.Repaired {
+			result = "已修复"
+		} else if d.Error != "" {
+			result = "失败: " + d.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", d.Kind, d.OrderSn, d.PayType,
+			d.LocalStatus, d.RemoteStatus, d.LocalAmount, d.RemoteAmount, d.TradeNo, result)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,83 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/utils/money"
+)
+
+// ParseStatement 解析csv格式的对账单， 第一行是表头， 列的顺序不限， #开头的行是注释
+//
+//	out_trade_no,trade_no,total_amount,trade_status,pay_time
+//	20211010101010123456,2021101022001400000000000001,99.00,TRADE_SUCCESS,2021-10-10 10:12:00
+//
+// 支付宝和微信支付下载的账单需要先转换成这个格式， trade_status和pay_time可以没有， 没有的时候当作支付成功
+func ParseStatement(r io.Reader) ([]*payment.Trade, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("读取对账单表头失败: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"out_trade_no", "trade_no", "total_amount"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("对账单缺少%s列", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var trades []*payment.Trade
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("读取对账单第%d行失败: %w", line, err)
+		}
+
+		amount, err := money.Parse(field(record, "total_amount"))
+		if err != nil {
+			return nil, fmt.Errorf("对账单第%d行金额格式错误: %w", line, err)
+		}
+		trade := &payment.Trade{
+			OrderSn: field(record, "out_trade_no"),
+			TradeNo: field(record, "trade_no"),
+			Status:  field(record, "trade_status"),
+			Amount:  amount,
+		}
+		if trade.OrderSn == "" {
+			return nil, fmt.Errorf("对账单第%d行缺少订单号", line)
+		}
+		if trade.Status == "" {
+			trade.Status = "TRADE_SUCCESS"
+		}
+		if payTime := field(record, "pay_time"); payTime != "" {
+			t, err := time.ParseInLocation("2006-01-02 15:04:05", payTime, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("对账单第%d行支付时间格式错误: %w", line, err)
+			}
+			trade.PayTime = &t
+		}
+		trades = append(trades, trade)
+	}
+	return trades, nil
+}
@@ -0,0 +1,117 @@
+package reconcile
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/handler"
+	"wshop_srvs/order_srv/model"
+	"wshop_srvs/order_srv/payment"
+	"wshop_srvs/order_srv/proto"
+)
+
+// 需要对账的订单状态， 通知丢失的时候订单会被超时关闭， 所以关闭的订单也要检查
+// 历史数据中空的状态当作刚创建的订单
+var orderStatuses = []string{"", "WAIT_BUYER_PAY", "PAYING", "TRADE_SUCCESS", "TRADE_CLOSED"}
+
+var paidStatuses = []string{"TRADE_SUCCESS", "TRADE_SHIPPED", "TRADE_FINISHED", "TRADE_REFUNDED"}
+
+// DBStore 从订单库中读取订单， 修复的时候和支付通知一样按照订单状态机流转
+type DBStore struct {
+	db *gorm.DB
+}
+
+func NewDBStore(db *gorm.DB) *DBStore {
+	return &DBStore{db: db}
+}
+
+func (s *DBStore) Orders(ctx context.Context, from, to time.Time) ([]*Order, error) {
+	var orders []model.OrderInfo
+	result := s.db.Where("add_time >= ? and add_time < ? and status in ?", from, to, orderStatuses).Find(&orders)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return s.toOrders(orders)
+}
+
+func (s *DBStore) PaidOrders(ctx context.Context, payType string, from, to time.Time) ([]*Order, error) {
+	db := s.db.Where("pay_time >= ? and pay_time < ? and status in ?", from, to, paidStatuses)
+	if payType == handler.DefaultPayType {
+		db = db.Where("pay_type = ? or pay_type = ''", payType)
+	} else {
+		db = db.Where("pay_type = ?", payType)
+	}
+	var orders []model.OrderInfo
+	if result := db.Find(&orders); result.Error != nil {
+		return nil, result.Error
+	}
+	return s.toOrders(orders)
+}
+
+func (s *DBStore) Order(ctx context.Context, orderSn string) (*Order, error) {
+	var orders []model.OrderInfo
+	if result := s.db.Where(&model.OrderInfo{OrderSn: orderSn}).Limit(1).Find(&orders); result.Error != nil {
+		return nil, result.Error
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	list, err := s.toOrders(orders)
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// toOrders 查询订单发起过支付的所有支付方式
+func (s *DBStore) toOrders(orders []model.OrderInfo) ([]*Order, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	ids := make([]int32, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+	var payments []model.Payment
+	if result := s.db.Select("order", "pay_type").Where("`order` in ?", ids).Find(&payments); result.Error != nil {
+		return nil, result.Error
+	}
+	payTypes := make(map[int32][]string)
+	for _, p := range payments {
+		payTypes[p.Order] = append(payTypes[p.Order], p.PayType)
+	}
+
+	list := make([]*Order, 0, len(orders))
+	for _, order := range orders {
+		o := &Order{
+			OrderSn:  order.OrderSn,
+			Status:   handler.ParseOrderStatus(order.Status).String(),
+			PayType:  order.PayType,
+			PayTypes: payTypes[order.ID],
+			TradeNo:  order.TradeNo,
+			Amount:   order.OrderMount,
+		}
+		// 历史订单和没有选择支付方式的订单都使用支付宝支付
+		if o.PayType == "" {
+			o.PayType = handler.DefaultPayType
+		}
+		list = append(list, o)
+	}
+	return list, nil
+}
+
+func (s *DBStore) Repair(ctx context.Context, payType string, trade *payment.Trade) error {
+	req := &proto.PayOrderRequest{
+		OrderSn:     trade.OrderSn,
+		PayType:     payType,
+		TradeNo:     trade.TradeNo,
+		TradeStatus: trade.Status,
+		AmountCents: trade.Amount.Cents(),
+	}
+	if trade.PayTime != nil {
+		req.PayTime = trade.PayTime.Format("2006-01-02 15:04:05")
+	}
+	return handler.ApplyPayment(ctx, req, "对账补单")
+}
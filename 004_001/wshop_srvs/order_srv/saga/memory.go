@@ -0,0 +1,85 @@
+package saga
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// MemoryStore 内存中的saga实例存储， 语义和GormStore一致， 测试的时候使用
+type MemoryStore struct {
+	mu   sync.Mutex
+	seq  int32
+	rows map[int32]*model.SagaInstance
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int32]*model.SagaInstance)}
+}
+
+func (s *MemoryStore) Create(inst *model.SagaInstance) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range s.rows {
+		if row.Name == inst.Name && row.BizKey == inst.BizKey {
+			return errors.New("saga实例已经存在")
+		}
+	}
+	s.seq++
+	inst.ID = s.seq
+	row := *inst
+	s.rows[inst.ID] = &row
+	return nil
+}
+
+func (s *MemoryStore) Save(inst *model.SagaInstance) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := *inst
+	s.rows[inst.ID] = &row
+	return nil
+}
+
+func (s *MemoryStore) Claim(inst *model.SagaInstance, until time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row, ok := s.rows[inst.ID]
+	if !ok || row.Status != inst.Status || !row.NextRetryAt.Equal(inst.NextRetryAt) {
+		return false, nil
+	}
+	row.NextRetryAt = until
+	inst.NextRetryAt = until
+	return true, nil
+}
+
+func (s *MemoryStore) Due(now time.Time, limit int) ([]*model.SagaInstance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*model.SagaInstance
+	for _, row := range s.rows {
+		if (row.Status == model.SAGA_RUNNING || row.Status == model.SAGA_COMPENSATING) && !row.NextRetryAt.After(now) {
+			inst := *row
+			due = append(due, &inst)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// Get 按照名称和业务标识查询实例
+func (s *MemoryStore) Get(name string, bizKey string) (model.SagaInstance, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range s.rows {
+		if row.Name == name && row.BizKey == bizKey {
+			return *row, true
+		}
+	}
+	return model.SagaInstance{}, false
+}
@@ -0,0 +1,312 @@
+// Package saga saga编排
+// 一个saga由多个步骤组成， 每个步骤有自己的超时时间和补偿， 执行的状态保存在saga_instance表中
+// 某个步骤失败的时候从失败的步骤开始倒序执行补偿， 失败的步骤可能已经部分生效了(比如调用超时)， 所以也要补偿
+// 补偿需要幂等， 并且要能处理对应的步骤没有执行的情况
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/model"
+)
+
+const (
+	RecoverForward  = iota + 1 // 进程重启之后继续执行剩下的步骤
+	RecoverBackward            // 进程重启之后补偿已经执行的步骤
+)
+
+const (
+	defaultStepTimeout = 10 * time.Second
+	defaultLeaseGrace  = 30 * time.Second
+	defaultMaxAttempts = 16
+	defaultMaxBackoff  = 5 * time.Minute
+	defaultBatchSize   = 100
+	defaultInterval    = 5 * time.Second
+)
+
+var (
+	ErrUnknownSaga = errors.New("saga没有注册")
+	ErrStepTimeout = errors.New("步骤执行超时")
+)
+
+// Step saga的一个步骤， data是Definition.NewData返回的类型
+type Step struct {
+	Name       string
+	Timeout    time.Duration // 为0的时候使用默认的10s
+	Action     func(ctx context.Context, data interface{}) error
+	Compensate func(ctx context.Context, data interface{}) error // 为nil表示不需要补偿， 比如只读的步骤
+}
+
+// Definition saga的定义
+type Definition struct {
+	Name     string
+	Steps    []Step
+	NewData  func() interface{} // 恢复的时候用来反序列化保存的数据， 需要返回指针
+	Recovery int                // RecoverForward(默认)或者RecoverBackward
+}
+
+// Store saga实例的存储
+type Store interface {
+	Create(inst *model.SagaInstance) error
+	Save(inst *model.SagaInstance) error
+	// Claim 只有next_retry_at没有变化的时候才能抢占， 抢占成功之后until之前其他的实例不会处理
+	Claim(inst *model.SagaInstance, until time.Time) (bool, error)
+	// Due 查询租约已经过期或者到了重试时间的实例
+	Due(now time.Time, limit int) ([]*model.SagaInstance, error)
+}
+
+type Orchestrator struct {
+	store       Store
+	definitions map[string]*Definition
+
+	LeaseGrace  time.Duration // 步骤超时之后再等待这么久， 租约才会过期
+	MaxAttempts int32
+	MaxBackoff  time.Duration
+	BatchSize   int
+	Interval    time.Duration
+
+	now func() time.Time
+}
+
+func NewOrchestrator(store Store) *Orchestrator {
+	return &Orchestrator{
+		store:       store,
+		definitions: make(map[string]*Definition),
+		LeaseGrace:  defaultLeaseGrace,
+		MaxAttempts: defaultMaxAttempts,
+		MaxBackoff:  defaultMaxBackoff,
+		BatchSize:   defaultBatchSize,
+		Interval:    defaultInterval,
+		now:         time.Now,
+	}
+}
+
+// Register 注册saga的定义， 需要在Execute和Recover之前调用
+func (o *Orchestrator) Register(def *Definition) {
+	o.definitions[def.Name] = def
+}
+
+// Execute 执行一个saga， 步骤失败的时候补偿之后返回步骤的错误
+// 补偿失败的时候同样返回步骤的错误， 补偿由Recover继续重试
+func (o *Orchestrator) Execute(ctx context.Context, name string, bizKey string, data interface{}) error {
+	def, ok := o.definitions[name]
+	if !ok {
+		return ErrUnknownSaga
+	}
+
+	inst := &model.SagaInstance{Name: name, BizKey: bizKey, Status: model.SAGA_RUNNING}
+	if err := o.marshal(inst, data); err != nil {
+		return err
+	}
+	inst.NextRetryAt = o.lease(def, 0)
+	if err := o.store.Create(inst); err != nil {
+		return err
+	}
+	return o.run(ctx, def, inst, data)
+}
+
+// Recover 继续处理中断的saga， 返回处理完成的数量
+func (o *Orchestrator) Recover(ctx context.Context) (int, error) {
+	due, err := o.store.Due(o.now(), o.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for _, inst := range due {
+		def, ok := o.definitions[inst.Name]
+		if !ok {
+			zap.S().Errorf("saga %s没有注册， 不能恢复实例%d", inst.Name, inst.ID)
+			continue
+		}
+		// 多个进程同时恢复的时候只有一个能抢占成功
+		ok, err := o.store.Claim(inst, o.lease(def, inst.Step))
+		if err != nil {
+			return done, err
+		}
+		if !ok {
+			continue
+		}
+
+		data := def.NewData()
+		if err := json.Unmarshal([]byte(inst.Data), data); err != nil {
+			zap.S().Errorf("解析saga实例%d的数据失败: %s", inst.ID, err.Error())
+			continue
+		}
+		if inst.Status == model.SAGA_RUNNING && def.Recovery == RecoverBackward && int(inst.Step) < len(def.Steps) {
+			inst.Status = model.SAGA_COMPENSATING
+			inst.LastError = "进程重启之后补偿"
+		}
+
+		_ = o.run(ctx, def, inst, data)
+		if inst.Status == model.SAGA_SUCCEEDED || inst.Status == model.SAGA_COMPENSATED || inst.Status == model.SAGA_FAILED {
+			done++
+		}
+	}
+	return done, nil
+}
+
+// Run 定时恢复中断的saga直到ctx被取消
+func (o *Orchestrator) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := o.Recover(ctx); err != nil {
+			zap.S().Errorf("恢复saga失败: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *Orchestrator) run(ctx context.Context, def *Definition, inst *model.SagaInstance, data interface{}) error {
+	var actionErr error
+	for inst.Status == model.SAGA_RUNNING {
+		if int(inst.Step) >= len(def.Steps) {
+			inst.Status = model.SAGA_SUCCEEDED
+			inst.LastError = ""
+			return o.save(inst, data)
+		}
+
+		step := def.Steps[inst.Step]
+		err := o.runStep(ctx, step, "", step.Action, data)
+		if err != nil {
+			actionErr = err
+			inst.Status = model.SAGA_COMPENSATING
+			inst.LastError = truncate(fmt.Sprintf("%s: %s", step.Name, err.Error()))
+			zap.S().Infof("saga %s(%s)的步骤%s失败， 开始补偿: %s", inst.Name, inst.BizKey, step.Name, err.Error())
+		} else {
+			inst.Step++
+		}
+		inst.NextRetryAt = o.lease(def, inst.Step)
+		if err := o.save(inst, data); err != nil {
+			return o.result(actionErr, err)
+		}
+	}
+
+	if inst.Status == model.SAGA_COMPENSATING {
+		if err := o.compensate(ctx, def, inst, data); err != nil {
+			zap.S().Errorf("saga %s(%s)补偿失败， 稍后重试: %s", inst.Name, inst.BizKey, err.Error())
+			return o.result(actionErr, err)
+		}
+	}
+	return actionErr
+}
+
+// compensate 从inst.Step开始倒序补偿
+func (o *Orchestrator) compensate(ctx context.Context, def *Definition, inst *model.SagaInstance, data interface{}) error {
+	if int(inst.Step) >= len(def.Steps) {
+		inst.Step = int32(len(def.Steps)) - 1
+	}
+	for inst.Step >= 0 {
+		step := def.Steps[inst.Step]
+		if step.Compensate != nil {
+			if err := o.runStep(ctx, step, "compensate_", step.Compensate, data); err != nil {
+				inst.Attempts++
+				inst.LastError = truncate(fmt.Sprintf("%s补偿: %s", step.Name, err.Error()))
+				if inst.Attempts >= o.MaxAttempts {
+					inst.Status = model.SAGA_FAILED
+					zap.S().Errorf("saga %s(%s)补偿失败超过%d次， 需要人工处理: %s", inst.Name, inst.BizKey, o.MaxAttempts, inst.LastError)
+				}
+				inst.NextRetryAt = o.now().Add(o.backoff(inst.Attempts))
+				if saveErr := o.save(inst, data); saveErr != nil {
+					zap.S().Errorf("保存saga实例%d的状态失败: %s", inst.ID, saveErr.Error())
+				}
+				return err
+			}
+		}
+		inst.Step--
+		if inst.Step >= 0 {
+			inst.NextRetryAt = o.lease(def, inst.Step)
+		}
+		if err := o.save(inst, data); err != nil {
+			return err
+		}
+	}
+
+	inst.Status = model.SAGA_COMPENSATED
+	return o.save(inst, data)
+}
+
+// runStep 带超时执行一个步骤或者补偿， 步骤需要响应ctx的取消
+func (o *Orchestrator) runStep(ctx context.Context, step Step, prefix string, fn func(context.Context, interface{}) error, data interface{}) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, prefix+step.Name)
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, stepTimeout(step))
+	defer cancel()
+	err := fn(ctx, data)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%w: %s", ErrStepTimeout, err.Error())
+	}
+	return err
+}
+
+func (o *Orchestrator) save(inst *model.SagaInstance, data interface{}) error {
+	if err := o.marshal(inst, data); err != nil {
+		return err
+	}
+	return o.store.Save(inst)
+}
+
+func (o *Orchestrator) marshal(inst *model.SagaInstance, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	inst.Data = string(b)
+	return nil
+}
+
+// lease 执行第step个步骤时的租约， 超过这个时间没有更新状态说明进程已经退出了
+func (o *Orchestrator) lease(def *Definition, step int32) time.Time {
+	timeout := defaultStepTimeout
+	if int(step) >= 0 && int(step) < len(def.Steps) {
+		timeout = stepTimeout(def.Steps[step])
+	}
+	return o.now().Add(timeout + o.LeaseGrace)
+}
+
+func (o *Orchestrator) backoff(attempts int32) time.Duration {
+	d := time.Second
+	for i := int32(1); i < attempts && d < o.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > o.MaxBackoff {
+		d = o.MaxBackoff
+	}
+	return d
+}
+
+// result 步骤失败的时候优先返回步骤的错误
+func (o *Orchestrator) result(actionErr error, err error) error {
+	if actionErr != nil {
+		return actionErr
+	}
+	return err
+}
+
+func stepTimeout(step Step) time.Duration {
+	if step.Timeout > 0 {
+		return step.Timeout
+	}
+	return defaultStepTimeout
+}
+
+func truncate(s string) string {
+	if len(s) > 200 {
+		return s[:200]
+	}
+	return s
+}
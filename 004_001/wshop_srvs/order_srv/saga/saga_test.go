@@ -0,0 +1,310 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// recorder 记录步骤和补偿的执行顺序
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (r *recorder) add(call string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+func (r *recorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Join(r.calls, ",")
+}
+
+type testData struct {
+	Value int
+}
+
+// testSaga 生成n个步骤的saga， failAt指定失败的步骤， failCompensate指定补偿前几次失败
+type testSaga struct {
+	rec            *recorder
+	failAt         int
+	failCompensate map[int]int
+	block          int // 这个步骤会一直阻塞直到超时
+}
+
+func (s *testSaga) definition(name string, n int, recovery int) *Definition {
+	def := &Definition{
+		Name:     name,
+		NewData:  func() interface{} { return &testData{} },
+		Recovery: recovery,
+	}
+	for i := 0; i < n; i++ {
+		i := i
+		def.Steps = append(def.Steps, Step{
+			Name:    fmt.Sprintf("step%d", i),
+			Timeout: 50 * time.Millisecond,
+			Action: func(ctx context.Context, data interface{}) error {
+				s.rec.add(fmt.Sprintf("do%d", i))
+				data.(*testData).Value++
+				if i == s.block {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+				if i == s.failAt {
+					return errors.New("步骤失败")
+				}
+				return nil
+			},
+			Compensate: func(ctx context.Context, data interface{}) error {
+				s.rec.add(fmt.Sprintf("undo%d", i))
+				if s.failCompensate[i] > 0 {
+					s.failCompensate[i]--
+					return errors.New("补偿失败")
+				}
+				data.(*testData).Value--
+				return nil
+			},
+		})
+	}
+	return def
+}
+
+type testEnv struct {
+	store *MemoryStore
+	now   time.Time
+	o     *Orchestrator
+}
+
+func newTestEnv(defs ...*Definition) *testEnv {
+	env := &testEnv{store: NewMemoryStore(), now: time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)}
+	env.o = NewOrchestrator(env.store)
+	env.o.now = func() time.Time { return env.now }
+	for _, def := range defs {
+		env.o.Register(def)
+	}
+	return env
+}
+
+func (e *testEnv) instance(t *testing.T, name, bizKey string) model.SagaInstance {
+	t.Helper()
+	inst, ok := e.store.Get(name, bizKey)
+	if !ok {
+		t.Fatalf("saga实例%s(%s)不存在", name, bizKey)
+	}
+	return inst
+}
+
+func TestExecuteSucceeded(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: -1, block: -1}
+	env := newTestEnv(s.definition("test", 3, RecoverForward))
+
+	data := &testData{}
+	if err := env.o.Execute(context.Background(), "test", "1", data); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.rec.String(); got != "do0,do1,do2" {
+		t.Fatalf("执行顺序不正确: %s", got)
+	}
+	inst := env.instance(t, "test", "1")
+	if inst.Status != model.SAGA_SUCCEEDED || inst.Data != `{"Value":3}` {
+		t.Fatalf("saga实例的状态不正确: %+v", inst)
+	}
+}
+
+func TestCompensateFromFailedStep(t *testing.T) {
+	// 每个步骤都失败一次， 补偿从失败的步骤开始倒序执行
+	expected := []string{
+		"do0,undo0",
+		"do0,do1,undo1,undo0",
+		"do0,do1,do2,undo2,undo1,undo0",
+	}
+	for failAt, want := range expected {
+		s := &testSaga{rec: &recorder{}, failAt: failAt, block: -1}
+		env := newTestEnv(s.definition("test", 3, RecoverForward))
+
+		data := &testData{}
+		if err := env.o.Execute(context.Background(), "test", "1", data); err == nil {
+			t.Fatalf("步骤%d失败应该返回错误", failAt)
+		}
+		if got := s.rec.String(); got != want {
+			t.Fatalf("步骤%d失败的执行顺序不正确， 期望: %s， 实际: %s", failAt, want, got)
+		}
+		if data.Value != 0 {
+			t.Fatalf("补偿之后数据应该恢复， 实际: %d", data.Value)
+		}
+		if inst := env.instance(t, "test", "1"); inst.Status != model.SAGA_COMPENSATED || inst.Step != -1 {
+			t.Fatalf("saga实例的状态不正确: %+v", inst)
+		}
+	}
+}
+
+func TestStepTimeout(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: -1, block: 1}
+	env := newTestEnv(s.definition("test", 3, RecoverForward))
+
+	err := env.o.Execute(context.Background(), "test", "1", &testData{})
+	if !errors.Is(err, ErrStepTimeout) {
+		t.Fatalf("应该返回ErrStepTimeout， 实际: %v", err)
+	}
+	if got := s.rec.String(); got != "do0,do1,undo1,undo0" {
+		t.Fatalf("执行顺序不正确: %s", got)
+	}
+}
+
+func TestCompensateRetry(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: 2, block: -1, failCompensate: map[int]int{1: 2}}
+	env := newTestEnv(s.definition("test", 3, RecoverForward))
+
+	if err := env.o.Execute(context.Background(), "test", "1", &testData{}); err == nil || err.Error() != "步骤失败" {
+		t.Fatalf("应该返回步骤的错误， 实际: %v", err)
+	}
+	inst := env.instance(t, "test", "1")
+	if inst.Status != model.SAGA_COMPENSATING || inst.Step != 1 || inst.Attempts != 1 || !inst.NextRetryAt.Equal(env.now.Add(time.Second)) {
+		t.Fatalf("补偿失败之后的状态不正确: %+v", inst)
+	}
+
+	// 没有到重试时间
+	if done, _ := env.o.Recover(context.Background()); done != 0 {
+		t.Fatal("没有到重试时间不应该恢复")
+	}
+	env.now = env.now.Add(time.Second)
+	if done, _ := env.o.Recover(context.Background()); done != 0 {
+		t.Fatal("第二次补偿也失败了")
+	}
+	env.now = env.now.Add(2 * time.Second)
+	if done, _ := env.o.Recover(context.Background()); done != 1 {
+		t.Fatal("第三次补偿应该成功")
+	}
+
+	if got := s.rec.String(); got != "do0,do1,do2,undo2,undo1,undo1,undo1,undo0" {
+		t.Fatalf("执行顺序不正确: %s", got)
+	}
+	inst = env.instance(t, "test", "1")
+	if inst.Status != model.SAGA_COMPENSATED || inst.Data != `{"Value":0}` {
+		t.Fatalf("saga实例的状态不正确: %+v", inst)
+	}
+}
+
+func TestCompensateGiveUp(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: 0, block: -1, failCompensate: map[int]int{0: 100}}
+	env := newTestEnv(s.definition("test", 1, RecoverForward))
+	env.o.MaxAttempts = 3
+
+	_ = env.o.Execute(context.Background(), "test", "1", &testData{})
+	for i := 0; i < 5; i++ {
+		env.now = env.now.Add(env.o.MaxBackoff)
+		_, _ = env.o.Recover(context.Background())
+	}
+	if inst := env.instance(t, "test", "1"); inst.Status != model.SAGA_FAILED || inst.Attempts != 3 {
+		t.Fatalf("超过重试次数之后应该标记为失败: %+v", inst)
+	}
+}
+
+// crash 模拟进程在执行完前n个步骤之后退出
+func crash(t *testing.T, env *testEnv, name string, bizKey string, def *Definition, n int) {
+	t.Helper()
+	data := &testData{}
+	inst := &model.SagaInstance{Name: name, BizKey: bizKey, Status: model.SAGA_RUNNING}
+	_ = env.o.marshal(inst, data)
+	inst.NextRetryAt = env.o.lease(def, 0)
+	if err := env.store.Create(inst); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		if err := def.Steps[i].Action(context.Background(), data); err != nil {
+			t.Fatal(err)
+		}
+		inst.Step++
+		inst.NextRetryAt = env.o.lease(def, inst.Step)
+		if err := env.o.save(inst, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestResumeForwardAfterRestart(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: -1, block: -1}
+	def := s.definition("test", 3, RecoverForward)
+	env := newTestEnv(def)
+	crash(t, env, "test", "1", def, 2)
+
+	// 租约没有过期的时候认为进程还在执行
+	if done, _ := env.o.Recover(context.Background()); done != 0 {
+		t.Fatal("租约没有过期不应该恢复")
+	}
+
+	env.now = env.now.Add(50*time.Millisecond + env.o.LeaseGrace)
+	restarted := NewOrchestrator(env.store)
+	restarted.now = env.o.now
+	restarted.Register(def)
+	if done, _ := restarted.Recover(context.Background()); done != 1 {
+		t.Fatal("应该恢复1个saga")
+	}
+	if got := s.rec.String(); got != "do0,do1,do2" {
+		t.Fatalf("执行顺序不正确: %s", got)
+	}
+	if inst := env.instance(t, "test", "1"); inst.Status != model.SAGA_SUCCEEDED || inst.Data != `{"Value":3}` {
+		t.Fatalf("saga实例的状态不正确: %+v", inst)
+	}
+}
+
+func TestResumeBackwardAfterRestart(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: -1, block: -1}
+	def := s.definition("test", 3, RecoverBackward)
+	env := newTestEnv(def)
+	crash(t, env, "test", "1", def, 2)
+
+	env.now = env.now.Add(50*time.Millisecond + env.o.LeaseGrace)
+	if done, _ := env.o.Recover(context.Background()); done != 1 {
+		t.Fatal("应该恢复1个saga")
+	}
+	// 第3个步骤可能在退出的时候正在执行， 也要补偿
+	if got := s.rec.String(); got != "do0,do1,undo2,undo1,undo0" {
+		t.Fatalf("执行顺序不正确: %s", got)
+	}
+	if inst := env.instance(t, "test", "1"); inst.Status != model.SAGA_COMPENSATED {
+		t.Fatalf("saga实例的状态不正确: %+v", inst)
+	}
+}
+
+func TestConcurrentRecoverOnce(t *testing.T) {
+	s := &testSaga{rec: &recorder{}, failAt: -1, block: -1}
+	def := s.definition("test", 2, RecoverForward)
+	env := newTestEnv(def)
+	crash(t, env, "test", "1", def, 1)
+	env.now = env.now.Add(time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o := NewOrchestrator(env.store)
+			o.now = env.o.now
+			o.Register(def)
+			_, _ = o.Recover(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	if got := s.rec.String(); got != "do0,do1" {
+		t.Fatalf("每个步骤只能执行一次， 实际: %s", got)
+	}
+}
+
+func TestExecuteUnknownSaga(t *testing.T) {
+	env := newTestEnv()
+	if err := env.o.Execute(context.Background(), "unknown", "1", &testData{}); err != ErrUnknownSaga {
+		t.Fatalf("应该返回ErrUnknownSaga， 实际: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package saga
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormStore 使用saga_instance表保存saga实例
+type GormStore struct {
+	DB *gorm.DB
+}
+
+func (s GormStore) Create(inst *model.SagaInstance) error {
+	return s.DB.Create(inst).Error
+}
+
+func (s GormStore) Save(inst *model.SagaInstance) error {
+	return s.DB.Model(&model.SagaInstance{}).Where("id = ?", inst.ID).Updates(map[string]interface{}{
+		"status":        inst.Status,
+		"step":          inst.Step,
+		"data":          inst.Data,
+		"attempts":      inst.Attempts,
+		"next_retry_at": inst.NextRetryAt,
+		"last_error":    inst.LastError,
+	}).Error
+}
+
+func (s GormStore) Claim(inst *model.SagaInstance, until time.Time) (bool, error) {
+	result := s.DB.Model(&model.SagaInstance{}).
+		Where("id = ? and status = ? and next_retry_at = ?", inst.ID, inst.Status, inst.NextRetryAt).
+		Update("next_retry_at", until)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	inst.NextRetryAt = until
+	return true, nil
+}
+
+func (s GormStore) Due(now time.Time, limit int) ([]*model.SagaInstance, error) {
+	var due []*model.SagaInstance
+	result := s.DB.Where("status in ? and next_retry_at <= ?", []int32{model.SAGA_RUNNING, model.SAGA_COMPENSATING}, now).
+		Order("id").Limit(limit).Find(&due)
+	return due, result.Error
+}
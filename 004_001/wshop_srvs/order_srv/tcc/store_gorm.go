@@ -0,0 +1,38 @@
+package tcc
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormStore 使用tcctransaction表保存全局事务
+type GormStore struct {
+	DB *gorm.DB
+}
+
+func (s GormStore) Create(t *model.TccTransaction) error {
+	return s.DB.Create(t).Error
+}
+
+func (s GormStore) Transit(t *model.TccTransaction, from int32) (bool, error) {
+	result := s.DB.Model(&model.TccTransaction{}).Where("id = ? and status = ?", t.ID, from).Updates(map[string]interface{}{
+		"status":        t.Status,
+		"attempts":      t.Attempts,
+		"next_retry_at": t.NextRetryAt,
+		"last_error":    t.LastError,
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s GormStore) Due(now time.Time, limit int) ([]*model.TccTransaction, error) {
+	var due []*model.TccTransaction
+	result := s.DB.Where("status in ? and next_retry_at <= ?", []int32{model.TCC_TRYING, model.TCC_CONFIRMING, model.TCC_CANCELING}, now).
+		Order("id").Limit(limit).Find(&due)
+	return due, result.Error
+}
@@ -0,0 +1,234 @@
+// Package tcc TCC分布式事务的协调者
+// 全局事务的状态保存在数据库中: try阶段任意一个参与者失败或者本地事务失败的时候取消所有参与者，
+// 本地事务提交之后确认所有参与者， confirm和cancel失败的时候由Recover按照指数退避重试直到成功
+// 参与者的三个接口都需要幂等， cancel需要允许空回滚， 并且空回滚之后要拒绝try(防悬挂)
+package tcc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"wshop_srvs/order_srv/model"
+)
+
+const (
+	defaultTryTimeout = time.Minute
+	defaultMaxBackoff = 5 * time.Minute
+	defaultBatchSize  = 100
+	defaultInterval   = 5 * time.Second
+)
+
+// ErrStatusChanged 全局事务的状态已经被其他协调者修改了
+var ErrStatusChanged = errors.New("全局事务的状态已经被修改")
+
+// Participant TCC事务的参与者
+type Participant interface {
+	Name() string
+	Try(ctx context.Context, xid string, payload []byte) error
+	Confirm(ctx context.Context, xid string, payload []byte) error
+	Cancel(ctx context.Context, xid string, payload []byte) error
+}
+
+// Store 全局事务的存储
+type Store interface {
+	Create(t *model.TccTransaction) error
+	// Transit 只有状态还是from的时候才会保存t的状态、重试次数和下一次恢复的时间
+	Transit(t *model.TccTransaction, from int32) (bool, error)
+	// Due 查询需要恢复的全局事务
+	Due(now time.Time, limit int) ([]*model.TccTransaction, error)
+}
+
+// Resolver 查询本地事务是否已经提交， 用来恢复try阶段中断的全局事务
+type Resolver func(xid string) (bool, error)
+
+type Coordinator struct {
+	store        Store
+	resolver     Resolver
+	participants []Participant
+
+	TryTimeout time.Duration // try阶段超过这个时间没有结束的时候由Recover处理
+	MaxBackoff time.Duration
+	BatchSize  int
+	Interval   time.Duration
+
+	now func() time.Time
+}
+
+func NewCoordinator(store Store, resolver Resolver, participants ...Participant) *Coordinator {
+	return &Coordinator{
+		store:        store,
+		resolver:     resolver,
+		participants: participants,
+		TryTimeout:   defaultTryTimeout,
+		MaxBackoff:   defaultMaxBackoff,
+		BatchSize:    defaultBatchSize,
+		Interval:     defaultInterval,
+		now:          time.Now,
+	}
+}
+
+// Try 开启全局事务并调用所有参与者的try， 任意一个失败的时候取消全局事务并返回try的错误
+func (c *Coordinator) Try(ctx context.Context, xid string, payload []byte) (*model.TccTransaction, error) {
+	t := &model.TccTransaction{
+		Xid:         xid,
+		Status:      model.TCC_TRYING,
+		Payload:     string(payload),
+		NextRetryAt: c.now().Add(c.TryTimeout),
+	}
+	if err := c.store.Create(t); err != nil {
+		return nil, err
+	}
+
+	for _, p := range c.participants {
+		if err := p.Try(ctx, xid, payload); err != nil {
+			zap.S().Infof("全局事务%s的参与者%s try失败: %s", xid, p.Name(), err.Error())
+			if cancelErr := c.Cancel(ctx, t); cancelErr != nil {
+				zap.S().Errorf("取消全局事务%s失败: %s", xid, cancelErr.Error())
+			}
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Confirm 本地事务提交之后调用， 参与者确认失败的时候返回错误， 之后由Recover继续确认
+func (c *Coordinator) Confirm(ctx context.Context, t *model.TccTransaction) error {
+	return c.finish(ctx, t, model.TCC_CONFIRMING)
+}
+
+// Cancel 本地事务失败之后调用， 参与者取消失败的时候返回错误， 之后由Recover继续取消
+func (c *Coordinator) Cancel(ctx context.Context, t *model.TccTransaction) error {
+	return c.finish(ctx, t, model.TCC_CANCELING)
+}
+
+// Recover 恢复中断的全局事务， 返回处理完成的数量
+func (c *Coordinator) Recover(ctx context.Context) (int, error) {
+	due, err := c.store.Due(c.now(), c.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for _, t := range due {
+		var err error
+		switch t.Status {
+		case model.TCC_TRYING:
+			// try阶段中断了， 本地事务提交了就确认， 否则取消
+			committed, resolveErr := c.resolver(t.Xid)
+			if resolveErr != nil {
+				zap.S().Errorf("查询全局事务%s的本地事务失败: %s", t.Xid, resolveErr.Error())
+				continue
+			}
+			if committed {
+				err = c.Confirm(ctx, t)
+			} else {
+				err = c.Cancel(ctx, t)
+			}
+		case model.TCC_CONFIRMING, model.TCC_CANCELING:
+			err = c.finish(ctx, t, t.Status)
+		default:
+			continue
+		}
+
+		if err != nil {
+			zap.S().Infof("恢复全局事务%s失败: %s", t.Xid, err.Error())
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+// Run 定时恢复中断的全局事务直到ctx被取消
+func (c *Coordinator) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.Recover(ctx); err != nil {
+			zap.S().Errorf("恢复全局事务失败: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// finish 把全局事务推进到phase(confirming或者canceling)， 然后调用所有参与者的confirm或者cancel
+func (c *Coordinator) finish(ctx context.Context, t *model.TccTransaction, phase int32) error {
+	if t.Status != phase {
+		if t.Status != model.TCC_TRYING {
+			return ErrStatusChanged
+		}
+		// 先保存状态再调用参与者， 协调者在调用过程中退出的时候Recover会继续
+		t.Status = phase
+		t.NextRetryAt = c.now()
+		ok, err := c.store.Transit(t, model.TCC_TRYING)
+		if err != nil || !ok {
+			t.Status = model.TCC_TRYING
+		}
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrStatusChanged
+		}
+	}
+
+	payload := []byte(t.Payload)
+	var failed error
+	for _, p := range c.participants {
+		var err error
+		if phase == model.TCC_CONFIRMING {
+			err = p.Confirm(ctx, t.Xid, payload)
+		} else {
+			err = p.Cancel(ctx, t.Xid, payload)
+		}
+		if err != nil {
+			failed = err
+			zap.S().Infof("全局事务%s的参与者%s执行失败: %s", t.Xid, p.Name(), err.Error())
+			break
+		}
+	}
+
+	if failed != nil {
+		t.Attempts++
+		t.NextRetryAt = c.now().Add(c.backoff(t.Attempts))
+		t.LastError = failed.Error()
+		if len(t.LastError) > 200 {
+			t.LastError = t.LastError[:200]
+		}
+		if _, err := c.store.Transit(t, phase); err != nil {
+			zap.S().Errorf("保存全局事务%s的重试信息失败: %s", t.Xid, err.Error())
+		}
+		return failed
+	}
+
+	if phase == model.TCC_CONFIRMING {
+		t.Status = model.TCC_CONFIRMED
+	} else {
+		t.Status = model.TCC_CANCELED
+	}
+	t.LastError = ""
+	if _, err := c.store.Transit(t, phase); err != nil {
+		t.Status = phase
+		return err
+	}
+	return nil
+}
+
+func (c *Coordinator) backoff(attempts int32) time.Duration {
+	d := time.Second
+	for i := int32(1); i < attempts && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > c.MaxBackoff {
+		d = c.MaxBackoff
+	}
+	return d
+}
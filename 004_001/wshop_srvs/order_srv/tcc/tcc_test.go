@@ -0,0 +1,335 @@
+package tcc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// memoryStore 内存中的全局事务表， 语义和GormStore一致
+type memoryStore struct {
+	mu   sync.Mutex
+	seq  int32
+	rows map[int32]*model.TccTransaction
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{rows: make(map[int32]*model.TccTransaction)}
+}
+
+func (s *memoryStore) Create(t *model.TccTransaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range s.rows {
+		if row.Xid == t.Xid {
+			return errors.New("全局事务已经存在")
+		}
+	}
+	s.seq++
+	t.ID = s.seq
+	row := *t
+	s.rows[t.ID] = &row
+	return nil
+}
+
+func (s *memoryStore) Transit(t *model.TccTransaction, from int32) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := s.rows[t.ID]
+	if row.Status != from {
+		return false, nil
+	}
+	row.Status = t.Status
+	row.Attempts = t.Attempts
+	row.NextRetryAt = t.NextRetryAt
+	row.LastError = t.LastError
+	return true, nil
+}
+
+func (s *memoryStore) Due(now time.Time, limit int) ([]*model.TccTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*model.TccTransaction
+	for _, row := range s.rows {
+		if row.Status <= model.TCC_CANCELING && !row.NextRetryAt.After(now) {
+			t := *row
+			due = append(due, &t)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (s *memoryStore) get(xid string) model.TccTransaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range s.rows {
+		if row.Xid == xid {
+			return *row
+		}
+	}
+	return model.TccTransaction{}
+}
+
+// fakeParticipant 记录每个阶段的调用， 可以指定某个阶段失败
+type fakeParticipant struct {
+	name string
+
+	mu          sync.Mutex
+	calls       []string
+	failTry     bool
+	failConfirm int // 前几次confirm失败
+	failCancel  int
+}
+
+func (p *fakeParticipant) Name() string {
+	return p.name
+}
+
+func (p *fakeParticipant) record(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, phase)
+}
+
+func (p *fakeParticipant) Try(ctx context.Context, xid string, payload []byte) error {
+	p.record("try")
+	if p.failTry {
+		return errors.New("库存不足")
+	}
+	return nil
+}
+
+func (p *fakeParticipant) Confirm(ctx context.Context, xid string, payload []byte) error {
+	p.record("confirm")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failConfirm > 0 {
+		p.failConfirm--
+		return errors.New("服务不可用")
+	}
+	return nil
+}
+
+func (p *fakeParticipant) Cancel(ctx context.Context, xid string, payload []byte) error {
+	p.record("cancel")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failCancel > 0 {
+		p.failCancel--
+		return errors.New("服务不可用")
+	}
+	return nil
+}
+
+func (p *fakeParticipant) history() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.calls...)
+}
+
+func assertCalls(t *testing.T, p *fakeParticipant, expected ...string) {
+	t.Helper()
+	got := p.history()
+	if len(got) != len(expected) {
+		t.Fatalf("参与者%s的调用不正确， 期望: %v， 实际: %v", p.name, expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("参与者%s的调用不正确， 期望: %v， 实际: %v", p.name, expected, got)
+		}
+	}
+}
+
+type testEnv struct {
+	store       *memoryStore
+	now         time.Time
+	committed   map[string]bool
+	coordinator *Coordinator
+}
+
+func newTestEnv(participants ...Participant) *testEnv {
+	env := &testEnv{
+		store:     newMemoryStore(),
+		now:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local),
+		committed: make(map[string]bool),
+	}
+	env.coordinator = NewCoordinator(env.store, func(xid string) (bool, error) {
+		return env.committed[xid], nil
+	}, participants...)
+	env.coordinator.now = func() time.Time { return env.now }
+	return env
+}
+
+func (e *testEnv) recover(t *testing.T) int {
+	t.Helper()
+	done, err := e.coordinator.Recover(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return done
+}
+
+func TestTryConfirm(t *testing.T) {
+	inv := &fakeParticipant{name: "inventory"}
+	env := newTestEnv(inv)
+
+	tx, err := env.coordinator.Try(context.Background(), "1", []byte("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := env.coordinator.Confirm(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+
+	assertCalls(t, inv, "try", "confirm")
+	if row := env.store.get("1"); row.Status != model.TCC_CONFIRMED {
+		t.Fatalf("全局事务应该已经确认， 实际状态: %d", row.Status)
+	}
+}
+
+func TestTryFailCancelsAll(t *testing.T) {
+	// 第二个参与者try失败， 两个参与者都要cancel， 没有try成功的参与者是空回滚
+	inv := &fakeParticipant{name: "inventory"}
+	points := &fakeParticipant{name: "points", failTry: true}
+	env := newTestEnv(inv, points)
+
+	if _, err := env.coordinator.Try(context.Background(), "2", nil); err == nil {
+		t.Fatal("参与者try失败应该返回错误")
+	}
+
+	assertCalls(t, inv, "try", "cancel")
+	assertCalls(t, points, "try", "cancel")
+	if row := env.store.get("2"); row.Status != model.TCC_CANCELED {
+		t.Fatalf("全局事务应该已经取消， 实际状态: %d", row.Status)
+	}
+}
+
+func TestLocalFailCancels(t *testing.T) {
+	inv := &fakeParticipant{name: "inventory"}
+	env := newTestEnv(inv)
+
+	tx, err := env.coordinator.Try(context.Background(), "3", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 保存订单失败
+	if err := env.coordinator.Cancel(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+
+	assertCalls(t, inv, "try", "cancel")
+	if row := env.store.get("3"); row.Status != model.TCC_CANCELED {
+		t.Fatalf("全局事务应该已经取消， 实际状态: %d", row.Status)
+	}
+}
+
+func TestConfirmRetryWithBackoff(t *testing.T) {
+	inv := &fakeParticipant{name: "inventory", failConfirm: 2}
+	env := newTestEnv(inv)
+
+	tx, _ := env.coordinator.Try(context.Background(), "4", nil)
+	if err := env.coordinator.Confirm(context.Background(), tx); err == nil {
+		t.Fatal("参与者confirm失败应该返回错误")
+	}
+	row := env.store.get("4")
+	if row.Status != model.TCC_CONFIRMING || row.Attempts != 1 || !row.NextRetryAt.Equal(env.now.Add(time.Second)) {
+		t.Fatalf("第一次confirm失败之后的状态不正确: %+v", row)
+	}
+
+	// 没有到重试时间
+	if done := env.recover(t); done != 0 {
+		t.Fatal("没有到重试时间不应该恢复")
+	}
+
+	env.now = env.now.Add(time.Second)
+	if done := env.recover(t); done != 0 {
+		t.Fatal("第二次confirm也失败了")
+	}
+	if row := env.store.get("4"); row.Attempts != 2 || !row.NextRetryAt.Equal(env.now.Add(2*time.Second)) {
+		t.Fatalf("第二次confirm失败之后的状态不正确: %+v", row)
+	}
+
+	env.now = env.now.Add(2 * time.Second)
+	if done := env.recover(t); done != 1 {
+		t.Fatal("第三次confirm应该成功")
+	}
+	assertCalls(t, inv, "try", "confirm", "confirm", "confirm")
+	if row := env.store.get("4"); row.Status != model.TCC_CONFIRMED {
+		t.Fatalf("全局事务应该已经确认， 实际状态: %d", row.Status)
+	}
+}
+
+func TestCancelRetry(t *testing.T) {
+	inv := &fakeParticipant{name: "inventory", failCancel: 1}
+	env := newTestEnv(inv)
+
+	tx, _ := env.coordinator.Try(context.Background(), "5", nil)
+	if err := env.coordinator.Cancel(context.Background(), tx); err == nil {
+		t.Fatal("参与者cancel失败应该返回错误")
+	}
+	env.now = env.now.Add(time.Second)
+	if done := env.recover(t); done != 1 {
+		t.Fatal("重试cancel应该成功")
+	}
+	assertCalls(t, inv, "try", "cancel", "cancel")
+	if row := env.store.get("5"); row.Status != model.TCC_CANCELED {
+		t.Fatalf("全局事务应该已经取消， 实际状态: %d", row.Status)
+	}
+}
+
+func TestRecoverInterruptedTry(t *testing.T) {
+	// 协调者在try之后退出， 超时之后根据本地事务是否提交决定确认还是取消
+	inv := &fakeParticipant{name: "inventory"}
+	env := newTestEnv(inv)
+
+	if _, err := env.coordinator.Try(context.Background(), "committed", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.coordinator.Try(context.Background(), "lost", nil); err != nil {
+		t.Fatal(err)
+	}
+	env.committed["committed"] = true
+
+	if done := env.recover(t); done != 0 {
+		t.Fatal("try没有超时之前不应该恢复")
+	}
+
+	env.now = env.now.Add(env.coordinator.TryTimeout)
+	if done := env.recover(t); done != 2 {
+		t.Fatalf("应该恢复2个全局事务， 实际: %d", done)
+	}
+	if row := env.store.get("committed"); row.Status != model.TCC_CONFIRMED {
+		t.Fatalf("本地事务已经提交应该确认， 实际状态: %d", row.Status)
+	}
+	if row := env.store.get("lost"); row.Status != model.TCC_CANCELED {
+		t.Fatalf("本地事务没有提交应该取消， 实际状态: %d", row.Status)
+	}
+	assertCalls(t, inv, "try", "try", "confirm", "cancel")
+}
+
+func TestConfirmAfterRecoverCanceled(t *testing.T) {
+	// try超时被恢复任务取消之后， 迟到的confirm不能再确认
+	inv := &fakeParticipant{name: "inventory"}
+	env := newTestEnv(inv)
+
+	tx, _ := env.coordinator.Try(context.Background(), "6", nil)
+	env.now = env.now.Add(env.coordinator.TryTimeout)
+	env.recover(t)
+
+	if err := env.coordinator.Confirm(context.Background(), tx); err != ErrStatusChanged {
+		t.Fatalf("应该返回ErrStatusChanged， 实际: %v", err)
+	}
+	assertCalls(t, inv, "try", "cancel")
+	if row := env.store.get("6"); row.Status != model.TCC_CANCELED {
+		t.Fatalf("全局事务应该已经取消， 实际状态: %d", row.Status)
+	}
+}
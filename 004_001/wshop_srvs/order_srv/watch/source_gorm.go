@@ -0,0 +1,44 @@
+package watch
+
+import (
+	"gorm.io/gorm"
+
+	"wshop_srvs/order_srv/model"
+)
+
+// GormSource 从orderstatushistory表读取状态流水
+type GormSource struct {
+	DB *gorm.DB
+}
+
+func (s GormSource) LastId() (int32, error) {
+	var histories []model.OrderStatusHistory
+	if result := s.DB.Select("id").Order("id desc").Limit(1).Find(&histories); result.Error != nil {
+		return 0, result.Error
+	}
+	if len(histories) == 0 {
+		return 0, nil
+	}
+	return histories[0].ID, nil
+}
+
+func (s GormSource) Events(afterId int32, limit int) ([]*Event, error) {
+	var histories []model.OrderStatusHistory
+	if result := s.DB.Where("id > ?", afterId).Order("id").Limit(limit).Find(&histories); result.Error != nil {
+		return nil, result.Error
+	}
+	events := make([]*Event, 0, len(histories))
+	for _, history := range histories {
+		events = append(events, &Event{
+			Id:         history.ID,
+			OrderId:    history.Order,
+			OrderSn:    history.OrderSn,
+			UserId:     history.User,
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			Remark:     history.Remark,
+			Time:       history.CreatedAt,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,218 @@
+// Package watch 订阅订单状态的变化
+// 状态变化都会写入订单状态流水表， 每个order_srv实例定时读取新的流水推送给本实例的订阅者， 其他实例修改的订单也能收到
+// 本实例修改订单之后调用Notify马上读取， 不用等到下一次定时读取
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInterval   = time.Second
+	defaultBatchSize  = 500
+	defaultGapTimeout = 10 * time.Second
+	subscriberBuffer  = 64
+)
+
+// Event 一次订单状态变化， Id是状态流水的id
+type Event struct {
+	Id         int32
+	OrderId    int32
+	OrderSn    string
+	UserId     int32
+	FromStatus string
+	ToStatus   string
+	Remark     string
+	Time       time.Time
+}
+
+// Source 订单状态流水
+type Source interface {
+	// LastId 最新一条流水的id， 启动之后只推送新的变化
+	LastId() (int32, error)
+	// Events 按照id顺序读取id大于afterId的流水
+	Events(afterId int32, limit int) ([]*Event, error)
+}
+
+// Subscription 一个订阅者， 推送不过来的时候C会被关闭， 订阅者需要重新订阅
+type Subscription struct {
+	C <-chan *Event
+
+	c      chan *Event
+	filter func(*Event) bool
+	hub    *Hub
+}
+
+// Close 取消订阅， 可以重复调用
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
+
+// Hub 读取状态流水并推送给订阅者
+// 流水的id是自增的， 但是事务提交的顺序和id的顺序不一定一致， 比如id为10的事务比id为11的晚提交
+// 所以只有前面的id都推送过了才会前移读取的位置， 中间的空洞(还没有提交或者已经回滚的事务)超过GapTimeout之后跳过
+type Hub struct {
+	source Source
+
+	Interval   time.Duration // 定时读取的间隔
+	BatchSize  int
+	GapTimeout time.Duration
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	notify      chan struct{}
+
+	// 下面的字段只在Run中使用
+	started   bool
+	watermark int32               // 小于等于watermark的流水都已经推送过了
+	delivered map[int32]time.Time // 大于watermark已经推送过的流水和推送的时间
+	now       func() time.Time
+}
+
+func NewHub(source Source) *Hub {
+	return &Hub{
+		source:      source,
+		Interval:    defaultInterval,
+		BatchSize:   defaultBatchSize,
+		GapTimeout:  defaultGapTimeout,
+		subscribers: make(map[*Subscription]struct{}),
+		notify:      make(chan struct{}, 1),
+		delivered:   make(map[int32]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Subscribe 订阅filter返回true的变化
+func (h *Hub) Subscribe(filter func(*Event) bool) *Subscription {
+	c := make(chan *Event, subscriberBuffer)
+	s := &Subscription{C: c, c: c, filter: filter, hub: h}
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+	return s
+}
+
+func (h *Hub) remove(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscribers[s]; ok {
+		delete(h.subscribers, s)
+		close(s.c)
+	}
+}
+
+// Notify 本实例修改了订单状态之后唤醒Run马上读取， 对账等没有启动Hub的进程中为nil
+func (h *Hub) Notify() {
+	if h == nil {
+		return
+	}
+	select {
+	case h.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run 持续读取直到ctx被取消， 退出的时候关闭所有的订阅
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.Interval)
+	defer ticker.Stop()
+	for {
+		if err := h.Poll(); err != nil {
+			zap.S().Errorf("读取订单状态流水失败: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			h.mu.Lock()
+			for s := range h.subscribers {
+				delete(h.subscribers, s)
+				close(s.c)
+			}
+			h.mu.Unlock()
+			return
+		case <-ticker.C:
+		case <-h.notify:
+		}
+	}
+}
+
+// Poll 读取一次新的流水并推送
+func (h *Hub) Poll() error {
+	if !h.started {
+		id, err := h.source.LastId()
+		if err != nil {
+			return err
+		}
+		h.watermark = id
+		h.started = true
+	}
+
+	// 每次都从watermark开始读取， 空洞中晚提交的流水才能被读到
+	// 空洞后面已经推送过的流水可能超过一批， 下一批从这一批最后的id之后继续读取， 不能停在已经推送过的流水上
+	after := h.watermark
+	for {
+		events, err := h.source.Events(after, h.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if _, ok := h.delivered[e.Id]; ok {
+				continue
+			}
+			h.publish(e)
+			h.delivered[e.Id] = h.now()
+		}
+		h.advance()
+		if len(events) < h.BatchSize {
+			return nil
+		}
+		after = events[len(events)-1].Id
+		if h.watermark > after {
+			after = h.watermark
+		}
+	}
+}
+
+// advance 前移watermark， 空洞超过GapTimeout之后跳过
+func (h *Hub) advance() {
+	for len(h.delivered) > 0 {
+		if _, ok := h.delivered[h.watermark+1]; ok {
+			delete(h.delivered, h.watermark+1)
+			h.watermark++
+			continue
+		}
+
+		var min int32
+		for id := range h.delivered {
+			if min == 0 || id < min {
+				min = id
+			}
+		}
+		if h.now().Sub(h.delivered[min]) < h.GapTimeout {
+			return
+		}
+		h.watermark = min - 1
+	}
+}
+
+// publish 推送给所有订阅者， 缓冲区满了的订阅者直接断开， 不能因为一个慢的订阅者阻塞其他订阅者
+func (h *Hub) publish(e *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscribers {
+		if s.filter != nil && !s.filter(e) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			zap.S().Warnf("订单状态订阅者处理太慢， 断开订阅")
+			delete(h.subscribers, s)
+			close(s.c)
+		}
+	}
+}
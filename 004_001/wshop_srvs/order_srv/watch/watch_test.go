@@ -0,0 +1,213 @@
+package watch
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memorySource 内存中的状态流水， commit模拟事务提交之后流水才能被读到
+type memorySource struct {
+	mu     sync.Mutex
+	events map[int32]*Event
+}
+
+func newMemorySource() *memorySource {
+	return &memorySource{events: make(map[int32]*Event)}
+}
+
+func (s *memorySource) commit(id, userId int32, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events[id] = &Event{Id: id, OrderId: id * 10, UserId: userId, ToStatus: status}
+}
+
+func (s *memorySource) LastId() (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var last int32
+	for id := range s.events {
+		if id > last {
+			last = id
+		}
+	}
+	return last, nil
+}
+
+func (s *memorySource) Events(afterId int32, limit int) ([]*Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*Event
+	for id, e := range s.events {
+		if id > afterId {
+			events = append(events, e)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Id < events[j].Id })
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func receive(t *testing.T, sub *Subscription) []int32 {
+	t.Helper()
+	var ids []int32
+	for {
+		select {
+		case e, ok := <-sub.C:
+			if !ok {
+				return ids
+			}
+			ids = append(ids, e.Id)
+		default:
+			return ids
+		}
+	}
+}
+
+func equal(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHubPublishesNewEvents(t *testing.T) {
+	source := newMemorySource()
+	source.commit(1, 7, "TRADE_CLOSED") // 启动之前的流水不推送
+	hub := NewHub(source)
+	mine := hub.Subscribe(func(e *Event) bool { return e.UserId == 7 })
+	all := hub.Subscribe(nil)
+	if err := hub.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	source.commit(2, 7, "TRADE_SUCCESS")
+	source.commit(3, 8, "TRADE_SUCCESS")
+	if err := hub.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, mine); !equal(got, []int32{2}) {
+		t.Errorf("filtered subscriber got %v", got)
+	}
+	if got := receive(t, all); !equal(got, []int32{2, 3}) {
+		t.Errorf("subscriber got %v", got)
+	}
+
+	// 取消订阅之后不再推送
+	mine.Close()
+	mine.Close()
+	source.commit(4, 7, "TRADE_SHIPPED")
+	_ = hub.Poll()
+	if got := receive(t, all); !equal(got, []int32{4}) {
+		t.Errorf("subscriber got %v", got)
+	}
+}
+
+func TestHubWaitsForGap(t *testing.T) {
+	source := newMemorySource()
+	hub := NewHub(source)
+	now := time.Now()
+	hub.now = func() time.Time { return now }
+	sub := hub.Subscribe(nil)
+	_ = hub.Poll()
+
+	// id为1的事务比id为2的晚提交
+	source.commit(2, 7, "TRADE_SUCCESS")
+	_ = hub.Poll()
+	source.commit(1, 7, "TRADE_CLOSED")
+	_ = hub.Poll()
+	if got := receive(t, sub); !equal(got, []int32{2, 1}) {
+		t.Errorf("got %v", got)
+	}
+	if hub.watermark != 2 || len(hub.delivered) != 0 {
+		t.Errorf("watermark = %d, delivered = %v", hub.watermark, hub.delivered)
+	}
+
+	// id为3的事务回滚了， 超过GapTimeout之后跳过
+	source.commit(4, 7, "TRADE_SUCCESS")
+	_ = hub.Poll()
+	_ = hub.Poll()
+	if got := receive(t, sub); !equal(got, []int32{4}) {
+		t.Errorf("got %v", got)
+	}
+	if hub.watermark != 2 {
+		t.Errorf("watermark moved past gap: %d", hub.watermark)
+	}
+	now = now.Add(hub.GapTimeout)
+	_ = hub.Poll()
+	if hub.watermark != 4 || len(hub.delivered) != 0 {
+		t.Errorf("watermark = %d, delivered = %v", hub.watermark, hub.delivered)
+	}
+}
+
+func TestHubReadsPastDeliveredBatch(t *testing.T) {
+	source := newMemorySource()
+	hub := NewHub(source)
+	hub.BatchSize = 3
+	now := time.Now()
+	hub.now = func() time.Time { return now }
+	sub := hub.Subscribe(nil)
+	_ = hub.Poll()
+
+	// id为1的事务还没有提交， 后面已经推送过的流水超过一批
+	for id := int32(2); id <= 5; id++ {
+		source.commit(id, 7, "TRADE_SUCCESS")
+	}
+	_ = hub.Poll()
+	if got := receive(t, sub); !equal(got, []int32{2, 3, 4, 5}) {
+		t.Errorf("got %v", got)
+	}
+
+	// 新的流水在已经推送过的一整批后面， 也要推送出去
+	source.commit(6, 7, "TRADE_SUCCESS")
+	source.commit(7, 7, "TRADE_SUCCESS")
+	_ = hub.Poll()
+	if got := receive(t, sub); !equal(got, []int32{6, 7}) {
+		t.Errorf("got %v", got)
+	}
+	_ = hub.Poll()
+	if got := receive(t, sub); len(got) != 0 {
+		t.Errorf("重复推送 %v", got)
+	}
+	if hub.watermark != 0 {
+		t.Errorf("watermark moved past gap: %d", hub.watermark)
+	}
+
+	// 空洞中的流水晚提交之后也能读到， watermark前移到最后
+	source.commit(1, 7, "TRADE_CLOSED")
+	_ = hub.Poll()
+	if got := receive(t, sub); !equal(got, []int32{1}) {
+		t.Errorf("got %v", got)
+	}
+	if hub.watermark != 7 || len(hub.delivered) != 0 {
+		t.Errorf("watermark = %d, delivered = %v", hub.watermark, hub.delivered)
+	}
+}
+
+func TestHubDropsSlowSubscriber(t *testing.T) {
+	source := newMemorySource()
+	hub := NewHub(source)
+	sub := hub.Subscribe(nil)
+	_ = hub.Poll()
+	for id := int32(1); id <= subscriberBuffer+1; id++ {
+		source.commit(id, 7, "TRADE_SUCCESS")
+	}
+	_ = hub.Poll()
+
+	n := 0
+	for range sub.C {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Errorf("received %d events before disconnect", n)
+	}
+	sub.Close()
+}
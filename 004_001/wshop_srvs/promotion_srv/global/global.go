@@ -0,0 +1,37 @@
+package global
+
+import (
+	"gorm.io/gorm"
+	"wshop_srvs/promotion_srv/config"
+)
+
+var (
+	DB           *gorm.DB
+	ServerConfig config.ServerConfig
+	NacosConfig  config.NacosConfig
+)
+
+// func init() {
+//	dsn := "root:123456@tcp(192.168.0.249:3306)/wshop_promotion_srv?charset=utf8mb4&parseTime=True&loc=Local"
+//
+//	newLogger := logger.New(
+//		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
+//		logger.Config{
+//			SlowThreshold: time.Second,   // 慢 SQL 阈值
+//			LogLevel:      logger.Info, // Log level
+//			Colorful:      true,         // 禁用彩色打印
+//		},
+//	)
+//
+//	// 全局模式
+//	var err error
+//	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
+//		NamingStrategy: schema.NamingStrategy{
+//			SingularTable: true,
+//		},
+//		Logger: newLogger,
+//	})
+//	if err != nil {
+//		panic(err)
+//	}
+// }
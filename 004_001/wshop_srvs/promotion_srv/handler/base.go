@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"gorm.io/gorm"
+	"wshop_srvs/promotion_srv/proto"
+)
+
+type PromotionServer struct {
+	proto.UnimplementedPromotionServer
+}
+
+func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if page == 0 {
+			page = 1
+		}
+
+		switch {
+		case pageSize > 100:
+			pageSize = 100
+		case pageSize <= 0:
+			pageSize = 10
+		}
+
+		offset := (page - 1) * pageSize
+		return db.Offset(offset).Limit(pageSize)
+	}
+}
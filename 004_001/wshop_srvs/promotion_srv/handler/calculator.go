@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"sort"
+
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/utils/money"
+)
+
+// 每种类型的优惠券最多参与计算最优组合的数量， 避免用户优惠券很多的时候组合数量太大
+const maxCandidatesPerType = 10
+
+// 叠加使用时的计算顺序， 先满减再立减最后打折
+var couponApplyOrder = []int32{model.COUPON_THRESHOLD, model.COUPON_FIXED, model.COUPON_PERCENT}
+
+type discountItem struct {
+	GoodsId    int32
+	CategoryId int32
+	BrandId    int32
+	Amount     money.Money // 商品行的金额， 单价*数量
+}
+
+type couponCandidate struct {
+	Coupon   model.UserCoupon
+	Template model.CouponTemplate
+}
+
+type couponDiscount struct {
+	Candidate couponCandidate
+	Discount  money.Money
+}
+
+type discountResult struct {
+	Total    money.Money
+	Discount money.Money
+	Coupons  []couponDiscount
+}
+
+// inScope 商品是否在优惠券的使用范围内
+func inScope(t *model.CouponTemplate, item *discountItem) bool {
+	var id int32
+	switch t.ScopeType {
+	case model.SCOPE_ALL:
+		return true
+	case model.SCOPE_CATEGORY:
+		id = item.CategoryId
+	case model.SCOPE_BRAND:
+		id = item.BrandId
+	default:
+		return false
+	}
+	for _, scopeId := range t.ScopeIds {
+		if scopeId == id {
+			return true
+		}
+	}
+	return false
+}
+
+// couponAmount 计算一张优惠券的优惠金额
+// origin是范围内商品的原价， 用来判断门槛; remain是前面的优惠券减免之后剩余的金额， 优惠金额不会超过remain
+func couponAmount(t *model.CouponTemplate, origin, remain money.Money) money.Money {
+	if remain <= 0 || origin < t.Threshold {
+		return 0
+	}
+
+	var discount money.Money
+	switch t.CouponType {
+	case model.COUPON_FIXED, model.COUPON_THRESHOLD:
+		discount = t.Discount
+	case model.COUPON_PERCENT:
+		discount = money.FromCents(remain.Cents() * int64(100-t.Percent) / 100)
+		if t.MaxDiscount > 0 && discount > t.MaxDiscount {
+			discount = t.MaxDiscount
+		}
+	}
+	if discount > remain {
+		discount = remain
+	}
+	return discount
+}
+
+// calculate 按照满减、立减、折扣的顺序计算一组优惠券的优惠
+// 每张优惠券的优惠金额按照商品行剩余的金额分摊， 后面的优惠券在分摊之后的金额上继续计算
+func calculate(items []discountItem, candidates []couponCandidate) discountResult {
+	remains := make([]money.Money, len(items))
+	var result discountResult
+	for i, item := range items {
+		remains[i] = item.Amount
+		result.Total = result.Total.Add(item.Amount)
+	}
+
+	sorted := make([]couponCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return applyRank(sorted[i].Template.CouponType) < applyRank(sorted[j].Template.CouponType)
+	})
+
+	for _, c := range sorted {
+		var scoped []int
+		var origin, remain money.Money
+		for i := range items {
+			if inScope(&c.Template, &items[i]) {
+				scoped = append(scoped, i)
+				origin = origin.Add(items[i].Amount)
+				remain = remain.Add(remains[i])
+			}
+		}
+
+		discount := couponAmount(&c.Template, origin, remain)
+		if discount > 0 {
+			for n, share := range apportion(discount, remains, scoped) {
+				remains[scoped[n]] = remains[scoped[n]].Sub(share)
+			}
+		}
+		result.Discount = result.Discount.Add(discount)
+		result.Coupons = append(result.Coupons, couponDiscount{Candidate: c, Discount: discount})
+	}
+	return result
+}
+
+// apportion 按照剩余金额的比例把优惠分摊到scoped中的商品行， 返回每一行分摊的金额
+// 每一行先向下取整， 除不尽的部分依次由还有剩余金额的商品行承担， 分摊的金额不会超过商品行剩余的金额
+func apportion(discount money.Money, remains []money.Money, scoped []int) []money.Money {
+	shares := make([]money.Money, len(scoped))
+	var remain money.Money
+	for _, i := range scoped {
+		remain = remain.Add(remains[i])
+	}
+	if remain <= 0 {
+		return shares
+	}
+
+	left := discount
+	for n, i := range scoped {
+		shares[n] = money.FromCents(discount.Cents() * remains[i].Cents() / remain.Cents())
+		left = left.Sub(shares[n])
+	}
+	for n, i := range scoped {
+		if left <= 0 {
+			break
+		}
+		extra := remains[i].Sub(shares[n])
+		if extra > left {
+			extra = left
+		}
+		shares[n] = shares[n].Add(extra)
+		left = left.Sub(extra)
+	}
+	return shares
+}
+
+// bestCombination 每种类型最多选一张， 枚举所有的组合找出优惠最多的一组
+func bestCombination(items []discountItem, candidates []couponCandidate) discountResult {
+	groups := make([][]couponCandidate, len(couponApplyOrder))
+	for _, c := range candidates {
+		rank := applyRank(c.Template.CouponType)
+		if rank < 0 {
+			continue
+		}
+		// 单独使用没有优惠的券不参与组合
+		if calculate(items, []couponCandidate{c}).Discount <= 0 {
+			continue
+		}
+		groups[rank] = append(groups[rank], c)
+	}
+	for rank, group := range groups {
+		if len(group) > maxCandidatesPerType {
+			sort.SliceStable(group, func(i, j int) bool {
+				return calculate(items, []couponCandidate{group[i]}).Discount > calculate(items, []couponCandidate{group[j]}).Discount
+			})
+			groups[rank] = group[:maxCandidatesPerType]
+		}
+	}
+
+	best := calculate(items, nil)
+	var walk func(rank int, chosen []couponCandidate)
+	walk = func(rank int, chosen []couponCandidate) {
+		if rank == len(groups) {
+			result := calculate(items, chosen)
+			// 优惠相同的时候使用更少的券
+			if result.Discount > best.Discount || (result.Discount == best.Discount && len(result.Coupons) < len(best.Coupons)) {
+				best = result
+			}
+			return
+		}
+		walk(rank+1, chosen)
+		for _, c := range groups[rank] {
+			walk(rank+1, append(chosen, c))
+		}
+	}
+	walk(0, make([]couponCandidate, 0, len(groups)))
+	return best
+}
+
+func applyRank(couponType int32) int {
+	for i, t := range couponApplyOrder {
+		if t == couponType {
+			return i
+		}
+	}
+	return -1
+}
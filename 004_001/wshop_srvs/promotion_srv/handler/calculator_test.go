@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/utils/money"
+)
+
+func yuan(n int64) money.Money {
+	return money.FromCents(n * 100)
+}
+
+func candidate(id int32, t model.CouponTemplate) couponCandidate {
+	if t.ScopeType == 0 {
+		t.ScopeType = model.SCOPE_ALL
+	}
+	return couponCandidate{Coupon: model.UserCoupon{BaseModel: model.BaseModel{ID: id}}, Template: t}
+}
+
+func TestApportion(t *testing.T) {
+	tests := []struct {
+		name     string
+		discount money.Money
+		remains  []money.Money
+		scoped   []int
+		want     []money.Money
+	}{
+		{"proportional", 600, []money.Money{1000, 2000}, []int{0, 1}, []money.Money{200, 400}},
+		{"remainder to first", 100, []money.Money{100, 100, 100}, []int{0, 1, 2}, []money.Money{34, 33, 33}},
+		// 最后一行只剩1分， 除不尽的部分不能都由最后一行承担
+		{"last line small", 10, []money.Money{5, 5, 1}, []int{0, 1, 2}, []money.Money{5, 5, 0}},
+		{"all", 11, []money.Money{5, 5, 1}, []int{0, 1, 2}, []money.Money{5, 5, 1}},
+		{"scoped", 300, []money.Money{1000, 500, 500}, []int{1, 2}, []money.Money{150, 150}},
+		{"nothing left", 100, []money.Money{0, 0}, []int{0, 1}, []money.Money{0, 0}},
+	}
+	for _, tt := range tests {
+		got := apportion(tt.discount, tt.remains, tt.scoped)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: shares = %v, want %v", tt.name, got, tt.want)
+		}
+		for n, i := range tt.scoped {
+			if got[n] > tt.remains[i] {
+				t.Errorf("%s: 第%d行分摊了%s， 超过剩余的%s", tt.name, i, got[n], tt.remains[i])
+			}
+		}
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	items := []discountItem{
+		{GoodsId: 1, CategoryId: 10, BrandId: 100, Amount: yuan(100)},
+		{GoodsId: 2, CategoryId: 20, BrandId: 200, Amount: yuan(50)},
+	}
+	threshold := candidate(1, model.CouponTemplate{CouponType: model.COUPON_THRESHOLD, Threshold: yuan(120), Discount: yuan(20)})
+	fixed := candidate(2, model.CouponTemplate{CouponType: model.COUPON_FIXED, Discount: yuan(10)})
+	percent := candidate(3, model.CouponTemplate{CouponType: model.COUPON_PERCENT, Percent: 80})
+	capped := candidate(4, model.CouponTemplate{CouponType: model.COUPON_PERCENT, Percent: 50, MaxDiscount: yuan(15)})
+	category := candidate(5, model.CouponTemplate{CouponType: model.COUPON_THRESHOLD, Threshold: yuan(60), Discount: yuan(5), ScopeType: model.SCOPE_CATEGORY, ScopeIds: model.GormIntList{20}})
+	brand := candidate(6, model.CouponTemplate{CouponType: model.COUPON_FIXED, Discount: yuan(80), ScopeType: model.SCOPE_BRAND, ScopeIds: model.GormIntList{200}})
+
+	tests := []struct {
+		name       string
+		candidates []couponCandidate
+		discount   money.Money
+		each       []money.Money // 每张优惠券的优惠， 按照满减、立减、折扣的顺序
+	}{
+		{"no coupon", nil, 0, nil},
+		{"threshold", []couponCandidate{threshold}, yuan(20), []money.Money{yuan(20)}},
+		// 分类内的商品只有50元， 没有达到门槛
+		{"category below threshold", []couponCandidate{category}, 0, []money.Money{0}},
+		// 立减的金额不能超过范围内商品的金额
+		{"brand capped by amount", []couponCandidate{brand}, yuan(50), []money.Money{yuan(50)}},
+		{"max discount", []couponCandidate{capped}, yuan(15), []money.Money{yuan(15)}},
+		// 先满减再立减最后打折: 150-20-10=120， 再打八折优惠24
+		{"stacking", []couponCandidate{percent, fixed, threshold}, yuan(54), []money.Money{yuan(20), yuan(10), yuan(24)}},
+		// 满减的门槛按照原价判断， 立减之后不满120也可以使用
+		{"threshold by origin", []couponCandidate{fixed, threshold}, yuan(30), []money.Money{yuan(20), yuan(10)}},
+		// 品牌券把商品2减到0之后， 折扣只作用在商品1上
+		{"stacking in scope", []couponCandidate{brand, percent}, yuan(70), []money.Money{yuan(50), yuan(20)}},
+	}
+	for _, tt := range tests {
+		result := calculate(items, tt.candidates)
+		if result.Total != yuan(150) || result.Discount != tt.discount {
+			t.Errorf("%s: total=%s discount=%s, want 150.00 %s", tt.name, result.Total, result.Discount, tt.discount)
+			continue
+		}
+		var each []money.Money
+		for _, c := range result.Coupons {
+			each = append(each, c.Discount)
+		}
+		if !reflect.DeepEqual(each, tt.each) {
+			t.Errorf("%s: 每张券的优惠 = %v, want %v", tt.name, each, tt.each)
+		}
+	}
+}
+
+func TestBestCombination(t *testing.T) {
+	items := []discountItem{{GoodsId: 1, Amount: yuan(100)}}
+	small := candidate(1, model.CouponTemplate{CouponType: model.COUPON_FIXED, Discount: yuan(5)})
+	big := candidate(2, model.CouponTemplate{CouponType: model.COUPON_FIXED, Discount: yuan(8)})
+	unreachable := candidate(3, model.CouponTemplate{CouponType: model.COUPON_THRESHOLD, Threshold: yuan(200), Discount: yuan(50)})
+	percent := candidate(4, model.CouponTemplate{CouponType: model.COUPON_PERCENT, Percent: 90})
+
+	result := bestCombination(items, []couponCandidate{small, big, unreachable, percent})
+	var ids []int32
+	for _, c := range result.Coupons {
+		ids = append(ids, c.Candidate.Coupon.ID)
+	}
+	// 每种类型只能选一张: 立减8元之后再打九折优惠9.20
+	if result.Discount != money.FromCents(1720) || !reflect.DeepEqual(ids, []int32{2, 4}) {
+		t.Errorf("最优组合 discount=%s coupons=%v, want 17.20 [2 4]", result.Discount, ids)
+	}
+
+	if result := bestCombination(items, []couponCandidate{unreachable}); result.Discount != 0 || len(result.Coupons) != 0 {
+		t.Errorf("没有可用的券 discount=%s coupons=%d", result.Discount, len(result.Coupons))
+	}
+}
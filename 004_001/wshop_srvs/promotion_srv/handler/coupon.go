@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/promotion_srv/global"
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/proto"
+)
+
+func (*PromotionServer) ClaimCoupon(ctx context.Context, req *proto.ClaimCouponRequest) (*proto.UserCouponResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少用户信息")
+	}
+
+	tx := global.DB.Begin()
+	// 锁住模板， 同一个模板的领取是串行的， 发放总量和每人限领的数量都不会超出
+	var template model.CouponTemplate
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, req.TemplateId); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "优惠券不存在")
+	}
+
+	now := time.Now()
+	if !template.OnSale || (template.StartTime != nil && now.Before(*template.StartTime)) || (template.EndTime != nil && !now.Before(*template.EndTime)) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "不在优惠券的领取时间内")
+	}
+	if template.Total > 0 && template.Claimed >= template.Total {
+		tx.Rollback()
+		return nil, status.Errorf(codes.ResourceExhausted, "优惠券已经领完了")
+	}
+
+	var claimed int64
+	if result := tx.Model(&model.UserCoupon{}).Where(&model.UserCoupon{User: req.UserId, Template: template.ID}).Count(&claimed); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "领取优惠券失败")
+	}
+	if claimed >= int64(template.PerUserLimit) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.ResourceExhausted, "已经达到领取上限")
+	}
+
+	coupon := model.UserCoupon{
+		User:      req.UserId,
+		Template:  template.ID,
+		Status:    model.USER_COUPON_UNUSED,
+		ValidFrom: &now,
+	}
+	if template.ValidDays > 0 {
+		validTo := now.AddDate(0, 0, int(template.ValidDays))
+		coupon.ValidTo = &validTo
+	} else {
+		if template.StartTime != nil {
+			coupon.ValidFrom = template.StartTime
+		}
+		coupon.ValidTo = template.EndTime
+	}
+	if result := tx.Create(&coupon); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "领取优惠券失败")
+	}
+	if result := tx.Model(&template).Update("claimed", gorm.Expr("claimed + 1")); result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "领取优惠券失败")
+	}
+	tx.Commit()
+
+	return userCouponResponse(&coupon, &template), nil
+}
+
+func (*PromotionServer) UserCouponList(ctx context.Context, req *proto.UserCouponFilterRequest) (*proto.UserCouponListResponse, error) {
+	localDB := global.DB.Model(&model.UserCoupon{}).Where(&model.UserCoupon{User: req.UserId, Status: req.Status})
+
+	var count int64
+	localDB.Count(&count)
+
+	var coupons []model.UserCoupon
+	if result := localDB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Order("id desc").Find(&coupons); result.Error != nil {
+		return nil, result.Error
+	}
+	templates, err := loadTemplates(global.DB, coupons)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := proto.UserCouponListResponse{Total: int32(count)}
+	for i := range coupons {
+		template := templates[coupons[i].Template]
+		rsp.Data = append(rsp.Data, userCouponResponse(&coupons[i], &template))
+	}
+	return &rsp, nil
+}
+
+// loadTemplates 批量查询用户优惠券对应的模板
+func loadTemplates(db *gorm.DB, coupons []model.UserCoupon) (map[int32]model.CouponTemplate, error) {
+	var ids []int32
+	for _, coupon := range coupons {
+		ids = append(ids, coupon.Template)
+	}
+
+	templates := make(map[int32]model.CouponTemplate)
+	if len(ids) == 0 {
+		return templates, nil
+	}
+	var list []model.CouponTemplate
+	if result := db.Where("id in ?", ids).Find(&list); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询优惠券模板失败")
+	}
+	for _, t := range list {
+		templates[t.ID] = t
+	}
+	return templates, nil
+}
+
+func userCouponResponse(c *model.UserCoupon, t *model.CouponTemplate) *proto.UserCouponResponse {
+	return &proto.UserCouponResponse{
+		Id:        c.ID,
+		UserId:    c.User,
+		Status:    c.Status,
+		OrderSn:   c.OrderSn,
+		ValidFrom: timeToUnix(c.ValidFrom),
+		ValidTo:   timeToUnix(c.ValidTo),
+		Template:  templateResponse(t),
+	}
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/promotion_srv/global"
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/proto"
+	"wshop_srvs/promotion_srv/utils/money"
+)
+
+func (*PromotionServer) CalculateDiscount(ctx context.Context, req *proto.CalculateDiscountRequest) (*proto.CalculateDiscountResponse, error) {
+	/*
+		计算订单的优惠
+			1. 指定了优惠券的时候校验这些优惠券能不能一起使用， 并计算优惠金额
+			2. 没有指定优惠券的时候从用户所有可用的优惠券中选出优惠最多的组合
+	*/
+	items, err := discountItems(req.Goods)
+	if err != nil {
+		return nil, err
+	}
+
+	var result discountResult
+	if len(req.CouponIds) > 0 {
+		var coupons []model.UserCoupon
+		if result := global.DB.Where("id in ? and user = ?", req.CouponIds, req.UserId).Find(&coupons); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+		}
+		if result, err = calculateCoupons(global.DB, items, req.CouponIds, coupons, ""); err != nil {
+			return nil, err
+		}
+	} else {
+		var coupons []model.UserCoupon
+		if result := global.DB.Where(&model.UserCoupon{User: req.UserId, Status: model.USER_COUPON_UNUSED}).Find(&coupons); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+		}
+		templates, err := loadTemplates(global.DB, coupons)
+		if err != nil {
+			return nil, err
+		}
+
+		var candidates []couponCandidate
+		now := time.Now()
+		for _, coupon := range coupons {
+			template, ok := templates[coupon.Template]
+			if ok && couponUsable(&coupon, now) {
+				candidates = append(candidates, couponCandidate{Coupon: coupon, Template: template})
+			}
+		}
+		result = bestCombination(items, candidates)
+	}
+	return discountResponse(result), nil
+}
+
+func (*PromotionServer) LockCoupons(ctx context.Context, req *proto.LockCouponsRequest) (*proto.CalculateDiscountResponse, error) {
+	/*
+		下单的时候锁定优惠券
+			同一个订单重复锁定的时候直接返回之前锁定的结果， 订单服务的本地事务重试不会重复扣减
+	*/
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+	items, err := discountItems(req.Goods)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.CouponIds) == 0 {
+		return discountResponse(calculate(items, nil)), nil
+	}
+
+	tx := global.DB.Begin()
+	var coupons []model.UserCoupon
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id in ? and user = ?", req.CouponIds, req.UserId).Find(&coupons); result.Error != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+	result, err := calculateCoupons(tx, items, req.CouponIds, coupons, req.OrderSn)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var unlocked []int32
+	for _, coupon := range coupons {
+		if coupon.Status == model.USER_COUPON_UNUSED {
+			unlocked = append(unlocked, coupon.ID)
+		}
+	}
+	if len(unlocked) > 0 {
+		now := time.Now()
+		if result := tx.Model(&model.UserCoupon{}).Where("id in ? and status = ?", unlocked, model.USER_COUPON_UNUSED).Updates(map[string]interface{}{
+			"status":    model.USER_COUPON_LOCKED,
+			"order_sn":  req.OrderSn,
+			"lock_time": now,
+		}); result.Error != nil || result.RowsAffected != int64(len(unlocked)) {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Aborted, "优惠券已被使用")
+		}
+	}
+	tx.Commit()
+
+	return discountResponse(result), nil
+}
+
+func (*PromotionServer) ReleaseCoupons(ctx context.Context, req *proto.CouponOrderRequest) (*emptypb.Empty, error) {
+	// 订单关闭之后归还优惠券， 没有锁定的优惠券时也返回成功， 方便订单服务重试
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+	if result := global.DB.Model(&model.UserCoupon{}).Where("order_sn = ? and status = ?", req.OrderSn, model.USER_COUPON_LOCKED).Updates(map[string]interface{}{
+		"status":    model.USER_COUPON_UNUSED,
+		"order_sn":  "",
+		"lock_time": nil,
+	}); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "释放优惠券失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (*PromotionServer) UseCoupons(ctx context.Context, req *proto.CouponOrderRequest) (*emptypb.Empty, error) {
+	// 订单支付成功之后核销优惠券， 重复调用不会报错
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "缺少订单号")
+	}
+	if result := global.DB.Model(&model.UserCoupon{}).Where("order_sn = ? and status = ?", req.OrderSn, model.USER_COUPON_LOCKED).Updates(map[string]interface{}{
+		"status":   model.USER_COUPON_USED,
+		"use_time": time.Now(),
+	}); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "核销优惠券失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// calculateCoupons 校验用户指定的优惠券并计算优惠
+// orderSn不为空的时候， 已经被这个订单锁定的优惠券也是可以使用的
+func calculateCoupons(db *gorm.DB, items []discountItem, couponIds []int32, coupons []model.UserCoupon, orderSn string) (discountResult, error) {
+	if len(coupons) != len(uniqueIds(couponIds)) {
+		return discountResult{}, status.Errorf(codes.NotFound, "优惠券不存在")
+	}
+	templates, err := loadTemplates(db, coupons)
+	if err != nil {
+		return discountResult{}, err
+	}
+
+	now := time.Now()
+	usedTypes := make(map[int32]bool)
+	var candidates []couponCandidate
+	for _, coupon := range coupons {
+		template, ok := templates[coupon.Template]
+		if !ok {
+			return discountResult{}, status.Errorf(codes.NotFound, "优惠券不存在")
+		}
+		lockedBySelf := orderSn != "" && coupon.Status == model.USER_COUPON_LOCKED && coupon.OrderSn == orderSn
+		if !lockedBySelf && !couponUsable(&coupon, now) {
+			return discountResult{}, status.Errorf(codes.FailedPrecondition, "优惠券%s已经使用或者不在有效期内", template.Name)
+		}
+		if usedTypes[template.CouponType] {
+			return discountResult{}, status.Errorf(codes.InvalidArgument, "同一种类型的优惠券只能使用一张")
+		}
+		usedTypes[template.CouponType] = true
+		candidates = append(candidates, couponCandidate{Coupon: coupon, Template: template})
+	}
+
+	result := calculate(items, candidates)
+	for _, c := range result.Coupons {
+		if c.Discount <= 0 {
+			return discountResult{}, status.Errorf(codes.FailedPrecondition, "优惠券%s不满足使用条件", c.Candidate.Template.Name)
+		}
+	}
+	return result, nil
+}
+
+func couponUsable(c *model.UserCoupon, now time.Time) bool {
+	if c.Status != model.USER_COUPON_UNUSED {
+		return false
+	}
+	if c.ValidFrom != nil && now.Before(*c.ValidFrom) {
+		return false
+	}
+	if c.ValidTo != nil && !now.Before(*c.ValidTo) {
+		return false
+	}
+	return true
+}
+
+func discountItems(goods []*proto.DiscountGoodsItem) ([]discountItem, error) {
+	if len(goods) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "没有商品")
+	}
+	var items []discountItem
+	for _, g := range goods {
+		if g.Nums <= 0 || g.PriceCents < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "商品数量或者价格不合法")
+		}
+		items = append(items, discountItem{
+			GoodsId:    g.GoodsId,
+			CategoryId: g.CategoryId,
+			BrandId:    g.BrandId,
+			Amount:     money.FromCents(g.PriceCents).Mul(g.Nums),
+		})
+	}
+	return items, nil
+}
+
+func uniqueIds(ids []int32) []int32 {
+	seen := make(map[int32]bool)
+	var result []int32
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func discountResponse(result discountResult) *proto.CalculateDiscountResponse {
+	rsp := proto.CalculateDiscountResponse{
+		TotalCents:    result.Total.Cents(),
+		DiscountCents: result.Discount.Cents(),
+	}
+	for _, c := range result.Coupons {
+		rsp.Coupons = append(rsp.Coupons, &proto.CouponDiscount{
+			CouponId:      c.Candidate.Coupon.ID,
+			Name:          c.Candidate.Template.Name,
+			DiscountCents: c.Discount.Cents(),
+		})
+	}
+	return &rsp
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/proto"
+)
+
+func TestLockCoupons(t *testing.T) {
+	db := setupDB(t)
+	template := model.CouponTemplate{Name: "满100减20", CouponType: model.COUPON_THRESHOLD, Threshold: yuan(100), Discount: yuan(20), ScopeType: model.SCOPE_ALL}
+	db.Create(&template)
+	coupon := model.UserCoupon{User: 1, Template: template.ID, Status: model.USER_COUPON_UNUSED}
+	db.Create(&coupon)
+
+	s := &PromotionServer{}
+	ctx := context.Background()
+	goods := []*proto.DiscountGoodsItem{{GoodsId: 1, PriceCents: 15000, Nums: 1}}
+	lock := func(orderSn string) (*proto.CalculateDiscountResponse, error) {
+		return s.LockCoupons(ctx, &proto.LockCouponsRequest{UserId: 1, OrderSn: orderSn, Goods: goods, CouponIds: []int32{coupon.ID}})
+	}
+
+	rsp, err := lock("o1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.DiscountCents != 2000 {
+		t.Errorf("discount = %d", rsp.DiscountCents)
+	}
+	// 同一个订单重复锁定返回同样的结果， 其他订单不能再使用
+	if rsp, err = lock("o1"); err != nil || rsp.DiscountCents != 2000 {
+		t.Errorf("重复锁定 rsp = %v, err = %v", rsp, err)
+	}
+	if _, err = lock("o2"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("其他订单锁定 err = %v", err)
+	}
+	// 其他用户的优惠券
+	if _, err = s.LockCoupons(ctx, &proto.LockCouponsRequest{UserId: 2, OrderSn: "o3", Goods: goods, CouponIds: []int32{coupon.ID}}); status.Code(err) != codes.NotFound {
+		t.Errorf("其他用户的优惠券 err = %v", err)
+	}
+	// 锁定之后不再是可用的优惠券
+	if rsp, err := s.CalculateDiscount(ctx, &proto.CalculateDiscountRequest{UserId: 1, Goods: goods}); err != nil || rsp.DiscountCents != 0 {
+		t.Errorf("锁定之后自动选择 rsp = %v, err = %v", rsp, err)
+	}
+
+	if _, err := s.ReleaseCoupons(ctx, &proto.CouponOrderRequest{OrderSn: "o1"}); err != nil {
+		t.Fatal(err)
+	}
+	if rsp, err := s.CalculateDiscount(ctx, &proto.CalculateDiscountRequest{UserId: 1, Goods: goods}); err != nil || rsp.DiscountCents != 2000 {
+		t.Errorf("释放之后自动选择 rsp = %v, err = %v", rsp, err)
+	}
+}
+
+func TestDiscountQueryError(t *testing.T) {
+	db := setupDB(t)
+	// 查询优惠券失败的时候返回Internal， 不能当作没有优惠券
+	if err := db.Migrator().DropTable(&model.UserCoupon{}); err != nil {
+		t.Fatal(err)
+	}
+	s := &PromotionServer{}
+	ctx := context.Background()
+	goods := []*proto.DiscountGoodsItem{{GoodsId: 1, PriceCents: 15000, Nums: 1}}
+
+	if _, err := s.CalculateDiscount(ctx, &proto.CalculateDiscountRequest{UserId: 1, Goods: goods}); status.Code(err) != codes.Internal {
+		t.Errorf("自动选择 err = %v", err)
+	}
+	if _, err := s.CalculateDiscount(ctx, &proto.CalculateDiscountRequest{UserId: 1, Goods: goods, CouponIds: []int32{1}}); status.Code(err) != codes.Internal {
+		t.Errorf("指定优惠券 err = %v", err)
+	}
+	if _, err := s.LockCoupons(ctx, &proto.LockCouponsRequest{UserId: 1, OrderSn: "o1", Goods: goods, CouponIds: []int32{1}}); status.Code(err) != codes.Internal {
+		t.Errorf("锁定优惠券 err = %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wshop_srvs/promotion_srv/global"
+	"wshop_srvs/promotion_srv/model"
+	"wshop_srvs/promotion_srv/proto"
+	"wshop_srvs/promotion_srv/utils/money"
+)
+
+func (*PromotionServer) CreateCouponTemplate(ctx context.Context, req *proto.CouponTemplateRequest) (*proto.CouponTemplateResponse, error) {
+	template := model.CouponTemplate{
+		Name:         req.Name,
+		CouponType:   req.CouponType,
+		Threshold:    money.FromCents(req.ThresholdCents),
+		Discount:     money.FromCents(req.DiscountCents),
+		Percent:      req.Percent,
+		MaxDiscount:  money.FromCents(req.MaxDiscountCents),
+		ScopeType:    req.ScopeType,
+		ScopeIds:     req.ScopeIds,
+		Total:        req.Total,
+		PerUserLimit: req.PerUserLimit,
+		ValidDays:    req.ValidDays,
+		StartTime:    unixToTime(req.StartTime),
+		EndTime:      unixToTime(req.EndTime),
+		OnSale:       true,
+	}
+	if template.PerUserLimit == 0 {
+		template.PerUserLimit = 1
+	}
+	if err := validateTemplate(&template); err != nil {
+		return nil, err
+	}
+
+	if result := global.DB.Create(&template); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "创建优惠券模板失败")
+	}
+	return templateResponse(&template), nil
+}
+
+func (*PromotionServer) CouponTemplateList(ctx context.Context, req *proto.CouponTemplateFilterRequest) (*proto.CouponTemplateListResponse, error) {
+	localDB := global.DB.Model(&model.CouponTemplate{})
+	if req.Available {
+		// 只返回还在领取时间内并且没有领完的优惠券
+		now := time.Now()
+		localDB = localDB.Where("on_sale = ?", true).
+			Where("start_time is null or start_time <= ?", now).
+			Where("end_time is null or end_time > ?", now).
+			Where("total = 0 or claimed < total")
+	}
+
+	var count int64
+	localDB.Count(&count)
+
+	var templates []model.CouponTemplate
+	if result := localDB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Order("id desc").Find(&templates); result.Error != nil {
+		return nil, result.Error
+	}
+
+	rsp := proto.CouponTemplateListResponse{Total: int32(count)}
+	for i := range templates {
+		rsp.Data = append(rsp.Data, templateResponse(&templates[i]))
+	}
+	return &rsp, nil
+}
+
+func validateTemplate(t *model.CouponTemplate) error {
+	if t.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "优惠券名称不能为空")
+	}
+	switch t.CouponType {
+	case model.COUPON_FIXED:
+		if t.Discount <= 0 {
+			return status.Errorf(codes.InvalidArgument, "立减金额必须大于0")
+		}
+	case model.COUPON_THRESHOLD:
+		if t.Discount <= 0 || t.Threshold <= t.Discount {
+			return status.Errorf(codes.InvalidArgument, "满减的门槛必须大于减免金额")
+		}
+	case model.COUPON_PERCENT:
+		if t.Percent <= 0 || t.Percent >= 100 {
+			return status.Errorf(codes.InvalidArgument, "折扣必须在1到99之间")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "优惠券类型不合法")
+	}
+
+	switch t.ScopeType {
+	case model.SCOPE_ALL:
+		t.ScopeIds = nil
+	case model.SCOPE_CATEGORY, model.SCOPE_BRAND:
+		if len(t.ScopeIds) == 0 {
+			return status.Errorf(codes.InvalidArgument, "指定分类或者品牌的优惠券缺少适用范围")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "优惠券使用范围不合法")
+	}
+
+	if t.Total < 0 || t.PerUserLimit < 0 || t.ValidDays < 0 {
+		return status.Errorf(codes.InvalidArgument, "优惠券数量和有效期不能为负数")
+	}
+	// 没有设置领取后的有效天数， 就需要模板的结束时间作为优惠券的过期时间
+	if t.ValidDays == 0 && t.EndTime == nil {
+		return status.Errorf(codes.InvalidArgument, "优惠券缺少有效期")
+	}
+	if t.StartTime != nil && t.EndTime != nil && !t.EndTime.After(*t.StartTime) {
+		return status.Errorf(codes.InvalidArgument, "结束时间必须晚于开始时间")
+	}
+	return nil
+}
+
+func templateResponse(t *model.CouponTemplate) *proto.CouponTemplateResponse {
+	return &proto.CouponTemplateResponse{
+		Id:               t.ID,
+		Name:             t.Name,
+		CouponType:       t.CouponType,
+		ThresholdCents:   t.Threshold.Cents(),
+		DiscountCents:    t.Discount.Cents(),
+		Percent:          t.Percent,
+		MaxDiscountCents: t.MaxDiscount.Cents(),
+		ScopeType:        t.ScopeType,
+		ScopeIds:         t.ScopeIds,
+		Total:            t.Total,
+		Claimed:          t.Claimed,
+		PerUserLimit:     t.PerUserLimit,
+		ValidDays:        t.ValidDays,
+		StartTime:        timeToUnix(t.StartTime),
+		EndTime:          timeToUnix(t.EndTime),
+	}
+}
+
+func unixToTime(sec int64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+func timeToUnix(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,8 @@
+package initialize
+
+import "go.uber.org/zap"
+
+func InitLogger() {
+	logger, _ := zap.NewDevelopment()
+	zap.ReplaceGlobals(logger)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"wshop_srvs/promotion_srv/handler"
+	"wshop_srvs/promotion_srv/utils/register/consul"
+
+	"github.com/satori/go.uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"wshop_srvs/promotion_srv/global"
+	"wshop_srvs/promotion_srv/initialize"
+	"wshop_srvs/promotion_srv/proto"
+	"wshop_srvs/promotion_srv/utils"
+)
+
+func main() {
+	IP := flag.String("ip", "0.0.0.0", "ip地址")
+	Port := flag.Int("port", 0, "端口号")
+
+	// 初始化
+	initialize.InitLogger()
+	initialize.InitConfig()
+	initialize.InitDB()
+	zap.S().Info(global.ServerConfig)
+
+	flag.Parse()
+	zap.S().Info("ip: ", *IP)
+	if *Port == 0 {
+		*Port, _ = utils.GetFreePort()
+	}
+
+	zap.S().Info("port: ", *Port)
+
+	server := grpc.NewServer()
+	proto.RegisterPromotionServer(server, &handler.PromotionServer{})
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", *IP, *Port))
+	if err != nil {
+		panic("failed to listen:" + err.Error())
+	}
+	// 注册服务健康检查
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	// 启动服务
+	go func() {
+		err = server.Serve(lis)
+		if err != nil {
+			panic("failed to start grpc:" + err.Error())
+		}
+	}()
+
+	// 服务注册
+	register_client := consul.NewRegistryClient(global.ServerConfig.ConsulInfo.Host, global.ServerConfig.ConsulInfo.Port)
+	serviceId := fmt.Sprintf("%s", uuid.NewV4())
+	err = register_client.Register(global.ServerConfig.Host, *Port, global.ServerConfig.Name, global.ServerConfig.Tags, serviceId)
+	if err != nil {
+		zap.S().Panic("服务注册失败:", err.Error())
+	}
+	zap.S().Debugf("启动服务器, 端口： %d", *Port)
+
+	// 接收终止信号
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	if err = register_client.DeRegister(serviceId); err != nil {
+		zap.S().Info("注销失败:", err.Error())
+	} else {
+		zap.S().Info("注销成功:")
+	}
+}
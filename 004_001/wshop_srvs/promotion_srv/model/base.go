@@ -0,0 +1,37 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"gorm.io/gorm"
+	"time"
+)
+
+type GormList []string
+
+func (g GormList) Value() (driver.Value, error) {
+	return json.Marshal(g)
+}
+
+// Scan 实现 sql.Scanner 接口，Scan 将 value 扫描至 Jsonb
+func (g *GormList) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), &g)
+}
+
+type BaseModel struct {
+	ID        int32          `gorm:"primarykey;type:int" json:"id"` // 为什么使用int32， bigint
+	CreatedAt time.Time      `gorm:"column:add_time" json:"-"`
+	UpdatedAt time.Time      `gorm:"column:update_time" json:"-"`
+	DeletedAt gorm.DeletedAt `json:"-"`
+	IsDeleted bool           `json:"-"`
+}
+
+type GormIntList []int32
+
+func (g GormIntList) Value() (driver.Value, error) {
+	return json.Marshal(g)
+}
+
+func (g *GormIntList) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), &g)
+}
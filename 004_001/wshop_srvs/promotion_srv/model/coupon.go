@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"wshop_srvs/promotion_srv/utils/money"
+)
+
+// 优惠券类型
+const (
+	COUPON_FIXED     = iota + 1 // 立减， 直接减去固定的金额
+	COUPON_PERCENT              // 折扣， 按照百分比支付
+	COUPON_THRESHOLD            // 满减， 满足门槛之后减去固定的金额
+)
+
+// 优惠券的使用范围
+const (
+	SCOPE_ALL      = iota + 1 // 全场通用
+	SCOPE_CATEGORY            // 指定分类
+	SCOPE_BRAND               // 指定品牌
+)
+
+// 用户优惠券的状态
+const (
+	USER_COUPON_UNUSED = iota + 1 // 未使用
+	USER_COUPON_LOCKED            // 下单之后锁定， 订单关闭之后释放
+	USER_COUPON_USED              // 订单支付之后核销
+)
+
+type CouponTemplate struct {
+	BaseModel
+
+	Name        string      `gorm:"type:varchar(50);not null"`
+	CouponType  int32       `gorm:"type:int comment '优惠券类型: 1(立减),2(折扣),3(满减)';not null"`
+	Threshold   money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 满多少可以使用， 0表示没有门槛
+	Discount    money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 立减和满减的金额
+	Percent     int32       `gorm:"type:int;not null;default:100"`         // 折扣券支付的百分比， 85表示85折
+	MaxDiscount money.Money `gorm:"type:decimal(10,2);not null;default:0"` // 折扣券最多优惠的金额， 0表示不限制
+	ScopeType   int32       `gorm:"type:int comment '使用范围: 1(全场),2(指定分类),3(指定品牌)';not null"`
+	ScopeIds    GormIntList `gorm:"type:varchar(1000)"` // 指定分类或者品牌的id
+
+	Total        int32 `gorm:"type:int;not null;default:0"` // 发放总量， 0表示不限制
+	Claimed      int32 `gorm:"type:int;not null;default:0"` // 已经领取的数量
+	PerUserLimit int32 `gorm:"type:int;not null;default:1"` // 每人限领数量
+	ValidDays    int32 `gorm:"type:int;not null;default:0"` // 领取之后的有效天数， 0表示使用模板的有效期
+
+	StartTime *time.Time `gorm:"type:datetime"`
+	EndTime   *time.Time `gorm:"type:datetime"`
+	OnSale    bool       `gorm:"default:true;not null"`
+}
+
+func (CouponTemplate) TableName() string {
+	return "coupontemplate"
+}
+
+type UserCoupon struct {
+	BaseModel
+
+	User     int32  `gorm:"type:int;index"`
+	Template int32  `gorm:"type:int;index"`
+	Status   int32  `gorm:"type:int comment '状态: 1(未使用),2(已锁定),3(已使用)';not null"`
+	OrderSn  string `gorm:"type:varchar(30);index"` // 锁定和核销的订单号
+
+	ValidFrom *time.Time `gorm:"type:datetime"`
+	ValidTo   *time.Time `gorm:"type:datetime"`
+	LockTime  *time.Time `gorm:"type:datetime"`
+	UseTime   *time.Time `gorm:"type:datetime"`
+}
+
+func (UserCoupon) TableName() string {
+	return "usercoupon"
+}
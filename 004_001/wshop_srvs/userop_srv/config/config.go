@@ -13,12 +13,24 @@ type ConsulConfig struct {
 	Port int    `mapstructure:"port" json:"port"`
 }
 
+type GoodsSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
+type OrderSrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
 type ServerConfig struct {
 	Name       string       `mapstructure:"name" json:"name"`
 	Host       string       `mapstructure:"host" json:"host"`
 	Tags       []string     `mapstructure:"tags" json:"tags"`
 	MysqlInfo  MysqlConfig  `mapstructure:"mysql" json:"mysql"`
 	ConsulInfo ConsulConfig `mapstructure:"consul" json:"consul"`
+
+	// 评价的时候需要校验订单， 审核之后更新商品的评分
+	GoodsSrvInfo GoodsSrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
+	OrderSrvInfo OrderSrvConfig `mapstructure:"order_srv" json:"order_srv"`
 }
 
 type NacosConfig struct {
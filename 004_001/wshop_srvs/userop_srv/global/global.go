@@ -3,12 +3,16 @@ package global
 import (
 	"gorm.io/gorm"
 	"wshop_srvs/userop_srv/config"
+	"wshop_srvs/userop_srv/proto"
 )
 
 var (
 	DB           *gorm.DB
 	ServerConfig config.ServerConfig
 	NacosConfig  config.NacosConfig
+
+	GoodsSrvClient proto.GoodsClient
+	OrderSrvClient proto.OrderClient
 )
 
 // func init() {
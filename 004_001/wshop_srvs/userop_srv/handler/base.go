@@ -9,6 +9,7 @@ type UserOpServer struct {
 	proto.UnimplementedAddressServer
 	proto.UnimplementedUserFavServer
 	proto.UnimplementedMessageServer
+	proto.UnimplementedReviewServer
 }
 
 func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
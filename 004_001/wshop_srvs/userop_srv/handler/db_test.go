@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"wshop_srvs/userop_srv/global"
+	"wshop_srvs/userop_srv/model"
+)
+
+// 测试使用sqlite， 和线上一样通过gorm访问， 不需要启动mysql
+// model中的类型带有mysql的列注释， sqlite不支持， 建表之前去掉
+
+var columnComment = regexp.MustCompile(`(?i)\s+comment\s+'[^']*'`)
+
+type sqliteConn struct {
+	*sql.DB
+}
+
+func (c sqliteConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.DB.ExecContext(ctx, columnComment.ReplaceAllString(query, ""), args...)
+}
+
+// setupDB 每个测试使用一个新的数据库， 替换global.DB
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	sqlDB, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "userop.db")+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqliteConn{sqlDB}}, &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&model.Review{}); err != nil {
+		t.Fatal(err)
+	}
+
+	oldDB, oldGoods, oldOrder := global.DB, global.GoodsSrvClient, global.OrderSrvClient
+	t.Cleanup(func() {
+		global.DB, global.GoodsSrvClient, global.OrderSrvClient = oldDB, oldGoods, oldOrder
+	})
+	global.DB = db
+	return db
+}
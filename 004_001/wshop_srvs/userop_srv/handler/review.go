@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm/clause"
+
+	"wshop_srvs/userop_srv/global"
+	"wshop_srvs/userop_srv/model"
+	"wshop_srvs/userop_srv/proto"
+)
+
+// reviewable 支付成功之后的订单才能评价， 包括待发货、已发货和交易结束
+// 已发货的订单不要求先确认收货， 否则还没有确认收货的订单反而不能评价， 比没有发货的订单限制更多
+// 关闭、全部退款的订单不能评价
+func reviewable(orderStatus string) bool {
+	switch orderStatus {
+	case "TRADE_SUCCESS", "TRADE_SHIPPED", "TRADE_FINISHED":
+		return true
+	}
+	return false
+}
+
+func reviewToResponse(review model.Review) *proto.ReviewResponse {
+	rsp := &proto.ReviewResponse{
+		Id:           review.ID,
+		UserId:       review.User,
+		GoodsId:      review.Goods,
+		OrderId:      review.Order,
+		OrderGoodsId: review.OrderGoods,
+		Rating:       review.Rating,
+		Content:      review.Content,
+		Images:       review.Images,
+		Status:       review.Status,
+		AdminRemark:  review.AdminRemark,
+		Reply:        review.Reply,
+		AddTime:      review.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if review.ReplyTime != nil {
+		rsp.ReplyTime = review.ReplyTime.Format("2006-01-02 15:04:05")
+	}
+	return rsp
+}
+
+// CreateReview 评价购买过的商品， 新的评价需要审核通过之后才会展示
+func (*UserOpServer) CreateReview(ctx context.Context, req *proto.ReviewRequest) (*proto.ReviewResponse, error) {
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, status.Errorf(codes.InvalidArgument, "评分只能是1到5星")
+	}
+
+	// 订单商品必须是当前用户的
+	orderGoods, err := global.OrderSrvClient.OrderGoodsDetail(ctx, &proto.OrderGoodsRequest{
+		Id:     req.OrderGoodsId,
+		UserId: req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !reviewable(orderGoods.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单还没有支付或者已经关闭， 不能评价")
+	}
+
+	review := model.Review{
+		User:       req.UserId,
+		Goods:      orderGoods.GoodsId,
+		Order:      orderGoods.OrderId,
+		OrderGoods: orderGoods.Id,
+		Rating:     req.Rating,
+		Content:    req.Content,
+		Images:     req.Images,
+		HasImage:   len(req.Images) > 0,
+		Status:     model.REVIEW_PENDING,
+	}
+	// 同一个订单商品并发评价的时候由唯一索引保证只有一条
+	result := global.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&review)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "保存评价失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "这件商品已经评价过了")
+	}
+
+	return reviewToResponse(review), nil
+}
+
+func (*UserOpServer) ReviewList(ctx context.Context, req *proto.ReviewFilterRequest) (*proto.ReviewListResponse, error) {
+	var rsp proto.ReviewListResponse
+	var reviews []model.Review
+
+	localDB := global.DB.Model(&model.Review{}).Where(&model.Review{User: req.UserId, Goods: req.GoodsId, Status: req.Status})
+	if req.HasImage {
+		localDB = localDB.Where("has_image = ?", true)
+	}
+
+	var total int64
+	localDB.Count(&total)
+	rsp.Total = int32(total)
+
+	if result := localDB.Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Order("id desc").Find(&reviews); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+	for _, review := range reviews {
+		rsp.Data = append(rsp.Data, reviewToResponse(review))
+	}
+	return &rsp, nil
+}
+
+// ReplyReview 商家回复评价， 再次回复会覆盖之前的内容
+func (*UserOpServer) ReplyReview(ctx context.Context, req *proto.ReviewReplyRequest) (*emptypb.Empty, error) {
+	var review model.Review
+	if result := global.DB.First(&review, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+
+	now := time.Now()
+	review.Reply = req.Reply
+	review.ReplyTime = &now
+	if result := global.DB.Save(&review); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "保存回复失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AuditReview 审核评价， 审核通过的评价才会展示和计入商品的评分
+// 已经通过的评价也可以改成不通过， 每次审核之后都会重新统计商品的评分
+func (*UserOpServer) AuditReview(ctx context.Context, req *proto.ReviewAuditRequest) (*emptypb.Empty, error) {
+	var review model.Review
+	if result := global.DB.First(&review, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+
+	review.Status = model.REVIEW_REJECTED
+	if req.Approved {
+		review.Status = model.REVIEW_APPROVED
+	}
+	review.AdminRemark = req.AdminRemark
+	if result := global.DB.Save(&review); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "保存审核结果失败")
+	}
+
+	if err := syncGoodsRating(ctx, review.Goods); err != nil {
+		// 评分是重新统计的， 再审核一次就可以重新同步
+		zap.S().Errorf("同步商品%d的评分失败: %s", review.Goods, err.Error())
+		return nil, status.Errorf(codes.Internal, "更新商品评分失败， 请重新审核")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// syncGoodsRating 重新统计商品审核通过的评价， 把评价数和平均星级写回商品服务
+func syncGoodsRating(ctx context.Context, goodsId int32) error {
+	var stat struct {
+		Num    int32
+		Rating float64
+	}
+	result := global.DB.Model(&model.Review{}).
+		Select("count(*) as num, coalesce(avg(rating), 0) as rating").
+		Where(&model.Review{Goods: goodsId, Status: model.REVIEW_APPROVED}).
+		Scan(&stat)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	_, err := global.GoodsSrvClient.UpdateGoodsRating(ctx, &proto.GoodsRatingRequest{
+		GoodsId:   goodsId,
+		Rating:    float32(math.Round(stat.Rating*100) / 100),
+		ReviewNum: stat.Num,
+	})
+	return err
+}
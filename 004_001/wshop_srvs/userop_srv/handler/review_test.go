@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"wshop_srvs/userop_srv/global"
+	"wshop_srvs/userop_srv/model"
+	"wshop_srvs/userop_srv/proto"
+)
+
+// fakeOrder 订单服务， orderGoods是订单商品id -> (用户, 订单商品)
+type fakeOrder struct {
+	proto.OrderClient
+	users      map[int32]int32
+	orderGoods map[int32]*proto.OrderGoodsResponse
+}
+
+func (f *fakeOrder) add(id, user, goods int32, orderStatus string) {
+	f.users[id] = user
+	f.orderGoods[id] = &proto.OrderGoodsResponse{Id: id, OrderId: id * 10, GoodsId: goods, Status: orderStatus}
+}
+
+func (f *fakeOrder) OrderGoodsDetail(ctx context.Context, in *proto.OrderGoodsRequest, opts ...grpc.CallOption) (*proto.OrderGoodsResponse, error) {
+	if rsp, ok := f.orderGoods[in.Id]; ok && f.users[in.Id] == in.UserId {
+		return rsp, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+}
+
+// fakeGoods 商品服务， 记录每个商品最后一次同步的评分
+type fakeGoods struct {
+	proto.GoodsClient
+	err     error
+	ratings map[int32]*proto.GoodsRatingRequest
+}
+
+func (f *fakeGoods) UpdateGoodsRating(ctx context.Context, in *proto.GoodsRatingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.ratings[in.GoodsId] = in
+	return &emptypb.Empty{}, nil
+}
+
+func setupReview(t *testing.T) (*fakeOrder, *fakeGoods) {
+	t.Helper()
+	setupDB(t)
+	order := &fakeOrder{users: make(map[int32]int32), orderGoods: make(map[int32]*proto.OrderGoodsResponse)}
+	goods := &fakeGoods{ratings: make(map[int32]*proto.GoodsRatingRequest)}
+	global.OrderSrvClient, global.GoodsSrvClient = order, goods
+	return order, goods
+}
+
+func TestReviewable(t *testing.T) {
+	cases := map[string]bool{
+		"":               false,
+		"WAIT_BUYER_PAY": false,
+		"PAYING":         false,
+		"TRADE_SUCCESS":  true,
+		"TRADE_SHIPPED":  true,
+		"TRADE_FINISHED": true,
+		"TRADE_CLOSED":   false,
+		"TRADE_REFUNDED": false,
+	}
+	for orderStatus, want := range cases {
+		if got := reviewable(orderStatus); got != want {
+			t.Errorf("reviewable(%q) = %v, want %v", orderStatus, got, want)
+		}
+	}
+}
+
+func TestCreateReview(t *testing.T) {
+	order, _ := setupReview(t)
+	order.add(1, 1, 100, "TRADE_SHIPPED")
+	order.add(2, 1, 100, "WAIT_BUYER_PAY")
+	order.add(3, 1, 100, "TRADE_CLOSED")
+	order.add(4, 2, 100, "TRADE_FINISHED")
+	s := &UserOpServer{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *proto.ReviewRequest
+		code codes.Code
+	}{
+		{"rating too low", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1, Rating: 0}, codes.InvalidArgument},
+		{"rating too high", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1, Rating: 6}, codes.InvalidArgument},
+		{"not paid", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 2, Rating: 5}, codes.FailedPrecondition},
+		{"closed", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 3, Rating: 5}, codes.FailedPrecondition},
+		// 别人的订单商品
+		{"other user", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 4, Rating: 5}, codes.NotFound},
+		{"shipped", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1, Rating: 4, Content: "不错", Images: []string{"a.jpg"}}, codes.OK},
+		// 每个订单商品只能评价一次
+		{"twice", &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1, Rating: 1}, codes.AlreadyExists},
+	}
+	for _, tt := range tests {
+		_, err := s.CreateReview(ctx, tt.req)
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+
+	var reviews []model.Review
+	global.DB.Find(&reviews)
+	if len(reviews) != 1 {
+		t.Fatalf("reviews = %d", len(reviews))
+	}
+	review := reviews[0]
+	if review.User != 1 || review.Goods != 100 || review.Order != 10 || review.OrderGoods != 1 || review.Rating != 4 ||
+		!review.HasImage || len(review.Images) != 1 || review.Status != model.REVIEW_PENDING {
+		t.Errorf("review = %+v", review)
+	}
+}
+
+func TestAuditReviewRating(t *testing.T) {
+	order, goods := setupReview(t)
+	s := &UserOpServer{}
+	ctx := context.Background()
+
+	// 商品100有三条评价， 商品200有一条
+	ids := make(map[int32]int32)
+	for id, rating := range map[int32]int32{1: 5, 2: 4, 3: 4, 4: 1} {
+		goodsId := int32(100)
+		if id == 4 {
+			goodsId = 200
+		}
+		order.add(id, 1, goodsId, "TRADE_FINISHED")
+		req := &proto.ReviewRequest{UserId: 1, OrderGoodsId: id, Rating: rating}
+		if id == 1 {
+			req.Images = []string{"a.jpg"}
+		}
+		rsp, err := s.CreateReview(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[id] = rsp.Id
+	}
+
+	approved := func(filter *proto.ReviewFilterRequest) int32 {
+		t.Helper()
+		filter.Status = model.REVIEW_APPROVED
+		rsp, err := s.ReviewList(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(rsp.Total) != len(rsp.Data) {
+			t.Fatalf("total = %d, data = %d", rsp.Total, len(rsp.Data))
+		}
+		return rsp.Total
+	}
+	// 没有审核的评价不展示
+	if n := approved(&proto.ReviewFilterRequest{GoodsId: 100}); n != 0 {
+		t.Fatalf("审核之前展示了%d条评价", n)
+	}
+
+	audits := []struct {
+		review   int32
+		approved bool
+		rating   float32
+		num      int32
+	}{
+		{1, true, 5, 1},
+		{2, true, 4.5, 2},
+		{3, true, 4.33, 3},
+		// 审核不通过的评价不计入评分
+		{3, false, 4.5, 2},
+		{1, false, 4, 1},
+		{2, false, 0, 0},
+	}
+	for _, a := range audits {
+		if _, err := s.AuditReview(ctx, &proto.ReviewAuditRequest{Id: ids[a.review], Approved: a.approved}); err != nil {
+			t.Fatal(err)
+		}
+		got := goods.ratings[100]
+		if got == nil || got.Rating != a.rating || got.ReviewNum != a.num {
+			t.Fatalf("审核评价%d之后同步的评分 = %v, want %v/%d", a.review, got, a.rating, a.num)
+		}
+	}
+	if _, ok := goods.ratings[200]; ok {
+		t.Error("不应该同步其他商品的评分")
+	}
+
+	for _, id := range []int32{1, 2} {
+		if _, err := s.AuditReview(ctx, &proto.ReviewAuditRequest{Id: ids[id], Approved: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := approved(&proto.ReviewFilterRequest{GoodsId: 100}); n != 2 {
+		t.Errorf("审核通过的评价 = %d", n)
+	}
+	if n := approved(&proto.ReviewFilterRequest{GoodsId: 100, HasImage: true}); n != 1 {
+		t.Errorf("有图的评价 = %d", n)
+	}
+	if n := approved(&proto.ReviewFilterRequest{GoodsId: 200}); n != 0 {
+		t.Errorf("商品200审核通过的评价 = %d", n)
+	}
+
+	// 同步失败的时候返回错误， 重新审核一次就可以再同步
+	goods.err = errors.New("unavailable")
+	if _, err := s.AuditReview(ctx, &proto.ReviewAuditRequest{Id: ids[3], Approved: true}); status.Code(err) != codes.Internal {
+		t.Errorf("同步评分失败 err = %v", err)
+	}
+	goods.err = nil
+	if _, err := s.AuditReview(ctx, &proto.ReviewAuditRequest{Id: ids[3], Approved: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got := goods.ratings[100]; got.Rating != 4.33 || got.ReviewNum != 3 {
+		t.Errorf("重新审核之后同步的评分 = %v", got)
+	}
+
+	if _, err := s.AuditReview(ctx, &proto.ReviewAuditRequest{Id: 999, Approved: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("不存在的评价 err = %v", err)
+	}
+}
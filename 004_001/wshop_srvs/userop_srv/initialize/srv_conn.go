@@ -0,0 +1,37 @@
+package initialize
+
+import (
+	"fmt"
+
+	_ "github.com/mbobakov/grpc-consul-resolver" // It's important
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"wshop_srvs/userop_srv/global"
+	"wshop_srvs/userop_srv/proto"
+)
+
+func InitSrvConn() {
+	consulInfo := global.ServerConfig.ConsulInfo
+	goodsConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.GoodsSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【商品服务失败】")
+	}
+
+	global.GoodsSrvClient = proto.NewGoodsClient(goodsConn)
+
+	orderConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.OrderSrvInfo.Name),
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【订单服务失败】")
+	}
+
+	global.OrderSrvClient = proto.NewOrderClient(orderConn)
+}
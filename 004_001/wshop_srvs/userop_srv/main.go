@@ -30,6 +30,7 @@ func main() {
 	initialize.InitLogger()
 	initialize.InitConfig()
 	initialize.InitDB()
+	initialize.InitSrvConn()
 	zap.S().Info(global.ServerConfig)
 
 	flag.Parse()
@@ -44,6 +45,7 @@ func main() {
 	proto.RegisterAddressServer(server, &handler.UserOpServer{})
 	proto.RegisterMessageServer(server, &handler.UserOpServer{})
 	proto.RegisterUserFavServer(server, &handler.UserOpServer{})
+	proto.RegisterReviewServer(server, &handler.UserOpServer{})
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", *IP, *Port))
 	if err != nil {
 		panic("failed to listen:" + err.Error())
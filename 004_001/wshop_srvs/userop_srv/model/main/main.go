@@ -43,6 +43,6 @@ func main() {
 		panic(err)
 	}
 
-	_ = db.AutoMigrate(&model.LeavingMessages{}, &model.UserFav{}, model.Address{})
+	_ = db.AutoMigrate(&model.LeavingMessages{}, &model.UserFav{}, model.Address{}, &model.Review{})
 
 }
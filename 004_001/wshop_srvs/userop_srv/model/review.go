@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// 评价的审核状态
+const (
+	REVIEW_PENDING  = iota + 1 // 待审核
+	REVIEW_APPROVED            // 审核通过， 展示并计入商品评分
+	REVIEW_REJECTED            // 审核不通过
+)
+
+// Review 商品评价， 用户只能评价已经支付的订单商品(见handler中的reviewable)， 每个订单商品只能评价一次
+type Review struct {
+	BaseModel
+
+	User       int32 `gorm:"type:int;index"`
+	Goods      int32 `gorm:"type:int;index:idx_goods_status"`
+	Order      int32 `gorm:"type:int"`
+	OrderGoods int32 `gorm:"type:int;index:idx_order_goods,unique"`
+
+	Rating   int32    `gorm:"type:int comment '星级: 1-5'"`
+	Content  string   `gorm:"type:varchar(500)"`
+	Images   GormList `gorm:"type:varchar(2000)"` // 通过oss上传之后的图片地址
+	HasImage bool     `gorm:"default:false;not null"`
+
+	Status      int32  `gorm:"type:int;index:idx_goods_status comment '审核状态: 1(待审核),2(审核通过),3(审核不通过)'"`
+	AdminRemark string `gorm:"type:varchar(200)"`
+
+	Reply     string `gorm:"type:varchar(500)"` // 商家回复
+	ReplyTime *time.Time
+}
+
+func (Review) TableName() string {
+	return "review"
+}